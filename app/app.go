@@ -137,6 +137,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/CosmWasm/wasmd/x/will"
 	willkeeper "github.com/CosmWasm/wasmd/x/will/keeper"
+	willv2 "github.com/CosmWasm/wasmd/x/will/migrations/v2"
 	willtypes "github.com/CosmWasm/wasmd/x/will/types"
 )
 
@@ -702,11 +703,20 @@ func NewWasmApp(
 		app.BankKeeper,
 		app.PermissionedWasmKeeper,
 		app.AccountKeeper,
+		// to send the ICS-20 transfers of IBC send components
+		app.TransferKeeper,
+		// to register the interchain accounts of ICA components and send their transactions
+		app.ICAControllerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		// the built-in claim schemes and the custom claim verifiers of the app, see willClaimSchemes
+		willkeeper.WithClaimSchemes(willClaimSchemes()...),
 	)
 
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	// settles the IBC send components of wills once their transfers are acknowledged
+	transferStack = will.NewTransferMiddleware(transferStack, app.WillKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -715,8 +725,9 @@ func NewWasmApp(
 	var icaControllerStack porttypes.IBCModule
 	// integration point for custom authentication modules
 	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
+	// the will module authenticates the interchain accounts it owns for ICA components
+	icaControllerStack = will.NewICAAuthModule(app.WillKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -788,7 +799,7 @@ func NewWasmApp(
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 
 		// will
-		will.NewAppModule(appCodec, &app.WillKeeper, logger),
+		will.NewAppModule(appCodec, &app.WillKeeper, logger, app.GetSubspace(willtypes.ModuleName)),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -1278,6 +1289,15 @@ func BlockedAddresses() map[string]bool {
 	return modAccAddrs
 }
 
+// willClaimSchemes returns the claim schemes the will module verifies claims with: the built-in
+// ones followed by the verifiers of the app's custom claim components, which are registered under
+// the scheme name their components carry. A scheme replaces a built-in one of the same name.
+func willClaimSchemes() []willkeeper.ClaimScheme {
+	schemes := willkeeper.DefaultClaimSchemes()
+	// append the verifiers of app-specific custom claim components here
+	return schemes
+}
+
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -1290,7 +1310,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
-	paramsKeeper.Subspace(willtypes.ModuleName)
+	paramsKeeper.Subspace(willtypes.ModuleName).WithKeyTable(willv2.ParamKeyTable())
 
 	// register the IBC key tables for legacy param subspaces
 	keyTable := ibcclienttypes.ParamKeyTable()
@@ -114,6 +114,13 @@ func (app *WasmApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 	// clear validator historical rewards
 	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
 
+	/* Handle will state. */
+
+	// keep the blocks left until each will fires, the new chain starts at height zero
+	if err := app.WillKeeper.RebaseWillHeights(ctx, ctx.BlockHeight()); err != nil {
+		panic(err)
+	}
+
 	// set context height to zero
 	height := ctx.BlockHeight()
 	ctx = ctx.WithBlockHeight(0)
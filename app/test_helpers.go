@@ -730,11 +730,20 @@ func Setup(t *testing.T, opts ...wasmkeeper.Option) *WasmApp {
 		app.BankKeeper,
 		app.PermissionedWasmKeeper,
 		app.AccountKeeper,
+		// to send the ICS-20 transfers of IBC send components
+		app.TransferKeeper,
+		// to register the interchain accounts of ICA components and send their transactions
+		app.ICAControllerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		// register custom claim verifiers alongside the built-in schemes
+		willkeeper.WithClaimSchemes(willkeeper.DefaultClaimSchemes()...),
 	)
 
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	// settles the IBC send components of wills once their transfers are acknowledged
+	transferStack = will.NewTransferMiddleware(transferStack, app.WillKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -743,8 +752,9 @@ func Setup(t *testing.T, opts ...wasmkeeper.Option) *WasmApp {
 	var icaControllerStack porttypes.IBCModule
 	// integration point for custom authentication modules
 	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
+	// the will module authenticates the interchain accounts it owns for ICA components
+	icaControllerStack = will.NewICAAuthModule(app.WillKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -816,7 +826,7 @@ func Setup(t *testing.T, opts ...wasmkeeper.Option) *WasmApp {
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 
 		// will
-		will.NewAppModule(appCodec, &app.WillKeeper, logger),
+		will.NewAppModule(appCodec, &app.WillKeeper, logger, app.GetSubspace(willtypes.ModuleName)),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -4,6 +4,7 @@ go 1.21
 
 require (
 	github.com/CosmWasm/wasmvm v1.5.0
+	github.com/bwesterb/go-ristretto v1.2.3
 	github.com/cosmos/cosmos-proto v1.0.0-beta.3
 	github.com/cosmos/cosmos-sdk v0.50.1 //v0.50.1
 	github.com/cosmos/gogogateway v1.2.0 // indirect
@@ -24,11 +25,10 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.8.4
 	github.com/syndtr/goleveldb v1.0.1-0.20220721030215-126854af5e6d
+	github.com/umbracle/go-eth-bn256 v0.0.0-20190607160430-b36caf4e0f6b
 	google.golang.org/genproto v0.0.0-20231120223509-83a465c0220f // indirect
 	google.golang.org/grpc v1.60.0
 	gopkg.in/yaml.v2 v2.4.0
-	github.com/bwesterb/go-ristretto v1.2.3
-
 )
 
 require (
@@ -56,7 +56,7 @@ require (
 	github.com/rs/zerolog v1.31.0
 	github.com/spf13/viper v1.17.0
 	google.golang.org/genproto/googleapis/api v0.0.0-20231106174013-bbf56f31fb17
-	google.golang.org/protobuf v1.31.0 // indirect
+	google.golang.org/protobuf v1.31.0
 )
 
 require (
@@ -190,7 +190,6 @@ require (
 	go.etcd.io/bbolt v1.3.8 // indirect
 	go.opencensus.io v0.24.0 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
-	golang.org/x/crypto v0.16.0 // indirect
 	golang.org/x/exp v0.0.0-20231006140011-7918f672742d // indirect
 	golang.org/x/net v0.19.0 // indirect
 	golang.org/x/oauth2 v0.13.0 // indirect
@@ -210,7 +209,10 @@ require (
 	sigs.k8s.io/yaml v1.4.0 // indirect
 )
 
-require go.dedis.ch/kyber/v3 v3.1.0
+require (
+	go.dedis.ch/kyber/v3 v3.1.0
+	golang.org/x/crypto v0.16.0
+)
 
 replace (
 	github.com/99designs/keyring => github.com/cosmos/keyring v1.2.0
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/bwesterb/go-ristretto"
+	"github.com/spf13/cobra"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/pedersen"
+)
+
+const flagClaimer = "claimer"
+
+// pedersenOutput is printed by the pedersen helper commands, every field hex encoded
+type pedersenOutput struct {
+	Commitment     string `json:"commitment"`
+	BlindingFactor string `json:"blinding_factor"`
+	Value          string `json:"value"`
+}
+
+// PedersenCmd groups the offline helpers for pedersen claims
+func PedersenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pedersen",
+		Short: "Offline helpers to create the commitments of pedersen claims",
+		Long: `Offline helpers for pedersen claim components. The commitment goes into the component, the
+blinding factor and value are the opening a claim reveals. Nothing is broadcast.
+Example:
+./build/wasmd tx will pedersen commit
+./build/wasmd tx will pedersen commit 42 --claimer will156mw28alhpenp4lknweat6432dux34uydx590v`,
+	}
+	cmd.AddCommand(pedersenCommitCmd())
+	return cmd
+}
+
+func pedersenCommitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit [value]",
+		Short: "Commit to a decimal or 0x prefixed hex value, or to a random one, with a random blinding factor",
+		Long: `Commit to a value with a random blinding factor and print the commitment with its opening.
+With --claimer the commitment is bound to the claimer's address: only that address can claim with
+the opening, and the component has to be created with ",bind".`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			claimer, err := cmd.Flags().GetString(flagClaimer)
+			if err != nil {
+				return err
+			}
+			var value, blindingFactor ristretto.Scalar
+			if len(args) == 0 {
+				value.Rand()
+			} else {
+				v, ok := new(big.Int).SetString(args[0], 0)
+				if !ok || v.Sign() < 0 || v.Cmp(pedersen.Order) >= 0 {
+					return fmt.Errorf("value %q is not a scalar", args[0])
+				}
+				value.SetBigInt(v)
+			}
+			blindingFactor.Rand()
+
+			committed := value
+			if claimer != "" {
+				committed = pedersen.BoundValue(&value, claimer)
+			}
+			commitment := pedersen.Commit(&blindingFactor, &committed)
+			bz, err := json.Marshal(pedersenOutput{
+				Commitment:     hex.EncodeToString(commitment.Bytes()),
+				BlindingFactor: hex.EncodeToString(blindingFactor.Bytes()),
+				Value:          hex.EncodeToString(value.Bytes()),
+			})
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagClaimer, "", "Bind the commitment to the address of the claimer")
+	return cmd
+}
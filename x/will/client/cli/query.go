@@ -12,6 +12,12 @@ import (
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
+const (
+	flagStatus    = "status"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+)
+
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -24,6 +30,13 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetWillCmd(),
 		ListWillsCmd(),
+		AllWillsCmd(),
+		WillEscrowCmd(),
+		VestingStatusCmd(),
+		WillExecutionReceiptsCmd(),
+		GetParamsCmd(),
+		WillsByBeneficiaryCmd(),
+		ClaimableComponentsCmd(),
 	)
 	return queryCmd
 }
@@ -115,6 +128,10 @@ func ListWillsCmd() *cobra.Command {
 			}
 
 			address := args[0] // Use the first argument as the address
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			fmt.Printf("Fetching wills for address: %s\n", address)
 			queryClient := types.NewQueryClient(clientCtx)
@@ -122,7 +139,8 @@ func ListWillsCmd() *cobra.Command {
 			res, err := queryClient.ListWills(
 				context.Background(),
 				&types.QueryListWillsRequest{
-					Address: address,
+					Address:    address,
+					Pagination: pageReq,
 				},
 			)
 			if err != nil {
@@ -134,5 +152,239 @@ func ListWillsCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list wills")
+	return cmd
+}
+
+// AllWillsCmd lists the wills of all accounts
+func AllWillsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all",
+		Short: "List the wills of all accounts, optionally filtered by status and trigger height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			minHeight, err := cmd.Flags().GetInt64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetInt64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllWills(
+				context.Background(),
+				&types.QueryAllWillsRequest{
+					Status:     status,
+					MinHeight:  minHeight,
+					MaxHeight:  maxHeight,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagStatus, "", "Only list wills with this status (live, queued, expired or cancelled)")
+	cmd.Flags().Int64(flagMinHeight, 0, "Only list wills triggering at or after this height")
+	cmd.Flags().Int64(flagMaxHeight, 0, "Only list wills triggering at or before this height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all wills")
+	return cmd
+}
+
+// WillEscrowCmd returns the assets held in escrow for a will
+func WillEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow [will-id]",
+		Short: "Query the assets held in escrow for a will",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.WillEscrow(
+				context.Background(),
+				&types.QueryWillEscrowRequest{
+					WillId: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// VestingStatusCmd returns the vested and remaining coins of a vesting component
+func VestingStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting [will-id] [component-id]",
+		Short: "Query the vested and remaining coins of a vesting component",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VestingStatus(
+				context.Background(),
+				&types.QueryVestingStatusRequest{
+					WillId:      args[0],
+					ComponentId: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WillExecutionReceiptsCmd returns what each component of a will did when the will fired
+func WillExecutionReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts [will-id]",
+		Short: "Query what each component of a will did when the will fired",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.WillExecutionReceipts(
+				context.Background(),
+				&types.QueryWillExecutionReceiptsRequest{
+					WillId:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "will execution receipts")
+	return cmd
+}
+
+// GetParamsCmd returns the will module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current will parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WillsByBeneficiaryCmd lists the wills naming an address as beneficiary
+func WillsByBeneficiaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "beneficiary [address]",
+		Short: "List the wills naming an address as beneficiary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.WillsByBeneficiary(
+				context.Background(),
+				&types.QueryWillsByBeneficiaryRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "wills by beneficiary")
+	return cmd
+}
+
+// ClaimableComponentsCmd lists the active claim components an address may claim
+func ClaimableComponentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable [address]",
+		Short: "List the active claim components an address may claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimableComponents(
+				context.Background(),
+				&types.QueryClaimableComponentsRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claimable components")
 	return cmd
 }
@@ -0,0 +1,285 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.dedis.ch/kyber/v3"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/schnorr"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// schnorrOutput is printed by the schnorr helper commands, every field hex encoded
+type schnorrOutput struct {
+	PrivateKey string `json:"private_key,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+	Signature  string `json:"signature,omitempty"`
+	// Nonce is secret, NonceCommitment is shared with the other signers of an aggregate signature
+	Nonce            string `json:"nonce,omitempty"`
+	NonceCommitment  string `json:"nonce_commitment,omitempty"`
+	PartialSignature string `json:"partial_signature,omitempty"`
+}
+
+const (
+	flagGuardians = "guardians"
+	flagSigners   = "signers"
+	flagNonces    = "nonces"
+)
+
+// SchnorrCmd groups the offline helpers for schnorr claims
+func SchnorrCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schnorr",
+		Short: "Offline helpers to create schnorr keys and sign schnorr and threshold claims",
+		Long: `Offline helpers for schnorr claim components. The public key goes into the component,
+claims are signed over the chain ID, will ID, component ID and claimer address. Nothing is broadcast.
+
+Guardians of a threshold component approve a claim with an aggregate signature: every signer
+creates a nonce and shares its commitment, every signer signs its part with all commitments,
+and the parts are aggregated into the signature of the claim. A guardian approving alone is the
+only signer. Signers are guardian indices in the order of the component's public keys.
+Example:
+./build/wasmd tx will schnorr keygen
+./build/wasmd tx will schnorr sign [private-key] [will-id] [component-id] [claimer] --chain-id willchain-mainnet
+./build/wasmd tx will schnorr nonce
+./build/wasmd tx will schnorr partial-sign [private-key] [nonce] [will-id] [component-id] [claimer] --guardians pk0,pk1,pk2 --signers 0,2 --nonces r0,r2 --chain-id willchain-mainnet
+./build/wasmd tx will schnorr aggregate [partial-signature]... --nonces r0,r2`,
+	}
+	cmd.AddCommand(schnorrKeygenCmd(), schnorrSignCmd(), schnorrNonceCmd(), schnorrPartialSignCmd(), schnorrAggregateCmd())
+	return cmd
+}
+
+func schnorrKeygenCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "keygen",
+		Short: "Create a schnorr key pair and print it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			privateKey, publicKey := schnorr.RandomKeyPair()
+			privateKeyBytes, err := privateKey.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			publicKeyBytes, err := publicKey.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return printSchnorrOutput(cmd, schnorrOutput{
+				PrivateKey: hex.EncodeToString(privateKeyBytes),
+				PublicKey:  hex.EncodeToString(publicKeyBytes),
+			})
+		},
+	}
+}
+
+func schnorrSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [private-key] [will-id] [component-id] [claimer]",
+		Short: "Sign the claim of a schnorr component by the claimer and print the signature",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			privateKey, err := schnorr.UnmarshalPrivateKey(bz)
+			if err != nil {
+				return err
+			}
+			signature, err := schnorr.SignMessage(types.SchnorrClaimDigest(chainID, args[1], args[2], args[3]), privateKey).MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return printSchnorrOutput(cmd, schnorrOutput{Signature: hex.EncodeToString(signature)})
+		},
+	}
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID the claim is submitted to")
+	_ = cmd.MarkFlagRequired(flags.FlagChainID)
+	return cmd
+}
+
+func schnorrNonceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "nonce",
+		Short: "Create a nonce for an aggregate signature and print it with its commitment",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			nonce, commitment := schnorr.NewNonce()
+			nonceBytes, err := nonce.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			commitmentBytes, err := commitment.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return printSchnorrOutput(cmd, schnorrOutput{
+				Nonce:           hex.EncodeToString(nonceBytes),
+				NonceCommitment: hex.EncodeToString(commitmentBytes),
+			})
+		},
+	}
+}
+
+func schnorrPartialSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-sign [private-key] [nonce] [will-id] [component-id] [claimer]",
+		Short: "Sign a guardian's part of the aggregate signature approving a threshold claim",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			privateKey, err := decodeScalar(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid private key: %w", err)
+			}
+			nonce, err := decodeScalar(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+			guardians, err := pointsFlag(cmd, flagGuardians)
+			if err != nil {
+				return err
+			}
+			signersFlag, err := cmd.Flags().GetString(flagSigners)
+			if err != nil {
+				return err
+			}
+			signers, err := parseSigners(signersFlag)
+			if err != nil {
+				return err
+			}
+			commitments, err := pointsFlag(cmd, flagNonces)
+			if err != nil {
+				return err
+			}
+			if len(commitments) != len(signers) {
+				return fmt.Errorf("got %d nonce commitments for %d signers", len(commitments), len(signers))
+			}
+			publicKey := schnorr.PublicKey(privateKey)
+			signer := -1
+			for _, i := range signers {
+				if int(i) < len(guardians) && guardians[i].Equal(publicKey) {
+					signer = int(i)
+				}
+			}
+			if signer < 0 {
+				return fmt.Errorf("the private key is not the key of any of the signers")
+			}
+			r := schnorr.AggregateNonces(commitments...)
+			digest := types.ThresholdClaimDigest(chainID, args[2], args[3], args[4])
+			partial, err := schnorr.SignPartial(digest, guardians, signers, uint32(signer), privateKey, nonce, r)
+			if err != nil {
+				return err
+			}
+			partialBytes, err := partial.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return printSchnorrOutput(cmd, schnorrOutput{PartialSignature: hex.EncodeToString(partialBytes)})
+		},
+	}
+	cmd.Flags().String(flags.FlagChainID, "", "The chain ID the claim is submitted to")
+	cmd.Flags().String(flagGuardians, "", "Comma separated hex public keys of all guardians of the component, in component order")
+	cmd.Flags().String(flagSigners, "", "Comma separated indices of the signing guardians, in increasing order")
+	cmd.Flags().String(flagNonces, "", "Comma separated hex nonce commitments of the signers, in signer order")
+	for _, flag := range []string{flags.FlagChainID, flagGuardians, flagSigners, flagNonces} {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+	return cmd
+}
+
+func schnorrAggregateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate [partial-signature]...",
+		Short: "Aggregate the partial signatures of all signers into the signature of a threshold claim",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commitments, err := pointsFlag(cmd, flagNonces)
+			if err != nil {
+				return err
+			}
+			if len(commitments) != len(args) {
+				return fmt.Errorf("got %d nonce commitments for %d partial signatures", len(commitments), len(args))
+			}
+			partials := make([]kyber.Scalar, len(args))
+			for i, arg := range args {
+				if partials[i], err = decodeScalar(arg); err != nil {
+					return fmt.Errorf("invalid partial signature %d: %w", i, err)
+				}
+			}
+			signature, err := schnorr.AggregateSignatures(schnorr.AggregateNonces(commitments...), partials...).MarshalBinary()
+			if err != nil {
+				return err
+			}
+			return printSchnorrOutput(cmd, schnorrOutput{Signature: hex.EncodeToString(signature)})
+		},
+	}
+	cmd.Flags().String(flagNonces, "", "Comma separated hex nonce commitments of the signers, in signer order")
+	_ = cmd.MarkFlagRequired(flagNonces)
+	return cmd
+}
+
+// parseSigners parses comma separated guardian indices
+func parseSigners(s string) ([]uint32, error) {
+	parts := strings.Split(s, ",")
+	signers := make([]uint32, len(parts))
+	for i, part := range parts {
+		signer, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer %q: %w", part, err)
+		}
+		signers[i] = uint32(signer)
+	}
+	return signers, nil
+}
+
+// pointsFlag decodes a flag of comma separated hex points
+func pointsFlag(cmd *cobra.Command, flag string) ([]kyber.Point, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(value, ",")
+	points := make([]kyber.Point, len(parts))
+	for i, part := range parts {
+		bz, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %d: %w", flag, i, err)
+		}
+		if points[i], err = schnorr.UnmarshalPublicKey(bz); err != nil {
+			return nil, fmt.Errorf("invalid %s %d: %w", flag, i, err)
+		}
+	}
+	return points, nil
+}
+
+func decodeScalar(s string) (kyber.Scalar, error) {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return schnorr.UnmarshalPrivateKey(bz)
+}
+
+func printSchnorrOutput(cmd *cobra.Command, out schnorrOutput) error {
+	bz, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
@@ -1,11 +1,13 @@
 package cli
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -13,12 +15,44 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
+const (
+	flagInactivityWindow   = "inactivity-window"
+	flagBeneficiary        = "beneficiary"
+	flagHeight             = "height"
+	flagTriggerTime        = "trigger-time"
+	flagInactivityDuration = "inactivity-duration"
+	flagConditionContract  = "condition-contract"
+	flagConditionQuery     = "condition-query"
+	flagConditionPath      = "condition-path"
+	flagConditionOperator  = "condition-operator"
+	flagConditionValue     = "condition-value"
+	flagConditionInterval  = "condition-interval"
+	flagConditionGas       = "condition-gas"
+	flagExecutionMode      = "execution-mode"
+	flagRemoteChannels     = "remote-channels"
+)
+
+// executionModes maps the --execution-mode names to execution modes
+var executionModes = map[string]types.ExecutionMode{
+	"best-effort": types.ExecutionMode_EXECUTION_MODE_BEST_EFFORT,
+	"atomic":      types.ExecutionMode_EXECUTION_MODE_ATOMIC,
+}
+
+// conditionOperators maps the --condition-operator names to condition operators
+var conditionOperators = map[string]types.ConditionOperator{
+	"eq":  types.ConditionOperator_CONDITION_OPERATOR_EQUAL,
+	"ne":  types.ConditionOperator_CONDITION_OPERATOR_NOT_EQUAL,
+	"lt":  types.ConditionOperator_CONDITION_OPERATOR_LESS,
+	"lte": types.ConditionOperator_CONDITION_OPERATOR_LESS_OR_EQUAL,
+	"gt":  types.ConditionOperator_CONDITION_OPERATOR_GREATER,
+	"gte": types.ConditionOperator_CONDITION_OPERATOR_GREATER_OR_EQUAL,
+}
+
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -32,6 +66,14 @@ func GetTxCmd() *cobra.Command {
 		CreateWillCmd(),
 		CheckInCmd(),
 		ClaimCmd(),
+		FundWillCmd(),
+		UpdateWillCmd(),
+		CancelWillCmd(),
+		WithdrawVestedCmd(),
+		ExecuteWillCmd(),
+		SendWillPacketCmd(),
+		SchnorrCmd(),
+		PedersenCmd(),
 	)
 	return txCmd
 }
@@ -53,50 +95,39 @@ func CreateWillCmd() *cobra.Command {
 				return fmt.Errorf("failed to parse height '%s' into int64: %w", args[2], err)
 			}
 
-			componentNames, err := cmd.Flags().GetStringArray("component-name")
+			inactivityWindow, err := cmd.Flags().GetInt64(flagInactivityWindow)
 			if err != nil {
-				return fmt.Errorf("failed to parse component names: %w", err)
+				return fmt.Errorf("failed to parse inactivity window: %w", err)
 			}
-
-			componentArgs, err := cmd.Flags().GetStringArray("component-args")
+			triggerTime, err := triggerTimeFromFlags(cmd)
 			if err != nil {
-				return fmt.Errorf("failed to parse component arguments: %w", err)
+				return err
 			}
-
-			outputs, err := cmd.Flags().GetStringArray("component-output-type")
+			inactivityDuration, err := cmd.Flags().GetDuration(flagInactivityDuration)
 			if err != nil {
-				return fmt.Errorf("failed to parse component arguments: %w", err)
+				return fmt.Errorf("failed to parse inactivity duration: %w", err)
 			}
-
-			outputsArgs, err := cmd.Flags().GetStringArray("component-output-args")
+			condition, err := conditionFromFlags(cmd)
 			if err != nil {
-				return fmt.Errorf("failed to parse component arguments: %w", err)
+				return err
 			}
-
-			if len(componentNames) != len(componentArgs) {
-				return fmt.Errorf("mismatch between component names and arguments count")
+			modeName, err := cmd.Flags().GetString(flagExecutionMode)
+			if err != nil {
+				return err
 			}
-
-			if len(componentNames) != len(outputs) {
-				return fmt.Errorf("mismatch between component names and outputs count")
+			executionMode, ok := executionModes[modeName]
+			if !ok {
+				return fmt.Errorf("unknown execution mode %q, expected best-effort or atomic", modeName)
 			}
-
-			if len(outputs) != len(outputsArgs) {
-				return fmt.Errorf("mismatch between component outputs and output args count")
+			remoteChannels, err := cmd.Flags().GetStringSlice(flagRemoteChannels)
+			if err != nil {
+				return err
 			}
 
 			var sender string = clientCtx.GetFromAddress().String()
-			var components []*types.ExecutionComponent
-			for i, componentName := range componentNames {
-				componentArg := componentArgs[i]
-				output := outputs[i]
-				outputArgs := outputsArgs[i]
-				// component, err := parseComponent(componentName, componentArg)
-				component, err := parseComponentFromString(componentName, componentArg, output, outputArgs, sender)
-				if err != nil {
-					return fmt.Errorf("failed to parse component: %w", err)
-				}
-				components = append(components, component)
+			components, err := componentsFromFlags(cmd, sender)
+			if err != nil {
+				return err
 			}
 
 			msg := types.MsgCreateWillRequest{
@@ -105,21 +136,244 @@ func CreateWillCmd() *cobra.Command {
 				Beneficiary: args[1],
 				Height:      height,
 				Components:  components,
-			}
 
-			fmt.Println(msg)
+				InactivityWindow: inactivityWindow,
+
+				TriggerTime:        triggerTime,
+				InactivityDuration: inactivityDuration,
+				Condition:          condition,
+				ExecutionMode:      executionMode,
+				RemoteChannels:     remoteChannels,
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	addComponentFlags(cmd)
+	cmd.Flags().Int64(flagInactivityWindow, 0, "Blocks each check-in pushes the trigger height past the check-in block. With a height of 0 the will first triggers one window from now.")
+	cmd.Flags().String(flagTriggerTime, "", "Block time to trigger the will at instead of a height, in RFC 3339 format such as 2030-01-01T00:00:00Z. The height must be 0.")
+	cmd.Flags().Duration(flagInactivityDuration, 0, "Time each check-in pushes the trigger time past the check-in, such as 4320h. Without a trigger time the will first triggers one duration from now.")
+	cmd.Flags().String(flagConditionContract, "", "Contract to query to trigger the will on a condition instead of a height. The height must be 0.")
+	cmd.Flags().String(flagConditionQuery, "", "JSON smart query message of the condition contract")
+	cmd.Flags().String(flagConditionPath, "", "Dot separated path to the value compared within the query result, such as amount.0.amount. Empty compares the whole result.")
+	cmd.Flags().String(flagConditionOperator, "eq", "Comparison of the selected value with the condition value: eq, ne, lt, lte, gt or gte")
+	cmd.Flags().String(flagConditionValue, "true", "JSON value the selected value is compared with")
+	cmd.Flags().Int64(flagConditionInterval, 0, "Blocks between evaluations of the condition, 0 evaluates it every block")
+	cmd.Flags().Uint64(flagConditionGas, 0, "Gas the condition query may use, 0 uses the chain's maximum")
+	cmd.Flags().String(flagExecutionMode, "best-effort", "What a failed component does to the others when the will fires: best-effort keeps the effects of the components that succeed, atomic reverts all of them and returns the escrow to the creator")
+	cmd.Flags().StringSlice(flagRemoteChannels, nil, "Comma separated channels of the will port whose will packets may check in on the will and claim its components, such as channel-0. Without any, only local check-ins and claims are accepted.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// triggerTimeFromFlags parses the trigger time flag, nil when it is not given
+func triggerTimeFromFlags(cmd *cobra.Command) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flagTriggerTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trigger time: %w", err)
+	}
+	if value == "" {
+		return nil, nil
+	}
+	triggerTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trigger time %q, expected RFC 3339: %w", value, err)
+	}
+	return &triggerTime, nil
+}
+
+// conditionFromFlags builds the condition trigger from the condition flags, nil when no
+// condition contract is given
+func conditionFromFlags(cmd *cobra.Command) (*types.WasmCondition, error) {
+	contract, err := cmd.Flags().GetString(flagConditionContract)
+	if err != nil || contract == "" {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return nil, fmt.Errorf("invalid condition contract %q: %w", contract, err)
+	}
+	query, err := cmd.Flags().GetString(flagConditionQuery)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(query)) {
+		return nil, fmt.Errorf("condition query %q must be JSON", query)
+	}
+	path, err := cmd.Flags().GetString(flagConditionPath)
+	if err != nil {
+		return nil, err
+	}
+	operatorName, err := cmd.Flags().GetString(flagConditionOperator)
+	if err != nil {
+		return nil, err
+	}
+	operator, ok := conditionOperators[operatorName]
+	if !ok {
+		return nil, fmt.Errorf("unknown condition operator %q, expected eq, ne, lt, lte, gt or gte", operatorName)
+	}
+	value, err := cmd.Flags().GetString(flagConditionValue)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(value)) {
+		return nil, fmt.Errorf("condition value %q must be JSON, quote strings", value)
+	}
+	interval, err := cmd.Flags().GetInt64(flagConditionInterval)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := cmd.Flags().GetUint64(flagConditionGas)
+	if err != nil {
+		return nil, err
+	}
+	return &types.WasmCondition{
+		Contract: contract,
+		QueryMsg: []byte(query),
+		Path:     path,
+		Operator: operator,
+		Value:    value,
+		Interval: interval,
+		GasLimit: gasLimit,
+	}, nil
+}
+
+// addComponentFlags registers the flags describing will components
+func addComponentFlags(cmd *cobra.Command) {
 	cmd.Flags().StringArray("component-name", []string{}, "Names of the components. Use multiple --component-name flags for multiple components.")
 	cmd.Flags().StringArray("component-args", []string{}, "Arguments for the components. Use multiple --component-args flags for multiple components. Must match the order of --component-name flags.")
 	cmd.Flags().StringArray("component-output-type", []string{}, "Arguments for the outputs of each component. Use multiple --component-output-type flags for multiple component output. Must match the order of --component-output-type flags.")
 	cmd.Flags().StringArray("component-output-args", []string{}, "Arguments for the arguments of each component output. Use multiple --component-output-args flags for multiple components. Must match the order of --component-output-args flags.")
-	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray("component-claim-window", []string{}, "Claim window of each claim component, a number of blocks or a duration such as 720h, empty for none. Given for all components or none.")
+	cmd.Flags().StringArray("component-fallback-type", []string{}, "Output type run when the claim window of each component closes unclaimed, empty for none. Given for all components or none.")
+	cmd.Flags().StringArray("component-fallback-args", []string{}, "Arguments for the fallback output of each component. Must match the order of --component-fallback-type flags.")
+	cmd.Flags().StringArray("component-depends-on", []string{}, "Comma separated positions, counting from 0, of the earlier components each component only runs after, empty for none. Given for all components or none.")
+}
 
-	return cmd
+// componentsFromFlags parses the will components given with the component flags
+func componentsFromFlags(cmd *cobra.Command, sender string) ([]*types.ExecutionComponent, error) {
+	componentNames, err := cmd.Flags().GetStringArray("component-name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component names: %w", err)
+	}
+
+	componentArgs, err := cmd.Flags().GetStringArray("component-args")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component arguments: %w", err)
+	}
+
+	outputs, err := cmd.Flags().GetStringArray("component-output-type")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component arguments: %w", err)
+	}
+
+	outputsArgs, err := cmd.Flags().GetStringArray("component-output-args")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component arguments: %w", err)
+	}
+
+	if len(componentNames) != len(componentArgs) {
+		return nil, fmt.Errorf("mismatch between component names and arguments count")
+	}
+
+	if len(componentNames) != len(outputs) {
+		return nil, fmt.Errorf("mismatch between component names and outputs count")
+	}
+
+	if len(outputs) != len(outputsArgs) {
+		return nil, fmt.Errorf("mismatch between component outputs and output args count")
+	}
+
+	windows, err := cmd.Flags().GetStringArray("component-claim-window")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component claim windows: %w", err)
+	}
+	fallbacks, err := cmd.Flags().GetStringArray("component-fallback-type")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component fallbacks: %w", err)
+	}
+	fallbacksArgs, err := cmd.Flags().GetStringArray("component-fallback-args")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component fallback args: %w", err)
+	}
+	if len(windows) > 0 && len(windows) != len(componentNames) {
+		return nil, fmt.Errorf("mismatch between component names and claim windows count")
+	}
+	if len(windows) != len(fallbacks) || len(fallbacks) != len(fallbacksArgs) {
+		return nil, fmt.Errorf("mismatch between component claim windows, fallbacks and fallback args count")
+	}
+
+	var components []*types.ExecutionComponent
+	for i, componentName := range componentNames {
+		componentArg := componentArgs[i]
+		output := outputs[i]
+		outputArgs := outputsArgs[i]
+		// component, err := parseComponent(componentName, componentArg)
+		component, err := parseComponentFromString(componentName, componentArg, output, outputArgs, sender)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse component: %w", err)
+		}
+		if len(windows) > 0 {
+			if err := parseClaimWindow(component, windows[i], fallbacks[i], fallbacksArgs[i]); err != nil {
+				return nil, fmt.Errorf("failed to parse claim window of component %s: %w", componentName, err)
+			}
+		}
+		components = append(components, component)
+	}
+
+	dependencies, err := cmd.Flags().GetStringArray("component-depends-on")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse component dependencies: %w", err)
+	}
+	if len(dependencies) > 0 && len(dependencies) != len(components) {
+		return nil, fmt.Errorf("mismatch between component names and dependencies count")
+	}
+	for i, dependsOn := range dependencies {
+		if err := parseDependencies(components, i, dependsOn); err != nil {
+			return nil, fmt.Errorf("failed to parse dependencies of component %s: %w", componentNames[i], err)
+		}
+	}
+	return components, nil
+}
+
+// parseDependencies sets the dependencies of the component at index from the positions of
+// earlier components, component IDs are only generated when the components are parsed
+func parseDependencies(components []*types.ExecutionComponent, index int, dependsOn string) error {
+	if dependsOn == "" {
+		return nil
+	}
+	for _, position := range strings.Split(dependsOn, ",") {
+		dependency, err := strconv.Atoi(strings.TrimSpace(position))
+		if err != nil || dependency < 0 || dependency >= index {
+			return fmt.Errorf("invalid dependency %q, expected the position of an earlier component", position)
+		}
+		components[index].DependsOn = append(components[index].DependsOn, components[dependency].Id)
+	}
+	return nil
+}
+
+// parseClaimWindow sets the claim window and fallback output of a claim component, an empty window leaves it unset
+func parseClaimWindow(component *types.ExecutionComponent, window, fallbackType, fallbackArgs string) error {
+	if window == "" {
+		return nil
+	}
+	claim := component.GetClaim()
+	if claim == nil {
+		return fmt.Errorf("only claim components have a claim window")
+	}
+	claim.Window = &types.ClaimWindow{}
+	if blocks, err := strconv.ParseInt(window, 10, 64); err == nil {
+		claim.Window.Blocks = blocks
+	} else if claim.Window.Duration, err = time.ParseDuration(window); err != nil {
+		return fmt.Errorf("invalid claim window %q, expected a number of blocks or a duration", window)
+	}
+	fallback, err := getOutput(fallbackType, strings.Split(fallbackArgs, ","))
+	if err != nil {
+		return fmt.Errorf("invalid fallback output: %w", err)
+	}
+	claim.Fallback = fallback
+	return nil
 }
 
 func generateUniqueComponentID() string {
@@ -194,9 +448,9 @@ func getOutput(outputType string, outputParams []string) (*types.ComponentOutput
 		}, nil
 
 	case "ibc_send":
-		// Assuming outputParams format is "channel,address,denom,amount"
-		if len(outputParams) != 4 {
-			return nil, fmt.Errorf("expected 'channel,address,denom,amount' for ibc_send, got: %s", outputParams)
+		// outputParams format is "channel,address,denom,amount[,memo]", the memo may hold commas
+		if len(outputParams) < 4 {
+			return nil, fmt.Errorf("expected 'channel,address,denom,amount[,memo]' for ibc_send, got: %s", outputParams)
 		}
 		amount, err := strconv.ParseInt(outputParams[3], 10, 64)
 		if err != nil {
@@ -210,6 +464,7 @@ func getOutput(outputType string, outputParams []string) (*types.ComponentOutput
 					Address: outputParams[1],
 					Denom:   outputParams[2],
 					Amount:  &coinAmount,
+					Memo:    strings.Join(outputParams[4:], ","),
 				},
 			},
 		}, nil
@@ -298,7 +553,7 @@ func parseComponentFromString(componentName string, componentData string, output
 	var component types.ExecutionComponent
 	component.Name = componentName
 	component.Id = componentID
-	component.Status = "inactive"
+	component.Status = types.ComponentStatusInactive
 	component.OutputType = output
 	// panic(99)
 	switch rawComponentType {
@@ -325,83 +580,135 @@ func parseComponentFromString(componentName string, componentData string, output
 		}
 
 	case "schnorr":
-		accessParts := strings.Split(rawComponentType, "-")
-		fmt.Println("access parts: ", accessParts)
-		// accessType := accessParts[1]
-		// var addresses = make([]string, 0)
-
-		// if len(accessParts) > 2 && accessType == "private" {
-		// 	for _, accessPart := range accessParts[1:] {
-		// 		fmt.Println("Iterating over access parts")
-		// 		addresses = append(addresses, accessPart)
-		// 	}
-		// }
-
-		dataParts := strings.Split(params, ",")
-		if len(dataParts) != 3 {
-			return nil, fmt.Errorf("invalid schnorr component params, expected 'public key, signature, message'")
+		// claims verify against the public key the component commits to, as printed by the schnorr keygen command
+		publicKey, err := hex.DecodeString(params)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schnorr component params, expected the public key as hex: %w", err)
 		}
-		signature, public_key, message := dataParts[0], dataParts[1], dataParts[2]
-		fmt.Println("INSIDE parseComponentFromString for schnorr: ", public_key, signature, message)
 		component.ComponentType = &types.ExecutionComponent_Claim{
 			Claim: &types.ClaimComponent{
 				Access: parseAccess(accessDetails[0], accessDetails[1:]),
 				SchemeType: &types.ClaimComponent_Schnorr{
 					Schnorr: &types.SchnorrSignature{
-						PublicKey: []byte(public_key),
-						Signature: []byte(signature),
-						Message:   message,
+						PublicKey: publicKey,
 					},
 				},
 			},
 		}
 	case "pedersen":
-		accessParts := strings.Split(rawComponentType, "-")
-		fmt.Println("access parts: ", accessParts)
+		// the hex commitment printed by the pedersen commit command, followed by ",bind" when it is bound to the claimer
 		dataParts := strings.Split(params, ",")
-		if len(dataParts) != 4 {
-			return nil, fmt.Errorf("invalid pedersen component params, expected 'commitment,random factor, value, blinding factor'")
+		if len(dataParts) > 2 || (len(dataParts) == 2 && dataParts[1] != "bind") {
+			return nil, fmt.Errorf("invalid pedersen component params, expected 'commitment[,bind]' with the commitment as hex")
+		}
+		commitment, err := hex.DecodeString(dataParts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pedersen commitment: %w", err)
 		}
-		commitment, random_factor, value, blinding_factor := dataParts[0], dataParts[1], dataParts[2], dataParts[3]
-		fmt.Println("INSIDE parseComponentFromString for pedersen: ", commitment, random_factor, value, blinding_factor)
 		component.ComponentType = &types.ExecutionComponent_Claim{
 			Claim: &types.ClaimComponent{
 				Access: parseAccess(accessDetails[0], accessDetails[1:]),
 				SchemeType: &types.ClaimComponent_Pedersen{
 					Pedersen: &types.PedersenCommitment{
-						// note: removed these from the cli
-						// BlindingFactor: []byte(blinding_factor),
-						// Value:          []byte(value),
-						Commitment: []byte(commitment),
+						Commitment:  commitment,
+						BindClaimer: len(dataParts) == 2,
+					},
+				},
+			},
+		}
+	case "threshold":
+		// the number of guardians that must approve, followed by the hex guardian public keys
+		dataParts := strings.Split(params, ",")
+		if len(dataParts) < 2 {
+			return nil, fmt.Errorf("invalid threshold component params, expected 'threshold,public_key,...' with the keys as hex")
+		}
+		threshold, err := strconv.ParseUint(dataParts[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold: %w", err)
+		}
+		publicKeys := make([][]byte, len(dataParts)-1)
+		for i, part := range dataParts[1:] {
+			if publicKeys[i], err = hex.DecodeString(part); err != nil {
+				return nil, fmt.Errorf("invalid guardian public key %d: %w", i, err)
+			}
+		}
+		component.ComponentType = &types.ExecutionComponent_Claim{
+			Claim: &types.ClaimComponent{
+				Access: parseAccess(accessDetails[0], accessDetails[1:]),
+				SchemeType: &types.ClaimComponent_ThresholdSchnorr{
+					ThresholdSchnorr: &types.ThresholdSchnorr{
+						PublicKeys: publicKeys,
+						Threshold:  uint32(threshold),
 					},
 				},
 			},
 		}
 	case "gnark":
-		accessParts := strings.Split(rawComponentType, "-")
-		fmt.Println("access parts: ", accessParts)
 		dataParts := strings.Split(params, ",")
-		if len(dataParts) != 3 {
-			return nil, fmt.Errorf("invalid gnark component params, expected 'verification key, public inputs, proof'")
+		if len(dataParts) != 2 {
+			return nil, fmt.Errorf("invalid gnark component params, expected 'verification key, public inputs' as hex")
+		}
+		verificationKey, err := hex.DecodeString(dataParts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid gnark verification key: %w", err)
+		}
+		publicInputs, err := hex.DecodeString(dataParts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid gnark public inputs: %w", err)
 		}
-		verification_key, public_inputs, proof := dataParts[0], dataParts[1], dataParts[2]
-		fmt.Println("INSIDE parseComponentFromString for pedersen: ", verification_key, public_inputs, proof)
 		component.ComponentType = &types.ExecutionComponent_Claim{
 			Claim: &types.ClaimComponent{
 				Access: parseAccess(accessDetails[0], accessDetails[1:]),
 				SchemeType: &types.ClaimComponent_Gnark{
 					Gnark: &types.GnarkZkSnark{
-						VerificationKey: []byte(verification_key),
-						PublicInputs:    []byte(public_inputs),
+						VerificationKey: verificationKey,
+						PublicInputs:    publicInputs,
 					},
 				},
 			},
 		}
-	case "ibc_msg":
+	case "custom":
+		// a claim scheme registered with the keeper at app wiring, its data is hex
 		dataParts := strings.Split(params, ",")
-		fmt.Println("Number of ibc send params: ", len(dataParts))
+		if len(dataParts) != 2 {
+			return nil, fmt.Errorf("invalid custom component params, expected 'scheme, data' with the data as hex")
+		}
+		data, err := hex.DecodeString(dataParts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom claim scheme data: %w", err)
+		}
+		component.ComponentType = &types.ExecutionComponent_Claim{
+			Claim: &types.ClaimComponent{
+				Access: parseAccess(accessDetails[0], accessDetails[1:]),
+				SchemeType: &types.ClaimComponent_Custom{
+					Custom: &types.CustomClaimScheme{
+						Scheme: dataParts[0],
+						Data:   data,
+					},
+				},
+			},
+		}
+	case "distribution":
+		// 'address=weight,...;source', the source being coins or balance=denom, append -burn to the
+		// type to burn the rounding remainder instead of paying it to the first beneficiary
+		distribution, err := parseDistribution(params, accessDetails)
+		if err != nil {
+			return nil, err
+		}
+		component.ComponentType = &types.ExecutionComponent_Distribution{Distribution: distribution}
+	case "vesting":
+		// 'to;coins;schedule', the schedule being linear=blocks, cliff=cliff_blocks/blocks or
+		// periodic=blocks:coins|blocks:coins...
+		vesting, err := parseVesting(params)
+		if err != nil {
+			return nil, err
+		}
+		component.ComponentType = &types.ExecutionComponent_Vesting{Vesting: vesting}
+	case "ibc_msg":
+		// the data is the JSON of a will packet, it comes last and may hold commas
+		dataParts := strings.SplitN(params, ",", 3)
 		if len(dataParts) != 3 {
-			return nil, fmt.Errorf("invalid IBC send params, expected 'channel, port_id, data'")
+			return nil, fmt.Errorf("invalid IBC message params, expected 'channel,port_id,will packet JSON'")
 		}
 		channel, port_id, data := dataParts[0], dataParts[1], dataParts[2]
 
@@ -412,14 +719,32 @@ func parseComponentFromString(componentName string, componentData string, output
 				Data:    []byte(data),
 			},
 		}
-	case "ibc_send":
+	case "ica":
+		// the messages are the base64 protobuf encodings of google.protobuf.Any messages
 		dataParts := strings.Split(params, ",")
-
-		fmt.Println("Number of ibc send params: ", len(dataParts))
-		if len(dataParts) != 4 {
-			return nil, fmt.Errorf("invalid IBC send params, expected 'channel, address, denom, amount'")
+		if len(dataParts) < 2 {
+			return nil, fmt.Errorf("invalid ICA params, expected 'connection_id,base64 Any[,base64 Any...]'")
+		}
+		ica := &types.ICAComponent{ConnectionId: dataParts[0]}
+		for _, encoded := range dataParts[1:] {
+			msg, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("invalid base64 ICA message: %v", err)
+			}
+			ica.Msgs = append(ica.Msgs, msg)
+		}
+		component.ComponentType = &types.ExecutionComponent_Ica{Ica: ica}
+	case "ibc_send":
+		// the memo comes last and may hold commas
+		dataParts := strings.SplitN(params, ",", 6)
+		if len(dataParts) < 5 {
+			return nil, fmt.Errorf("invalid IBC send params, expected 'channel,address,port_id,denom,amount[,memo]'")
 		}
 		channel, address, portId, denom, amountStr := dataParts[0], dataParts[1], dataParts[2], dataParts[3], dataParts[4]
+		var memo string
+		if len(dataParts) == 6 {
+			memo = dataParts[5]
+		}
 		amount, err := strconv.ParseInt(string(amountStr), 10, 64)
 		if err != nil {
 			return nil, fmt.Errorf("invalid amount format for IBC send: %v", err)
@@ -433,6 +758,7 @@ func parseComponentFromString(componentName string, componentData string, output
 				PortId:  string(portId),
 				Denom:   string(denom),
 				Amount:  &coinAmount,
+				Memo:    memo,
 			},
 		}
 	default:
@@ -442,15 +768,112 @@ func parseComponentFromString(componentName string, componentData string, output
 	return &component, nil
 }
 
+// parseDistribution parses the shares, source and remainder handling of a distribution component
+func parseDistribution(params string, modifiers []string) (*types.DistributionComponent, error) {
+	parts := strings.Split(params, ";")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid distribution component params, expected 'address=weight,...;coins' or 'address=weight,...;balance=denom'")
+	}
+	distribution := &types.DistributionComponent{}
+	for _, share := range strings.Split(parts[0], ",") {
+		address, weightStr, ok := strings.Cut(share, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid distribution share %q, expected 'address=weight'", share)
+		}
+		weight, err := strconv.ParseUint(weightStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of distribution share %s: %w", address, err)
+		}
+		distribution.Shares = append(distribution.Shares, types.DistributionShare{Address: address, Weight: weight})
+	}
+	if denom, ok := strings.CutPrefix(parts[1], "balance="); ok {
+		distribution.Source = &types.DistributionComponent_BalanceDenom{BalanceDenom: denom}
+	} else {
+		coins, err := sdk.ParseCoinsNormalized(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid distribution coins: %w", err)
+		}
+		distribution.Source = &types.DistributionComponent_Coins{Coins: &types.DistributionCoins{Amount: coins}}
+	}
+	for _, modifier := range modifiers {
+		switch modifier {
+		case "burn":
+			distribution.Remainder = types.DistributionRemainder_DISTRIBUTION_REMAINDER_BURN
+		default:
+			return nil, fmt.Errorf("unknown distribution modifier %q, expected burn", modifier)
+		}
+	}
+	return distribution, nil
+}
+
+// parseVesting parses the beneficiary, amount and schedule of a vesting component
+func parseVesting(params string) (*types.VestingComponent, error) {
+	parts := strings.Split(params, ";")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid vesting component params, expected 'to;coins;schedule'")
+	}
+	amount, err := sdk.ParseCoinsNormalized(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid vesting amount: %w", err)
+	}
+	vesting := &types.VestingComponent{To: parts[0], Amount: amount}
+	kind, schedule, _ := strings.Cut(parts[2], "=")
+	switch kind {
+	case "linear":
+		blocks, err := strconv.ParseInt(schedule, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid linear vesting blocks: %w", err)
+		}
+		vesting.Schedule = &types.VestingComponent_Linear{Linear: &types.LinearVesting{Blocks: blocks}}
+	case "cliff":
+		cliffStr, blocksStr, ok := strings.Cut(schedule, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid cliff vesting schedule %q, expected 'cliff=cliff_blocks/blocks'", schedule)
+		}
+		cliff, err := strconv.ParseInt(cliffStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vesting cliff blocks: %w", err)
+		}
+		blocks, err := strconv.ParseInt(blocksStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cliff vesting blocks: %w", err)
+		}
+		vesting.Schedule = &types.VestingComponent_Cliff{Cliff: &types.CliffVesting{CliffBlocks: cliff, Blocks: blocks}}
+	case "periodic":
+		periodic := &types.PeriodicVesting{}
+		for _, period := range strings.Split(schedule, "|") {
+			blocksStr, coinsStr, ok := strings.Cut(period, ":")
+			if !ok {
+				return nil, fmt.Errorf("invalid vesting period %q, expected 'blocks:coins'", period)
+			}
+			blocks, err := strconv.ParseInt(blocksStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid vesting period blocks: %w", err)
+			}
+			coins, err := sdk.ParseCoinsNormalized(coinsStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid vesting period amount: %w", err)
+			}
+			periodic.Periods = append(periodic.Periods, types.VestingPeriod{Blocks: blocks, Amount: coins})
+		}
+		vesting.Schedule = &types.VestingComponent_Periodic{Periodic: periodic}
+	default:
+		return nil, fmt.Errorf("unknown vesting schedule %q, expected linear, cliff or periodic", kind)
+	}
+	return vesting, nil
+}
+
 func ClaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim [will-id] [claim-type] [claim-data]",
 		Short: "Submit a claim for a will",
 		Long: `Submit a claim for a will with specific data based on the claim type.
 Example:
-./build/wasmd tx will claim "will-id" "component-id" "schnorr" "signature:data" --from alice --chain-id willchain-mainnet -y
-./build/wasmd tx will claim "will-id" "component-id" "pedersen" "commitment:blinding_factor:value" --from alice --chain-id willchain-mainnet -y
-./build/wasmd tx will claim "will-id" "component-id" "gnark" "proof:public_inputs" --from alice --chain-id willchain-mainnet -y`,
+./build/wasmd tx will claim "will-id" "component-id" "schnorr" "signature" --from alice --chain-id willchain-mainnet -y
+./build/wasmd tx will claim "will-id" "component-id" "pedersen" "blinding_factor:value" --from alice --chain-id willchain-mainnet -y
+./build/wasmd tx will claim "will-id" "component-id" "gnark" "proof" --from alice --chain-id willchain-mainnet -y
+./build/wasmd tx will claim "will-id" "component-id" "threshold" "signer,...:signature" --from alice --chain-id willchain-mainnet -y
+./build/wasmd tx will claim "will-id" "component-id" "custom" "data" --from alice --chain-id willchain-mainnet -y`,
 		Args: cobra.ExactArgs(4), // Ensuring exactly 3 arguments
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -467,72 +890,106 @@ Example:
 			var msg *types.MsgClaimRequest
 			switch claimType {
 			case "schnorr":
-				// Parse the claim data for SchnorrClaim
-				parts := strings.Split(claimData, ":")
-				fmt.Println(parts)
-				if len(parts) != 3 {
-					return fmt.Errorf("invalid data format for Schnorr claim, expected 'signature:data'")
+				// the hex signature over the claim digest, as printed by the schnorr sign command
+				signature, err := hex.DecodeString(claimData)
+				if err != nil {
+					return fmt.Errorf("invalid schnorr signature: %w", err)
 				}
-
-				// pubKeyEncoded, _ := hex.DecodeString(parts[0])
-				// signatureEncoded, _ := hex.DecodeString(parts[1])
-				// messageEncoded, _ := hex.DecodeString(parts[2])
-
 				msg = &types.MsgClaimRequest{
 					WillId:      willID,
 					Claimer:     clientCtx.GetFromAddress().String(),
 					ComponentId: componentID,
 					ClaimType: &types.MsgClaimRequest_SchnorrClaim{
 						SchnorrClaim: &types.SchnorrClaim{
-							Signature: []byte(parts[0]),
-							PublicKey: []byte(parts[1]),
-							Message:   parts[2],
-
-							// PublicKey: pubKeyEncoded,
-							// Signature: signatureEncoded,
-							// Message:   messageEncoded,
+							Signature: signature,
 						},
 					},
 				}
 
 			case "pedersen":
-				// Parse the claim data for PedersenClaim
+				// the hex blinding factor and value printed by the pedersen commit command
 				parts := strings.Split(claimData, ":")
-				if len(parts) != 3 {
-					return fmt.Errorf("invalid data format for Pedersen claim, expected 'commitment:blinding_factor:value'")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid data format for Pedersen claim, expected 'blinding_factor:value' as hex")
+				}
+				blindingFactor, err := hex.DecodeString(parts[0])
+				if err != nil {
+					return fmt.Errorf("invalid pedersen blinding factor: %w", err)
+				}
+				value, err := hex.DecodeString(parts[1])
+				if err != nil {
+					return fmt.Errorf("invalid pedersen value: %w", err)
 				}
-				// Additional parsing and validation of parts[0], parts[1], and parts[2] needed here
 				msg = &types.MsgClaimRequest{
 					WillId:      willID,
 					Claimer:     clientCtx.GetFromAddress().String(),
 					ComponentId: componentID,
 					ClaimType: &types.MsgClaimRequest_PedersenClaim{
 						PedersenClaim: &types.PedersenClaim{
-							Commitment:     []byte(parts[0]),
-							BlindingFactor: []byte(parts[1]),
-							Value:          []byte(parts[2]),
+							BlindingFactor: blindingFactor,
+							Value:          value,
 						},
 					},
 				}
 
 			case "gnark":
-				// Parse the claim data for GnarkClaim
-				parts := strings.Split(claimData, ":")
-				if len(parts) != 2 {
-					return fmt.Errorf("invalid data format for Gnark claim, expected 'proof:public_inputs'")
+				// the proof is hex, a gnark Proof written with WriteRawTo, the will pins the public inputs
+				proof, err := hex.DecodeString(claimData)
+				if err != nil {
+					return fmt.Errorf("invalid gnark proof: %w", err)
 				}
-				// Additional parsing and validation of parts[0] and parts[1] needed here
 				msg = &types.MsgClaimRequest{
-					WillId:  willID,
-					Claimer: clientCtx.GetFromAddress().String(),
+					WillId:      willID,
+					Claimer:     clientCtx.GetFromAddress().String(),
+					ComponentId: componentID,
 					ClaimType: &types.MsgClaimRequest_GnarkClaim{
 						GnarkClaim: &types.GnarkClaim{
-							Proof:        []byte(parts[0]),
-							PublicInputs: []byte(parts[1]),
+							Proof: proof,
+						},
+					},
+				}
+
+			case "threshold":
+				// the approving guardian indices and their hex aggregate signature, as printed by the schnorr aggregate command
+				parts := strings.Split(claimData, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid data format for threshold claim, expected 'signer,...:signature'")
+				}
+				signers, err := parseSigners(parts[0])
+				if err != nil {
+					return err
+				}
+				signature, err := hex.DecodeString(parts[1])
+				if err != nil {
+					return fmt.Errorf("invalid threshold signature: %w", err)
+				}
+				msg = &types.MsgClaimRequest{
+					WillId:      willID,
+					Claimer:     clientCtx.GetFromAddress().String(),
+					ComponentId: componentID,
+					ClaimType: &types.MsgClaimRequest_ThresholdSchnorrClaim{
+						ThresholdSchnorrClaim: &types.ThresholdSchnorrClaim{
+							Signers:   signers,
+							Signature: signature,
 						},
 					},
 				}
 
+			case "custom":
+				// claims on components of a custom claim scheme carry hex data for its verifier
+				data, err := hex.DecodeString(claimData)
+				if err != nil {
+					return fmt.Errorf("invalid custom claim data: %w", err)
+				}
+				msg = &types.MsgClaimRequest{
+					WillId:      willID,
+					Claimer:     clientCtx.GetFromAddress().String(),
+					ComponentId: componentID,
+					ClaimType: &types.MsgClaimRequest_CustomClaim{
+						CustomClaim: &types.CustomClaim{Data: data},
+					},
+				}
+
 			default:
 				return fmt.Errorf("unsupported claim type: %s", claimType)
 			}
@@ -549,30 +1006,15 @@ Example:
 func CheckInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "Checkin [will-id]",
-		Short:   "Submit a checkin to the will",
-		Aliases: []string{"cw"},
+		Short:   "Submit a checkin to the will, pushing its trigger height forward by its inactivity window",
+		Aliases: []string{"cw", "checkin"},
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			// msg, err := parseStoreCodeArgs(args[0], clientCtx.GetFromAddress().String(), cmd.Flags())
-			// if err != nil {
-			// 	return err
-			// }
-			fmt.Println("inside tx Checkin command 1")
-			//
-			// logger := log.Logger{}
-			// logger := log.NewTestLogger(t)
-			logger := network.NewCLILogger(cmd)
-			logger.Log("inside tx Checkin command 2")
-			logger.Log(string(args[0]))
-			// willId, err := strconv.ParseUint(args[0], 10, 64)
 			willId := args[0]
-			if err != nil {
-				return fmt.Errorf("failed to parse will ID: %w", err)
-			}
 
 			msg := types.MsgCheckInRequest{
 				Creator: clientCtx.GetFromAddress().String(),
@@ -587,3 +1029,184 @@ func CheckInCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// FundWillCmd tops up the escrow of a will
+func FundWillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund [will-id] [amount]",
+		Short:   "Move coins from the will creator into the will escrow",
+		Aliases: []string{"fw"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+
+			msg := types.MsgFundWillRequest{
+				Creator: clientCtx.GetFromAddress().String(),
+				Id:      args[0],
+				Amount:  amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateWillCmd changes the beneficiary, trigger height or time, or components of a live will
+func UpdateWillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [will-id]",
+		Short: "Update the beneficiary, trigger height or time, or components of a live will",
+		Long:  "Update a live will. Omitted flags keep the current setting, passing any component flags replaces all components.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			beneficiary, err := cmd.Flags().GetString(flagBeneficiary)
+			if err != nil {
+				return fmt.Errorf("failed to parse beneficiary: %w", err)
+			}
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return fmt.Errorf("failed to parse height: %w", err)
+			}
+			triggerTime, err := triggerTimeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			components, err := componentsFromFlags(cmd, sender)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateWillRequest{
+				Creator:     sender,
+				Id:          args[0],
+				Beneficiary: beneficiary,
+				Height:      height,
+				Components:  components,
+				TriggerTime: triggerTime,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	addComponentFlags(cmd)
+	cmd.Flags().String(flagBeneficiary, "", "New beneficiary of the will")
+	cmd.Flags().Int64(flagHeight, 0, "New trigger height of the will")
+	cmd.Flags().String(flagTriggerTime, "", "New trigger time of a will with a trigger time, in RFC 3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelWillCmd revokes a live will and returns its escrow to the creator
+func CancelWillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [will-id]",
+		Short: "Cancel a live will and return its escrow to the creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgCancelWillRequest{
+				Creator: clientCtx.GetFromAddress().String(),
+				Id:      args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// WithdrawVestedCmd withdraws the coins that vested from a vesting component
+func WithdrawVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-vested [will-id] [component-id]",
+		Short: "Withdraw the coins that vested from a vesting component of a will",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgWithdrawVestedRequest{
+				Beneficiary: clientCtx.GetFromAddress().String(),
+				WillId:      args[0],
+				ComponentId: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ExecuteWillCmd executes a due will for the executor bounty
+func ExecuteWillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [will-id]",
+		Short: "Execute a will that is due, paying for its components' gas in return for a bounty from its creation deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgExecuteWillRequest{
+				Executor: clientCtx.GetFromAddress().String(),
+				WillId:   args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SendWillPacketCmd sends a check-in, claim or status query to another chain over the will port
+func SendWillPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-packet [channel] [packet-json]",
+		Short: "Send a check-in, claim or status query to the will module of another chain",
+		Long: `Send a will packet over a channel of the will port. The packet acts for the sender, and the
+other chain accepts a check-in or claim only over a channel the will lists as a remote channel.
+The answer to a status query is emitted in a will_status_ack event once it is acknowledged.
+Example:
+./build/wasmd tx will send-packet channel-0 '{"check_in":{"will_id":"did:will:..."}}'
+./build/wasmd tx will send-packet channel-0 '{"status_query":{"will_id":"did:will:..."}}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgSendWillPacketRequest{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Channel: args[0],
+				Data:    []byte(args[1]),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -64,13 +64,14 @@ func (i IBCModule) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	fmt.Println("IBC DEBUG: NewIBCModule OnAcknowledgementPacket")
-
-	// TODO: check type of ack
-	fmt.Println(packet)
-	fmt.Println(acknowledgement)
-	fmt.Println(relayer)
-
+	msg := types.IBCPacketAckMsg{
+		Acknowledgement: types.IBCAcknowledgement{Data: acknowledgement},
+		OriginalPacket:  newIBCPacket(packet),
+		Relayer:         relayer.String(),
+	}
+	if err := i.keeper.OnAckPacket(ctx, msg); err != nil {
+		return errorsmod.Wrap(err, "on acknowledgement")
+	}
 	return nil
 }
 
@@ -97,61 +98,40 @@ func newIBCPacket(packet channeltypes.Packet) types.IBCPacket {
 
 // OnChanCloseConfirm implements the IBCModule interface
 func (i IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
-	fmt.Println("IBC DEBUG: OnChanCloseConfirm")
-	// // counterparty has closed the channel
-	// contractAddr, err := keeper.ContractFromPortID(portID)
-	// if err != nil {
-	// 	return errorsmod.Wrapf(err, "contract port id")
-	// }
-	// channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
-	// if !ok {
-	// 	return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
-	// }
-	// appVersion, ok := i.appVersionGetter.GetAppVersion(ctx, portID, channelID)
-	// if !ok {
-	// 	return errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "port ID (%s) channel ID (%s)", portID, channelID)
-	// }
-
-	// msg := willtypes.IBCChannelCloseMsg{
-	// 	CloseConfirm: &willtypes.IBCCloseConfirm{Channel: toWasmVMChannel(portID, channelID, channelInfo, appVersion)},
-	// }
-	// err = i.keeper.OnCloseChannel(ctx, contractAddr, msg)
-	// if err != nil {
-	// 	return err
-	// }
-	// // emit events?
-
-	// return err
-	return nil
+	// counterparty has closed the channel
+	channel, err := i.closedChannel(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	msg := types.IBCChannelCloseMsg{
+		CloseConfirm: &types.IBCCloseConfirm{Channel: channel},
+	}
+	return i.keeper.OnCloseChannel(ctx, msg)
 }
 
 // OnChanCloseInit implements the IBCModule interface
 func (i IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
-	fmt.Println("IBC DEBUG: OnChanCloseInit")
-	// contractAddr, err := keeper.ContractFromPortID(portID)
-	// if err != nil {
-	// 	return errorsmod.Wrapf(err, "contract port id")
-	// }
-	// channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
-	// if !ok {
-	// 	return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
-	// }
-	// appVersion, ok := i.appVersionGetter.GetAppVersion(ctx, portID, channelID)
-	// if !ok {
-	// 	return errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "port ID (%s) channel ID (%s)", portID, channelID)
-	// }
-
-	// msg := willtypes.IBCChannelCloseMsg{
-	// 	CloseInit: &willtypes.IBCCloseInit{Channel: toWasmVMChannel(portID, channelID, channelInfo, appVersion)},
-	// }
-	// err = i.keeper.OnCloseChannel(ctx, contractAddr, msg)
-	// if err != nil {
-	// 	return err
-	// }
-	// // emit events?
+	channel, err := i.closedChannel(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+	msg := types.IBCChannelCloseMsg{
+		CloseInit: &types.IBCCloseInit{Channel: channel},
+	}
+	return i.keeper.OnCloseChannel(ctx, msg)
+}
 
-	// return err
-	return nil
+// closedChannel returns the will channel of a channel that is closing
+func (i IBCModule) closedChannel(ctx sdk.Context, portID, channelID string) (types.IBCChannel, error) {
+	channelInfo, ok := i.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !ok {
+		return types.IBCChannel{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	appVersion, ok := i.appVersionGetter.GetAppVersion(ctx, portID, channelID)
+	if !ok {
+		return types.IBCChannel{}, errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	return toWillChannel(portID, channelID, channelInfo, appVersion), nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -392,11 +372,11 @@ func (i IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet,
 func toWillChannel(portID, channelID string, channelInfo channeltypes.Channel, appVersion string) types.IBCChannel {
 	fmt.Println("IBC DEBUG: toWillChannel")
 	return types.IBCChannel{
-		// Endpoint:             types.IBCEndpoint{PortID: portID, ChannelID: channelID},
-		// CounterpartyEndpoint: types.IBCEndpoint{PortID: channelInfo.Counterparty.PortId, ChannelID: channelInfo.Counterparty.ChannelId},
-		Order:        channelInfo.Ordering.String(),
-		Version:      appVersion,
-		ConnectionID: channelInfo.ConnectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
+		Endpoint:             types.IBCEndpoint{PortID: portID, ChannelID: channelID},
+		CounterpartyEndpoint: types.IBCEndpoint{PortID: channelInfo.Counterparty.PortId, ChannelID: channelInfo.Counterparty.ChannelId},
+		Order:                channelInfo.Ordering.String(),
+		Version:              appVersion,
+		ConnectionID:         channelInfo.ConnectionHops[0], // At the moment this list must be of length 1. In the future multi-hop channels may be supported.
 	}
 }
 
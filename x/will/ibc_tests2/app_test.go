@@ -5,23 +5,23 @@ import (
 	"testing"
 	"time"
 
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
-	dbm "github.com/cosmos/cosmos-db"
-	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
-	corestore "cosmossdk.io/store"
-	storemetrics "cosmossdk.io/store/metrics"
-	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	//
 	"github.com/CosmWasm/wasmd/app"
@@ -37,57 +37,37 @@ type IBCTestSuite struct {
 }
 
 func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
-	w3llApp := app.Setup(t)
-	mockedCodec := w3llApp.AppCodec()
-	// Initialize DB and store
-	memDB := dbm.NewMemDB()
-	ms := corestore.NewCommitMultiStore(memDB, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
-	ibcStoreKey := storetypes.NewKVStoreKey(ibctransfertypes.StoreKey)    // IBC store key
-	ibcExportedStoreKey := storetypes.NewKVStoreKey(ibcexported.StoreKey) // IBC store key
-	storeservice := runtime.NewKVStoreService(storeKey)
-	// memKey := storetypes.NewMemoryStoreKey("mem_capability") // Memory key for capability
-
-	// Create and mount store keys
-	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, memDB)
-	ms.MountStoreWithDB(ibcStoreKey, storetypes.StoreTypeIAVL, memDB)         // Mount the IBC store
-	ms.MountStoreWithDB(ibcExportedStoreKey, storetypes.StoreTypeIAVL, memDB) // Mount the IBC store
-	require.NoError(t, ms.LoadLatestVersion())
-
-	// Create context
-	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
-
-	// capabilityKeeper := capabilitykeeper.NewKeeper(mockedCodec, storeKey, memKey)
-
-	// Initialize keeper with the store key
-	fmt.Println("IBC TESTING LOGS")
-	fmt.Println(w3llApp.IBCKeeper.ChannelKeeper)
-	fmt.Println(w3llApp.ScopedWillKeeper)
-	fmt.Println(w3llApp.ScopedIBCKeeper)
-	fmt.Println(w3llApp.CapabilityKeeper)
-
-	// scopedWillKeeper := w3llApp.CapabilityKeeper.ScopeToModule(willtypes.ModuleName)
-	// scopedIBCKeeper := w3llApp.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	w3llApp := app.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, "willchain-testing", nil, balance)
+	// build the keeper over the app's will store so it shares the app's IBC state
 	k := keeper.NewKeeper(
-		mockedCodec,
-		storeservice,
-		nil,
+		w3llApp.AppCodec(),
+		runtime.NewKVStoreService(w3llApp.GetKey(types.StoreKey)),
+		log.NewTestLogger(t),
 		w3llApp.IBCKeeper.ChannelKeeper,
 		w3llApp.IBCKeeper.PortKeeper,
-
-		// scopedWillKeeper,
-		// scopedIBCKeeper,
-
 		w3llApp.ScopedWillKeeper,
 		w3llApp.ScopedIBCKeeper,
-
 		*w3llApp.CapabilityKeeper,
 		w3llApp.WasmKeeper,
 		w3llApp.BankKeeper,
 		w3llApp.PermissionedWasmKeeper,
+		w3llApp.AccountKeeper,
+		w3llApp.TransferKeeper,
+		w3llApp.ICAControllerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	return &k, ctx
+	return &k, w3llApp.BaseApp.NewContext(false)
 }
 
 func (suite *IBCTestSuite) SetupTest() {
@@ -212,29 +192,18 @@ func TestSendIBCMessage(t *testing.T) {
 	// Setup
 	keeper, ctx := setupKeeper(t)
 
-	// Define your test channel ID, port ID, and data
-	channelID := "channel-0"
-	portID := "port-0"
-	data := []byte("test data")
-
-	// Act
-	fmt.Println("CTX:")
-	// fmt.Println(ctx)
-	fmt.Println(keeper.ChannelKeeper.GetNextSequenceSend(ctx, portID, channelID))
-	err := keeper.SendIBCMessage(ctx, channelID, portID, data)
-	require.NoError(t, err, "SendIBCMessage should not error")
-
-	// Here you would check for effects of sending the message.
-	// In an actual blockchain environment, you would check state changes or events.
-	// For this test environment, you might check if state entries expected to change have changed,
-	// if events are emitted, or other side effects depending on what SendIBCMessage does.
-
-	// Example: Check if a packet has been sent (mocking or state inspection)
-	// This part is highly dependent on how your app is structured and what tools or mock setups you have available.
-	// For an actual chain, you'd look into the state storage or events to see if the packet send operation has been initiated.
-
-	// Assert
-	// Verify that SendPacket was called on the mock with expected arguments
+	will := types.Will{ID: "will-1", Creator: "creator-address"}
+	component := &types.ExecutionComponent{
+		Id: "notify",
+		ComponentType: &types.ExecutionComponent_IbcMsg{IbcMsg: &types.IBCMsgComponent{
+			Channel: "channel-0",
+			Data:    []byte(`{"status_query":{"will_id":"will-1"}}`),
+		}},
+	}
+
+	// Act: no channel was opened on the will port, so the keeper holds no capability to send over it
+	err := keeper.SendIBCMessage(ctx, component, will)
+	require.ErrorIs(t, err, channeltypes.ErrChannelCapabilityNotFound)
 }
 
 func TestMyIBCTestSuite(t *testing.T) {
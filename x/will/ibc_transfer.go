@@ -0,0 +1,74 @@
+package will
+
+import (
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	willkeeper "github.com/CosmWasm/wasmd/x/will/keeper"
+)
+
+var _ porttypes.IBCModule = TransferMiddleware{}
+
+// TransferMiddleware wraps the ICS-20 transfer application to settle the IBC send components of
+// wills once their transfers are acknowledged or time out. The transfer application refunds the
+// sender of a transfer that failed, the middleware updates the components or schedules their
+// transfers to be sent again.
+type TransferMiddleware struct {
+	porttypes.IBCModule
+	keeper willkeeper.Keeper
+}
+
+// NewTransferMiddleware creates a TransferMiddleware on top of the transfer application
+func NewTransferMiddleware(app porttypes.IBCModule, k willkeeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (m TransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+	m.settle(ctx, packet, ack.Success(), ack.GetError())
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (m TransferMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	// the transfer is sent again or the component fails, according to its retry policy
+	if err := m.keeper.TimeoutPacket(ctx, packet.SourceChannel, packet.Sequence); err != nil {
+		m.logFailure(ctx, packet, err)
+	}
+	return nil
+}
+
+// settle settles the will component that sent a packet
+func (m TransferMiddleware) settle(ctx sdk.Context, packet channeltypes.Packet, success bool, reason string) {
+	if err := m.keeper.SettlePacket(ctx, packet.SourceChannel, packet.Sequence, success, reason); err != nil {
+		m.logFailure(ctx, packet, err)
+	}
+}
+
+// logFailure logs that the will component of a transfer could not be updated. The refund of the
+// transfer must not be held up by the will, so the failure is only logged.
+func (m TransferMiddleware) logFailure(ctx sdk.Context, packet channeltypes.Packet, err error) {
+	ctx.Logger().Error("settling will component transfer failed", "channel", packet.SourceChannel, "sequence", packet.Sequence, "err", err)
+}
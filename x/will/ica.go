@@ -0,0 +1,123 @@
+package will
+
+import (
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	willkeeper "github.com/CosmWasm/wasmd/x/will/keeper"
+)
+
+var _ porttypes.IBCModule = ICAAuthModule{}
+
+// ICAAuthModule is the application under the interchain accounts controller middleware. The
+// controller routes the callbacks of the interchain accounts the will module registered for ICA
+// components to it, and the module settles the components once their transactions are
+// acknowledged or time out. The controller runs the channel handshakes, so the module accepts
+// them as they are.
+type ICAAuthModule struct {
+	keeper willkeeper.Keeper
+}
+
+// NewICAAuthModule creates an ICAAuthModule
+func NewICAAuthModule(k willkeeper.Keeper) ICAAuthModule {
+	return ICAAuthModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (ICAAuthModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (ICAAuthModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (ICAAuthModule) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (ICAAuthModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (ICAAuthModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "interchain account channels cannot be closed")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (m ICAAuthModule) OnChanCloseConfirm(ctx sdk.Context, _, channelID string) error {
+	return m.keeper.CloseChannel(ctx, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface, the controller never receives packets
+func (ICAAuthModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (m ICAAuthModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		m.settle(ctx, packet, false, "invalid acknowledgement")
+		return nil
+	}
+	m.settle(ctx, packet, ack.Success(), ack.GetError())
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The ordered channel of the interchain
+// account closes on a timeout, so the transaction fails its component. The account is registered
+// again when a will needs it next.
+func (m ICAAuthModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	if err := m.keeper.TimeoutPacket(ctx, packet.SourceChannel, packet.Sequence); err != nil {
+		m.logFailure(ctx, packet, err)
+	}
+	return nil
+}
+
+// settle settles the ICA component that sent a transaction
+func (m ICAAuthModule) settle(ctx sdk.Context, packet channeltypes.Packet, success bool, reason string) {
+	if err := m.keeper.SettlePacket(ctx, packet.SourceChannel, packet.Sequence, success, reason); err != nil {
+		m.logFailure(ctx, packet, err)
+	}
+}
+
+// logFailure logs that the ICA component of a transaction could not be updated. The controller
+// must still complete the packet lifecycle, so the failure is only logged.
+func (m ICAAuthModule) logFailure(ctx sdk.Context, packet channeltypes.Packet, err error) {
+	ctx.Logger().Error("settling will ICA component failed", "channel", packet.SourceChannel, "sequence", packet.Sequence, "err", err)
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/pedersen"
+	"github.com/CosmWasm/wasmd/x/will/schemes/schnorr"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// ClaimScheme verifies claims on the claim components of one scheme. Schemes are
+// registered with the keeper at app wiring, see WithClaimSchemes. A custom scheme
+// stores its component data in types.CustomClaimScheme and receives its claims as
+// types.CustomClaim.
+type ClaimScheme interface {
+	// Name is the name components refer to the scheme by, see types.ClaimSchemeName
+	Name() string
+	// ValidateComponent checks the scheme data of a claim component when a will is created or updated
+	ValidateComponent(component *types.ClaimComponent) error
+	// GasCost returns the gas charged before a claim is verified
+	GasCost(component *types.ClaimComponent, msg *types.MsgClaimRequest) uint64
+	// VerifyClaim returns an error unless the claim proves the component
+	VerifyClaim(ctx context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error
+}
+
+// NullifyingClaimScheme is a claim scheme whose proofs must not be used twice. The keeper
+// rejects a claim whose nullifier it has recorded, and records the nullifier of every
+// claim it accepts.
+type NullifyingClaimScheme interface {
+	ClaimScheme
+	// Nullifier returns the value identifying the proof of a claim
+	Nullifier(component *types.ClaimComponent, msg *types.MsgClaimRequest) ([]byte, error)
+}
+
+// DefaultClaimSchemes returns the claim schemes built into the module
+func DefaultClaimSchemes() []ClaimScheme {
+	return []ClaimScheme{schnorrClaimScheme{}, pedersenClaimScheme{}, gnarkClaimScheme{}, thresholdSchnorrClaimScheme{}}
+}
+
+// claimScheme returns the scheme registered for a claim component
+func (k Keeper) claimScheme(component *types.ClaimComponent) (ClaimScheme, error) {
+	name, err := types.ClaimSchemeName(component)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalid, err.Error())
+	}
+	scheme, ok := k.claimSchemes[name]
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalid, "no claim scheme registered for %q", name)
+	}
+	return scheme, nil
+}
+
+// checkNullifier returns the nullifier of a claim, or an error if an accepted claim used it
+func (k Keeper) checkNullifier(ctx context.Context, scheme NullifyingClaimScheme, component *types.ClaimComponent, msg *types.MsgClaimRequest) ([]byte, error) {
+	nullifier, err := scheme.Nullifier(component, msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(nullifier) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidProof, "empty nullifier")
+	}
+	used, err := k.nullifiers.Has(ctx, collections.Join(scheme.Name(), nullifier))
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		return nil, errors.Wrapf(types.ErrProofUsed, "%s nullifier %X", scheme.Name(), nullifier)
+	}
+	return nullifier, nil
+}
+
+const (
+	// GasSchnorrVerify is charged to verify a Schnorr claim
+	GasSchnorrVerify uint64 = 1_000
+	// GasPedersenVerify is charged to verify a Pedersen claim
+	GasPedersenVerify uint64 = 1_000
+)
+
+// schnorrClaimScheme verifies Schnorr signatures over edwards25519 made with the public key
+// the component commits to. The signed message is types.SchnorrClaimDigest of the claim, and
+// every accepted signature is nullified so it cannot be submitted again.
+type schnorrClaimScheme struct{}
+
+func (schnorrClaimScheme) Name() string { return types.ClaimSchemeSchnorr }
+
+func (schnorrClaimScheme) ValidateComponent(component *types.ClaimComponent) error {
+	stored := component.GetSchnorr()
+	if stored == nil {
+		return errors.Wrap(types.ErrInvalid, "schnorr public key not found in the component")
+	}
+	if _, err := schnorr.UnmarshalPublicKey(stored.PublicKey); err != nil {
+		return errors.Wrapf(types.ErrInvalid, "schnorr public key: %s", err)
+	}
+	return nil
+}
+
+func (schnorrClaimScheme) GasCost(*types.ClaimComponent, *types.MsgClaimRequest) uint64 {
+	return GasSchnorrVerify
+}
+
+func (schnorrClaimScheme) VerifyClaim(ctx context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error {
+	claim := msg.GetSchnorrClaim()
+	if claim == nil {
+		return errors.Wrapf(types.ErrInvalid, "expected a schnorr claim, got %T", msg.ClaimType)
+	}
+	stored := component.GetSchnorr()
+	publicKey, err := schnorr.UnmarshalPublicKey(stored.PublicKey)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "schnorr public key: %s", err)
+	}
+	if len(claim.PublicKey) != 0 && !bytes.Equal(claim.PublicKey, stored.PublicKey) {
+		return errors.Wrap(types.ErrInvalidProof, "claim public key differs from the one the will commits to")
+	}
+	signature, err := schnorr.UnmarshalSignature(claim.Signature)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "schnorr signature: %s", err)
+	}
+	digest := types.SchnorrClaimDigest(sdk.UnwrapSDKContext(ctx).ChainID(), msg.WillId, msg.ComponentId, msg.Claimer)
+	if !schnorr.VerifyMessage(digest, signature, publicKey) {
+		return errors.Wrap(types.ErrInvalidProof, "schnorr signature verification failed")
+	}
+	return nil
+}
+
+// Nullifier is the signature itself, its encoding is canonical
+func (schnorrClaimScheme) Nullifier(_ *types.ClaimComponent, msg *types.MsgClaimRequest) ([]byte, error) {
+	claim := msg.GetSchnorrClaim()
+	if claim == nil {
+		return nil, errors.Wrapf(types.ErrInvalid, "expected a schnorr claim, got %T", msg.ClaimType)
+	}
+	return claim.Signature, nil
+}
+
+// pedersenClaimScheme verifies that a claim opens the Pedersen commitment of the
+// component: the revealed value and blinding factor recompute it with the fixed
+// generator of the pedersen scheme, bound to the claimer if the component asks so.
+type pedersenClaimScheme struct{}
+
+func (pedersenClaimScheme) Name() string { return types.ClaimSchemePedersen }
+
+func (pedersenClaimScheme) ValidateComponent(component *types.ClaimComponent) error {
+	stored := component.GetPedersen()
+	if stored == nil {
+		return errors.Wrap(types.ErrInvalid, "pedersen commitment not found in the component")
+	}
+	if _, err := pedersen.UnmarshalCommitment(stored.Commitment); err != nil {
+		return errors.Wrapf(types.ErrInvalid, "pedersen commitment: %s", err)
+	}
+	return nil
+}
+
+func (pedersenClaimScheme) GasCost(*types.ClaimComponent, *types.MsgClaimRequest) uint64 {
+	return GasPedersenVerify
+}
+
+func (pedersenClaimScheme) VerifyClaim(_ context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error {
+	claim := msg.GetPedersenClaim()
+	if claim == nil {
+		return errors.Wrapf(types.ErrInvalid, "expected a pedersen claim, got %T", msg.ClaimType)
+	}
+	stored := component.GetPedersen()
+	commitment, err := pedersen.UnmarshalCommitment(stored.Commitment)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "pedersen commitment: %s", err)
+	}
+	blindingFactor, err := pedersen.UnmarshalScalar(claim.BlindingFactor)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "blinding factor: %s", err)
+	}
+	value, err := pedersen.UnmarshalScalar(claim.Value)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "value: %s", err)
+	}
+	var claimer string
+	if stored.BindClaimer {
+		claimer = msg.Claimer
+	}
+	if !pedersen.Verify(&commitment, &blindingFactor, &value, claimer) {
+		return errors.Wrap(types.ErrInvalidProof, "the claim does not open the commitment")
+	}
+	return nil
+}
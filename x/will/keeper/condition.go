@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// validateCondition checks the contract, query and comparison of a condition trigger, and that
+// its query fits in the max condition gas
+func validateCondition(condition *types.WasmCondition, maxGas uint64) error {
+	if maxGas == 0 {
+		return errors.Wrap(types.ErrInvalid, "condition triggers are disabled")
+	}
+	if _, err := sdk.AccAddressFromBech32(condition.Contract); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "condition contract %s: %s", condition.Contract, err)
+	}
+	if !json.Valid(condition.QueryMsg) {
+		return errors.Wrap(types.ErrInvalid, "condition query message must be JSON")
+	}
+	if condition.Path != "" {
+		for _, segment := range strings.Split(condition.Path, ".") {
+			if segment == "" {
+				return errors.Wrapf(types.ErrInvalid, "condition path %q has an empty segment", condition.Path)
+			}
+		}
+	}
+	if _, ok := types.ConditionOperator_name[int32(condition.Operator)]; !ok {
+		return errors.Wrapf(types.ErrInvalid, "unknown condition operator %d", condition.Operator)
+	}
+	value, err := decodeConditionJSON([]byte(condition.Value))
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "condition value %q must be JSON: %s", condition.Value, err)
+	}
+	if isOrderingOperator(condition.Operator) {
+		if _, ok := conditionNumber(value); !ok {
+			return errors.Wrapf(types.ErrInvalid, "condition value %q must be a number to compare with %s", condition.Value, condition.Operator)
+		}
+	}
+	if condition.Interval < 0 {
+		return errors.Wrapf(types.ErrInvalid, "condition interval %d must not be negative", condition.Interval)
+	}
+	if condition.GasLimit > maxGas {
+		return errors.Wrapf(types.ErrInvalid, "condition gas limit %d is above the maximum of %d", condition.GasLimit, maxGas)
+	}
+	if condition.NextCheckHeight != 0 {
+		return errors.Wrap(types.ErrInvalid, "the next check height of a condition is set by the chain")
+	}
+	return nil
+}
+
+// conditionInterval returns the number of blocks between the evaluations of a condition
+func conditionInterval(condition *types.WasmCondition) int64 {
+	return max(condition.Interval, 1)
+}
+
+// EvaluateCondition selects the value at the path of a condition from the JSON result of its
+// query and compares it with the condition value
+func EvaluateCondition(condition *types.WasmCondition, result []byte) (bool, error) {
+	document, err := decodeConditionJSON(result)
+	if err != nil {
+		return false, errors.Wrapf(types.ErrInvalid, "condition query result: %s", err)
+	}
+	selected, err := selectConditionValue(document, condition.Path)
+	if err != nil {
+		return false, err
+	}
+	value, err := decodeConditionJSON([]byte(condition.Value))
+	if err != nil {
+		return false, errors.Wrapf(types.ErrInvalid, "condition value %q: %s", condition.Value, err)
+	}
+	switch condition.Operator {
+	case types.ConditionOperator_CONDITION_OPERATOR_EQUAL:
+		return conditionValuesEqual(selected, value), nil
+	case types.ConditionOperator_CONDITION_OPERATOR_NOT_EQUAL:
+		return !conditionValuesEqual(selected, value), nil
+	}
+	a, ok := conditionNumber(selected)
+	if !ok {
+		return false, errors.Wrapf(types.ErrInvalid, "condition path %q selects %v, not a number", condition.Path, selected)
+	}
+	b, ok := conditionNumber(value)
+	if !ok {
+		return false, errors.Wrapf(types.ErrInvalid, "condition value %q is not a number", condition.Value)
+	}
+	cmp := a.Cmp(b)
+	switch condition.Operator {
+	case types.ConditionOperator_CONDITION_OPERATOR_LESS:
+		return cmp < 0, nil
+	case types.ConditionOperator_CONDITION_OPERATOR_LESS_OR_EQUAL:
+		return cmp <= 0, nil
+	case types.ConditionOperator_CONDITION_OPERATOR_GREATER:
+		return cmp > 0, nil
+	case types.ConditionOperator_CONDITION_OPERATOR_GREATER_OR_EQUAL:
+		return cmp >= 0, nil
+	}
+	return false, errors.Wrapf(types.ErrInvalid, "unknown condition operator %d", condition.Operator)
+}
+
+// isOrderingOperator returns true for the operators that only compare numbers
+func isOrderingOperator(operator types.ConditionOperator) bool {
+	return operator != types.ConditionOperator_CONDITION_OPERATOR_EQUAL &&
+		operator != types.ConditionOperator_CONDITION_OPERATOR_NOT_EQUAL
+}
+
+// decodeConditionJSON decodes a JSON document keeping its numbers exact
+func decodeConditionJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.Wrap(types.ErrInvalid, "trailing data after the JSON value")
+	}
+	return value, nil
+}
+
+// selectConditionValue walks a dot separated path into a decoded JSON document
+func selectConditionValue(document any, path string) (any, error) {
+	if path == "" {
+		return document, nil
+	}
+	selected := document
+	for _, segment := range strings.Split(path, ".") {
+		switch node := selected.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return nil, errors.Wrapf(types.ErrInvalid, "condition path %q: no field %q", path, segment)
+			}
+			selected = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, errors.Wrapf(types.ErrInvalid, "condition path %q: no element %q", path, segment)
+			}
+			selected = node[index]
+		default:
+			return nil, errors.Wrapf(types.ErrInvalid, "condition path %q: cannot select %q from %v", path, segment, node)
+		}
+	}
+	return selected, nil
+}
+
+// conditionNumber returns the number a JSON value holds, numbers given as strings included, as
+// contracts encode 128 bit integers as strings
+func conditionNumber(value any) (*big.Rat, bool) {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return nil, false
+	}
+	return new(big.Rat).SetString(text)
+}
+
+// conditionValuesEqual compares numbers by value and any other JSON values structurally
+func conditionValuesEqual(a, b any) bool {
+	if x, ok := conditionNumber(a); ok {
+		if y, ok := conditionNumber(b); ok {
+			return x.Cmp(y) == 0
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// queryCondition runs the smart query of a condition with a gas limit, running out of gas
+// fails the query instead of the block. The gas the query used is charged to ctx.
+func (k Keeper) queryCondition(ctx sdk.Context, condition *types.WasmCondition) (result []byte, err error) {
+	contract, err := sdk.AccAddressFromBech32(condition.Contract)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "condition contract %s: %s", condition.Contract, err)
+	}
+	gasLimit := k.GetParams(ctx).MaxConditionGas
+	if condition.GasLimit > 0 {
+		gasLimit = min(condition.GasLimit, gasLimit)
+	}
+	// queries must not write, the cache is discarded either way
+	queryCtx, _ := ctx.CacheContext()
+	queryMeter := storetypes.NewGasMeter(gasLimit)
+	queryCtx = queryCtx.WithGasMeter(queryMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(queryMeter.GasConsumedToLimit(), "will condition query")
+			err = errors.Wrapf(sdkerrors.ErrOutOfGas, "condition query ran out of gas in %s", outOfGas.Descriptor)
+		}
+	}()
+	result, err = k.wasmKeeper.QuerySmart(queryCtx, contract, condition.QueryMsg)
+	ctx.GasMeter().ConsumeGas(queryMeter.GasConsumedToLimit(), "will condition query")
+	return result, err
+}
+
+// checkConditions evaluates the conditions due at the current block height and returns the IDs
+// of the wills whose condition is met. The conditions of the other wills are evaluated again
+// after their interval, a condition that cannot be evaluated is not met.
+func (k Keeper) checkConditions(ctx sdk.Context) ([]string, error) {
+	ids, err := k.liveWillIDsConditionDue(ctx, ctx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	var met []string
+	for _, id := range ids {
+		will, err := k.GetWillByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := k.evaluateWillCondition(ctx, will)
+		if err != nil {
+			ctx.Logger().Error("will condition evaluation failed", "will_id", will.ID, "err", err)
+		}
+		if ok {
+			met = append(met, will.ID)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent("will_condition_met",
+					sdk.NewAttribute("will_id", will.ID),
+					sdk.NewAttribute("contract", will.Condition.Contract),
+				),
+			)
+			continue
+		}
+		will.Condition.NextCheckHeight = ctx.BlockHeight() + conditionInterval(will.Condition)
+		if err := k.setWill(ctx, will); err != nil {
+			return nil, err
+		}
+	}
+	return met, nil
+}
+
+// evaluateWillCondition queries the contract of a will's condition and compares the result
+func (k Keeper) evaluateWillCondition(ctx sdk.Context, will *types.Will) (bool, error) {
+	result, err := k.queryCondition(ctx, will.Condition)
+	if err != nil {
+		return false, err
+	}
+	return EvaluateCondition(will.Condition, result)
+}
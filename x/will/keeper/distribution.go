@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MaxDistributionShares bounds the number of beneficiaries of a distribution component
+const MaxDistributionShares = 64
+
+// validateDistribution checks the shares and source of a distribution component
+func validateDistribution(distribution *types.DistributionComponent) error {
+	if len(distribution.Shares) == 0 || len(distribution.Shares) > MaxDistributionShares {
+		return errors.Wrapf(types.ErrInvalid, "distribution needs 1 to %d shares, got %d", MaxDistributionShares, len(distribution.Shares))
+	}
+	seen := make(map[string]struct{}, len(distribution.Shares))
+	total := math.ZeroUint()
+	for i, share := range distribution.Shares {
+		if _, err := sdk.AccAddressFromBech32(share.Address); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "share %d address %s: %s", i, share.Address, err)
+		}
+		if _, ok := seen[share.Address]; ok {
+			return errors.Wrapf(types.ErrDuplicate, "share %d address %s", i, share.Address)
+		}
+		seen[share.Address] = struct{}{}
+		if share.Weight == 0 {
+			return errors.Wrapf(types.ErrInvalid, "share %d weight must be positive", i)
+		}
+		total = total.Add(math.NewUint(share.Weight))
+	}
+	switch source := distribution.Source.(type) {
+	case *types.DistributionComponent_Coins:
+		if !source.Coins.Amount.IsValid() || source.Coins.Amount.IsZero() {
+			return errors.Wrapf(sdkerrors.ErrInvalidCoins, "distribution coins %s", source.Coins.Amount)
+		}
+	case *types.DistributionComponent_BalanceDenom:
+		if err := sdk.ValidateDenom(source.BalanceDenom); err != nil {
+			return errors.Wrapf(types.ErrInvalid, "distribution balance denom: %s", err)
+		}
+	default:
+		return errors.Wrap(types.ErrInvalid, "distribution needs a coin or balance source")
+	}
+	if _, ok := types.DistributionRemainder_name[int32(distribution.Remainder)]; !ok {
+		return errors.Wrapf(types.ErrInvalid, "unknown distribution remainder %d", distribution.Remainder)
+	}
+	return nil
+}
+
+// distributionEscrow returns the coins a distribution component pays out of escrow
+func distributionEscrow(distribution *types.DistributionComponent) sdk.Coins {
+	if coins := distribution.GetCoins(); coins != nil {
+		return coins.Amount
+	}
+	return sdk.NewCoins()
+}
+
+// SplitDistribution splits coins between the shares of a distribution in proportion to their
+// weights. Each share receives the floor of its part of every denom, the remainder is returned
+// separately.
+func SplitDistribution(shares []types.DistributionShare, coins sdk.Coins) ([]sdk.Coins, sdk.Coins) {
+	total := math.ZeroInt()
+	for _, share := range shares {
+		total = total.Add(math.NewIntFromUint64(share.Weight))
+	}
+	payouts := make([]sdk.Coins, len(shares))
+	for i := range payouts {
+		payouts[i] = sdk.NewCoins()
+	}
+	remainder := sdk.NewCoins()
+	for _, coin := range coins {
+		left := coin.Amount
+		for i, share := range shares {
+			amount := coin.Amount.Mul(math.NewIntFromUint64(share.Weight)).Quo(total)
+			payouts[i] = payouts[i].Add(sdk.NewCoin(coin.Denom, amount))
+			left = left.Sub(amount)
+		}
+		remainder = remainder.Add(sdk.NewCoin(coin.Denom, left))
+	}
+	return payouts, remainder
+}
+
+// ExecuteDistribution splits the source of a distribution component between its beneficiaries,
+// emitting one event per payout. Coins are paid out of escrow, a balance source is paid out of
+// the creator's spendable balance. Either every payout is made or none is.
+func (k Keeper) ExecuteDistribution(ctx sdk.Context, component *types.ExecutionComponent, will types.Will) error {
+	distribution := component.GetDistribution()
+	if distribution == nil {
+		return errors.Wrapf(types.ErrInvalid, "component %s is not a distribution", component.Id)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	payouts, remainder := SplitDistribution(distribution.Shares, k.distributionSource(cacheCtx, distribution, will))
+	if distribution.Remainder == types.DistributionRemainder_DISTRIBUTION_REMAINDER_FIRST_BENEFICIARY {
+		payouts[0] = payouts[0].Add(remainder...)
+		remainder = sdk.NewCoins()
+	}
+	for i, share := range distribution.Shares {
+		if payouts[i].IsZero() {
+			continue
+		}
+		to, err := sdk.AccAddressFromBech32(share.Address)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "share %d address %s: %s", i, share.Address, err)
+		}
+		if err := k.payDistribution(cacheCtx, distribution, will, to, payouts[i]); err != nil {
+			return err
+		}
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent("will_distribution_payout",
+				sdk.NewAttribute("will_id", will.ID),
+				sdk.NewAttribute("component_id", component.Id),
+				sdk.NewAttribute("to", share.Address),
+				sdk.NewAttribute("amount", payouts[i].String()),
+			),
+		)
+	}
+	if !remainder.IsZero() {
+		if err := k.burnDistribution(cacheCtx, distribution, will, remainder); err != nil {
+			return err
+		}
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent("will_distribution_burn",
+				sdk.NewAttribute("will_id", will.ID),
+				sdk.NewAttribute("component_id", component.Id),
+				sdk.NewAttribute("amount", remainder.String()),
+			),
+		)
+	}
+	write()
+	return nil
+}
+
+// distributionSource returns the coins a distribution component splits when its will fires
+func (k Keeper) distributionSource(ctx context.Context, distribution *types.DistributionComponent, will types.Will) sdk.Coins {
+	if denom := distribution.GetBalanceDenom(); denom != "" {
+		creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+		if err != nil {
+			return sdk.NewCoins()
+		}
+		return sdk.NewCoins(k.bankKeeper.SpendableCoin(ctx, creatorAddr, denom))
+	}
+	return distributionEscrow(distribution)
+}
+
+// payDistribution pays a beneficiary out of the source of a distribution component
+func (k Keeper) payDistribution(ctx context.Context, distribution *types.DistributionComponent, will types.Will, to sdk.AccAddress, coins sdk.Coins) error {
+	if distribution.GetBalanceDenom() == "" {
+		return k.releaseEscrow(ctx, will.ID, to, coins)
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	return k.bankKeeper.SendCoins(ctx, creatorAddr, to, coins)
+}
+
+// burnDistribution burns the remainder of a distribution component out of its source
+func (k Keeper) burnDistribution(ctx context.Context, distribution *types.DistributionComponent, will types.Will, coins sdk.Coins) error {
+	if distribution.GetBalanceDenom() == "" {
+		return k.burnEscrow(ctx, will.ID, coins)
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, coins); err != nil {
+		return errors.Wrapf(err, "burning distribution remainder of will %s", will.ID)
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
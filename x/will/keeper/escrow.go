@@ -0,0 +1,396 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// RequiredEscrow sums the coins every transfer, distribution, vesting, output transfer and IBC
+// send component of a will pays out, which is what the will must hold in escrow to be able to fire.
+// A claim component pays out either its output or its fallback, so it needs the larger of the two.
+func RequiredEscrow(components []*types.ExecutionComponent) (sdk.Coins, error) {
+	required := sdk.NewCoins()
+	for _, component := range components {
+		if component == nil {
+			continue
+		}
+		switch c := component.ComponentType.(type) {
+		case *types.ExecutionComponent_Transfer:
+			if err := addEscrowCoin(&required, c.Transfer.Amount); err != nil {
+				return nil, err
+			}
+		case *types.ExecutionComponent_IbcSend:
+			if err := addEscrowCoin(&required, c.IbcSend.Amount); err != nil {
+				return nil, err
+			}
+		case *types.ExecutionComponent_Distribution:
+			coins := distributionEscrow(c.Distribution)
+			if !coins.IsValid() {
+				return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distribution amount %s", coins)
+			}
+			required = required.Add(coins...)
+		case *types.ExecutionComponent_Vesting:
+			if !c.Vesting.Amount.IsValid() {
+				return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid vesting amount %s", c.Vesting.Amount)
+			}
+			required = required.Add(c.Vesting.Amount...)
+		}
+		output, err := outputEscrow(component.OutputType)
+		if err != nil {
+			return nil, err
+		}
+		if claim := component.GetClaim(); claim != nil && claim.Fallback != nil {
+			fallback, err := outputEscrow(claim.Fallback)
+			if err != nil {
+				return nil, err
+			}
+			output = output.Max(fallback)
+		}
+		required = required.Add(output...)
+	}
+	return required, nil
+}
+
+// outputEscrow returns the coins a component output pays out
+func outputEscrow(output *types.ComponentOutput) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	if output == nil {
+		return coins, nil
+	}
+	switch o := output.OutputType.(type) {
+	case *types.ComponentOutput_OutputTransfer:
+		if err := addEscrowCoin(&coins, o.OutputTransfer.Amount); err != nil {
+			return nil, err
+		}
+	case *types.ComponentOutput_OutputIbcSend:
+		if err := addEscrowCoin(&coins, o.OutputIbcSend.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return coins, nil
+}
+
+func addEscrowCoin(coins *sdk.Coins, coin *sdk.Coin) error {
+	if coin == nil {
+		return nil
+	}
+	if !coin.IsValid() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid component amount %s", coin)
+	}
+	*coins = coins.Add(*coin)
+	return nil
+}
+
+// escrowShortfall returns the part of required that is not covered by held
+func escrowShortfall(required, held sdk.Coins) sdk.Coins {
+	shortfall := sdk.NewCoins()
+	for _, coin := range required {
+		if missing := coin.Amount.Sub(held.AmountOf(coin.Denom)); missing.IsPositive() {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, missing))
+		}
+	}
+	return shortfall
+}
+
+// escrowSurplus returns the part of held that required does not need
+func escrowSurplus(held, required sdk.Coins) sdk.Coins {
+	surplus := sdk.NewCoins()
+	for _, coin := range held {
+		if extra := coin.Amount.Sub(required.AmountOf(coin.Denom)); extra.IsPositive() {
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, extra))
+		}
+	}
+	return surplus
+}
+
+// GetEscrow returns the assets held in escrow for a will, an empty escrow if there is none
+func (k Keeper) GetEscrow(ctx context.Context, willID string) (types.WillEscrow, error) {
+	escrow, err := k.escrows.Get(ctx, willID)
+	switch {
+	case errors.IsOf(err, collections.ErrNotFound):
+		return types.WillEscrow{WillId: willID, Coins: sdk.NewCoins(), Deposit: sdk.NewCoins()}, nil
+	case err != nil:
+		return escrow, err
+	}
+	return escrow, nil
+}
+
+func (k Keeper) setEscrow(ctx context.Context, escrow types.WillEscrow) error {
+	if escrow.Coins.IsZero() && escrow.Deposit.IsZero() {
+		return k.escrows.Remove(ctx, escrow.WillId)
+	}
+	return k.escrows.Set(ctx, escrow.WillId, escrow)
+}
+
+// IterateEscrows calls cb for every stored will escrow until cb returns true
+func (k Keeper) IterateEscrows(ctx context.Context, cb func(escrow types.WillEscrow) (stop bool)) error {
+	return k.escrows.Walk(ctx, nil, func(_ string, escrow types.WillEscrow) (bool, error) {
+		return cb(escrow), nil
+	})
+}
+
+// escrowCoins moves coins from the depositor into the will module account and credits them to the will
+func (k Keeper) escrowCoins(ctx context.Context, willID, depositor string, coins sdk.Coins) (types.WillEscrow, error) {
+	escrow, err := k.GetEscrow(ctx, willID)
+	if err != nil {
+		return escrow, err
+	}
+	if coins.IsZero() {
+		return escrow, nil
+	}
+	depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return escrow, errors.Wrapf(sdkerrors.ErrInvalidAddress, "depositor %s: %s", depositor, err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, coins); err != nil {
+		return escrow, errors.Wrapf(err, "escrowing %s for will %s", coins, willID)
+	}
+	escrow.Coins = escrow.Coins.Add(coins...)
+	if err := k.setEscrow(ctx, escrow); err != nil {
+		return escrow, err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("will_escrow_funded",
+			sdk.NewAttribute("will_id", willID),
+			sdk.NewAttribute("depositor", depositor),
+			sdk.NewAttribute("amount", coins.String()),
+		),
+	)
+	return escrow, nil
+}
+
+// releaseEscrow pays coins held for a will out of the module account and debits them from its escrow
+func (k Keeper) releaseEscrow(ctx context.Context, willID string, to sdk.AccAddress, coins sdk.Coins) error {
+	escrow, err := k.GetEscrow(ctx, willID)
+	if err != nil {
+		return err
+	}
+	remaining, hasNeg := escrow.Coins.SafeSub(coins...)
+	if hasNeg {
+		return errors.Wrapf(types.ErrInsufficientEscrow, "will %s holds %s, cannot pay out %s", willID, escrow.Coins, coins)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins); err != nil {
+		return errors.Wrapf(err, "releasing escrow of will %s", willID)
+	}
+	escrow.Coins = remaining
+	return k.setEscrow(ctx, escrow)
+}
+
+// burnEscrow burns coins held for a will in the module account and debits them from its escrow
+func (k Keeper) burnEscrow(ctx context.Context, willID string, coins sdk.Coins) error {
+	escrow, err := k.GetEscrow(ctx, willID)
+	if err != nil {
+		return err
+	}
+	remaining, hasNeg := escrow.Coins.SafeSub(coins...)
+	if hasNeg {
+		return errors.Wrapf(types.ErrInsufficientEscrow, "will %s holds %s, cannot burn %s", willID, escrow.Coins, coins)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return errors.Wrapf(err, "burning escrow of will %s", willID)
+	}
+	escrow.Coins = remaining
+	return k.setEscrow(ctx, escrow)
+}
+
+// escrowDeposit moves the creation deposit from the creator into the will module account
+func (k Keeper) escrowDeposit(ctx context.Context, willID, creator string, deposit sdk.Coins) error {
+	if deposit.IsZero() {
+		return nil
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", creator, err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, deposit); err != nil {
+		return errors.Wrapf(err, "taking creation deposit %s for will %s", deposit, willID)
+	}
+	escrow, err := k.GetEscrow(ctx, willID)
+	if err != nil {
+		return err
+	}
+	escrow.Deposit = escrow.Deposit.Add(deposit...)
+	return k.setEscrow(ctx, escrow)
+}
+
+// refundDeposit returns the creation deposit of a will to its creator
+func (k Keeper) refundDeposit(ctx context.Context, will *types.Will) (sdk.Coins, error) {
+	escrow, err := k.GetEscrow(ctx, will.ID)
+	if err != nil {
+		return nil, err
+	}
+	deposit := escrow.Deposit
+	if deposit.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, deposit); err != nil {
+		return nil, errors.Wrapf(err, "returning creation deposit of will %s", will.ID)
+	}
+	escrow.Deposit = sdk.NewCoins()
+	return deposit, k.setEscrow(ctx, escrow)
+}
+
+// FundWill tops up the escrow of a live will from its creator
+func (k Keeper) FundWill(ctx context.Context, msg *types.MsgFundWillRequest) (types.WillEscrow, error) {
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return types.WillEscrow{}, errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+	will, err := k.GetWillByID(ctx, msg.Id)
+	if err != nil {
+		return types.WillEscrow{}, err
+	}
+	if will.Creator != msg.Creator {
+		return types.WillEscrow{}, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the creator of will %s can fund it", will.ID)
+	}
+	if will.Status != types.WillStatusLive {
+		return types.WillEscrow{}, errors.Wrapf(types.ErrWillNotLive, "will %s is %s", will.ID, will.Status)
+	}
+	return k.escrowCoins(ctx, will.ID, msg.Creator, msg.Amount)
+}
+
+// RefundEscrow returns whatever is left in escrow for a will, including its creation deposit, to its creator
+func (k Keeper) RefundEscrow(ctx context.Context, will *types.Will) (sdk.Coins, error) {
+	deposit, err := k.refundDeposit(ctx, will)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := k.refundEscrowCoins(ctx, will)
+	if err != nil {
+		return nil, err
+	}
+	if coins.IsZero() {
+		return deposit, nil
+	}
+	refund := coins.Add(deposit...)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("will_escrow_refunded",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("creator", will.Creator),
+			sdk.NewAttribute("amount", refund.String()),
+		),
+	)
+	return refund, nil
+}
+
+// refundEscrowCoins returns the coins left in escrow for a will, but not its creation deposit, to its creator
+func (k Keeper) refundEscrowCoins(ctx context.Context, will *types.Will) (sdk.Coins, error) {
+	escrow, err := k.GetEscrow(ctx, will.ID)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.Coins.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	if err := k.releaseEscrow(ctx, will.ID, creatorAddr, escrow.Coins); err != nil {
+		return nil, err
+	}
+	return escrow.Coins, nil
+}
+
+// outstandingEscrow sums what the components of a will that fired may still pay out of escrow: the
+// output or fallback of a claim component that accepts claims, the coins of a vesting component
+// that were not withdrawn yet and the coins of a component whose packet is pending.
+func outstandingEscrow(will *types.Will) (sdk.Coins, error) {
+	outstanding := sdk.NewCoins()
+	for _, component := range will.Components {
+		switch component.Status {
+		case types.ComponentStatusActive, types.ComponentStatusPending:
+			required, err := RequiredEscrow([]*types.ExecutionComponent{component})
+			if err != nil {
+				return nil, err
+			}
+			outstanding = outstanding.Add(required...)
+		case types.ComponentStatusVesting:
+			vesting := component.GetVesting()
+			if remaining, hasNeg := vesting.Amount.SafeSub(vesting.Withdrawn...); !hasNeg {
+				outstanding = outstanding.Add(remaining...)
+			}
+		}
+	}
+	return outstanding, nil
+}
+
+// refundSurplus returns the coins in escrow for a will that fired which none of its components
+// can pay out anymore to its creator, such as the coins of a component that failed or a top up
+// beyond what the components need. A failure is only logged, the coins stay in escrow.
+func (k Keeper) refundSurplus(ctx sdk.Context, willID string) {
+	if err := k.releaseSurplus(ctx, willID); err != nil {
+		ctx.Logger().Error("will escrow surplus refund failed", "will_id", willID, "err", err)
+	}
+}
+
+func (k Keeper) releaseSurplus(ctx sdk.Context, willID string) error {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil || will.Status != types.WillStatusExpired {
+		return err
+	}
+	outstanding, err := outstandingEscrow(will)
+	if err != nil {
+		return err
+	}
+	escrow, err := k.GetEscrow(ctx, will.ID)
+	if err != nil {
+		return err
+	}
+	surplus := escrowSurplus(escrow.Coins, outstanding)
+	if surplus.IsZero() {
+		return nil
+	}
+	return k.releaseEscrowToCreator(ctx, will, surplus)
+}
+
+// releaseEscrowToCreator pays coins held for a will back to its creator
+func (k Keeper) releaseEscrowToCreator(ctx sdk.Context, will *types.Will, coins sdk.Coins) error {
+	creatorAddr, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	if err := k.releaseEscrow(ctx, will.ID, creatorAddr, coins); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_escrow_refunded",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("creator", will.Creator),
+			sdk.NewAttribute("amount", coins.String()),
+		),
+	)
+	return nil
+}
+
+// payExecutorBounty pays the executor of a will the ExecutorBountyPercent of the creation deposit
+// left in escrow, rounded down
+func (k Keeper) payExecutorBounty(ctx context.Context, will *types.Will, executor sdk.AccAddress) (sdk.Coins, error) {
+	escrow, err := k.GetEscrow(ctx, will.ID)
+	if err != nil {
+		return nil, err
+	}
+	percent := int64(k.GetParams(ctx).ExecutorBountyPercent)
+	bounty := sdk.NewCoins()
+	for _, coin := range escrow.Deposit {
+		bounty = bounty.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(percent).QuoRaw(100)))
+	}
+	if bounty.IsZero() {
+		return bounty, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, executor, bounty); err != nil {
+		return nil, errors.Wrapf(err, "paying the executor bounty of will %s", will.ID)
+	}
+	escrow.Deposit = escrow.Deposit.Sub(bounty...)
+	return bounty, k.setEscrow(ctx, escrow)
+}
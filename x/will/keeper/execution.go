@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// validateDependencies checks that every dependency of a component names an earlier component,
+// so the components can run in order
+func validateDependencies(components []*types.ExecutionComponent) error {
+	earlier := make(map[string]struct{}, len(components))
+	for _, component := range components {
+		for _, dependency := range component.DependsOn {
+			if _, ok := earlier[dependency]; !ok {
+				return errors.Wrapf(types.ErrInvalid, "component %s depends on %q, which is not an earlier component", component.Id, dependency)
+			}
+		}
+		earlier[component.Id] = struct{}{}
+	}
+	return nil
+}
+
+// isExecuted returns true for the statuses of components that ran successfully when their will fired
+func isExecuted(status string) bool {
+	switch status {
+	case types.ComponentStatusExecuted, types.ComponentStatusActive, types.ComponentStatusVesting, types.ComponentStatusPending:
+		return true
+	}
+	return false
+}
+
+// executeWill runs the components of a will that fires in order and stores a receipt for each.
+// Every component runs in a cached context, so a failed component leaves no partial effects. A
+// best effort will keeps the effects of the components that succeed. An atomic will keeps none
+// of them unless all succeed, otherwise its escrowed coins are returned to the creator.
+//
+// The components may use gasLimit together. When carryOver is set, a component running out of
+// it abandons the will without effects or receipts, and done is false so it runs again later.
+func (k Keeper) executeWill(ctx sdk.Context, will *types.Will, gasLimit uint64, carryOver bool) (gasUsed uint64, done bool, err error) {
+	atomic := will.ExecutionMode == types.ExecutionMode_EXECUTION_MODE_ATOMIC
+	willCtx, writeWill := ctx.CacheContext()
+	receipts := make([]types.ExecutionReceipt, len(will.Components))
+	// component ids map to false once a component with the id did not execute
+	executed := make(map[string]bool, len(will.Components))
+	failed := false
+	// connections whose interchain account has no open channel for an ICA component
+	var reopen []string
+	for i, component := range will.Components {
+		receipt := types.ExecutionReceipt{
+			WillId:      will.ID,
+			ComponentId: component.Id,
+			Index:       uint32(i),
+			Height:      ctx.BlockHeight(),
+		}
+		dependency := unmetDependency(component, executed)
+		switch {
+		case atomic && failed:
+			receipt.Status = types.ComponentStatusSkipped
+			receipt.Error = "an earlier component of the atomic will failed"
+		case dependency != "":
+			receipt.Status = types.ComponentStatusSkipped
+			receipt.Error = fmt.Sprintf("component %s it depends on did not execute", dependency)
+		default:
+			remaining := gasLimit - gasUsed
+			status, data, componentGas, err := k.runComponent(willCtx, component, will, remaining)
+			gasUsed += componentGas
+			if err != nil && carryOver && componentGas >= remaining {
+				// the will does not fit in what is left of the gas, nothing it did is kept
+				return gasUsed, false, nil
+			}
+			receipt.GasUsed, receipt.Data = componentGas, data
+			if ica := component.GetIca(); ica != nil && errors.IsOf(err, icatypes.ErrActiveChannelNotFound) && !slices.Contains(reopen, ica.ConnectionId) {
+				reopen = append(reopen, ica.ConnectionId)
+			}
+			if err != nil {
+				ctx.Logger().Error("will component failed", "will_id", will.ID, "component_id", component.Id, "err", err)
+				receipt.Status = types.ComponentStatusFailed
+				receipt.Error = err.Error()
+				failed = true
+			} else {
+				receipt.Status = status
+			}
+		}
+		if ok, seen := executed[component.Id]; !seen || ok {
+			executed[component.Id] = isExecuted(receipt.Status)
+		}
+		receipts[i] = receipt
+	}
+
+	reverted := atomic && failed
+	if reverted {
+		// drop what the components changed in the will along with their effects
+		stored, err := k.GetWillByID(ctx, will.ID)
+		if err != nil {
+			return gasUsed, false, err
+		}
+		*will = *stored
+		for i := range receipts {
+			if isExecuted(receipts[i].Status) {
+				receipts[i].Status = types.ComponentStatusReverted
+			}
+		}
+	} else {
+		writeWill()
+	}
+	for i, component := range will.Components {
+		component.Status = receipts[i].Status
+		if err := k.receipts.Set(ctx, collections.Join(will.ID, uint32(i)), receipts[i]); err != nil {
+			return gasUsed, false, err
+		}
+	}
+	if reverted {
+		if _, err := k.refundEscrowCoins(ctx, will); err != nil {
+			ctx.Logger().Error("will escrow refund failed", "will_id", will.ID, "err", err)
+		}
+	}
+	// the accounts are registered again on ctx, as a revert drops what happened in willCtx
+	for _, connectionID := range reopen {
+		k.reopenInterchainAccount(ctx, will, connectionID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_executed",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("execution_mode", will.ExecutionMode.String()),
+			sdk.NewAttribute("reverted", strconv.FormatBool(reverted)),
+			sdk.NewAttribute("gas_used", strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+	return gasUsed, true, nil
+}
+
+// unmetDependency returns the first dependency of a component that did not execute, empty when
+// all of them did
+func unmetDependency(component *types.ExecutionComponent, executed map[string]bool) string {
+	for _, dependency := range component.DependsOn {
+		if !executed[dependency] {
+			return dependency
+		}
+	}
+	return ""
+}
+
+// runComponent runs a component of a will that fires in its own cached context, which is only
+// written when the component succeeds. It returns the status the component moves to, the
+// response data of a contract call and the gas the component used, running out of gasLimit
+// fails the component instead of the block.
+func (k Keeper) runComponent(ctx sdk.Context, component *types.ExecutionComponent, will *types.Will, gasLimit uint64) (status string, data []byte, gasUsed uint64, err error) {
+	componentCtx, write := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	componentCtx = componentCtx.WithGasMeter(gasMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			status, gasUsed = "", gasMeter.GasConsumedToLimit()
+			err = errors.Wrapf(sdkerrors.ErrOutOfGas, "component %s ran out of gas in %s", component.Id, outOfGas.Descriptor)
+		}
+	}()
+
+	status = types.ComponentStatusExecuted
+	switch c := component.ComponentType.(type) {
+	case *types.ExecutionComponent_Transfer:
+		err = k.ExecuteTransfer(componentCtx, component, *will)
+	case *types.ExecutionComponent_Claim:
+		// claim components start accepting claims
+		status = types.ComponentStatusActive
+		err = k.openClaimWindow(componentCtx, will.ID, component)
+	case *types.ExecutionComponent_Contract:
+		data, err = k.ExecuteContract(componentCtx, c, will.Creator)
+	case *types.ExecutionComponent_IbcMsg:
+		// the packet is pending until it is acknowledged
+		status = types.ComponentStatusPending
+		err = k.SendIBCMessage(componentCtx, component, *will)
+	case *types.ExecutionComponent_IbcSend:
+		// the transfer is pending until it is acknowledged
+		status = types.ComponentStatusPending
+		err = k.ExecuteIBCSend(componentCtx, component, *will)
+	case *types.ExecutionComponent_Distribution:
+		err = k.ExecuteDistribution(componentCtx, component, *will)
+	case *types.ExecutionComponent_Ica:
+		// the transaction is pending until it is acknowledged
+		status = types.ComponentStatusPending
+		err = k.ExecuteICA(componentCtx, component, *will)
+	case *types.ExecutionComponent_Vesting:
+		// the beneficiary withdraws the coins as they vest
+		status = types.ComponentStatusVesting
+		startVesting(componentCtx, component)
+	default:
+		err = errors.Wrapf(types.ErrInvalid, "unknown component type %T", component.ComponentType)
+	}
+	if err != nil {
+		return "", data, gasMeter.GasConsumedToLimit(), err
+	}
+	write()
+	return status, data, gasMeter.GasConsumedToLimit(), nil
+}
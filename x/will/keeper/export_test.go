@@ -0,0 +1,18 @@
+package keeper
+
+// ApplyOptions applies constructor options to a keeper built by the app
+func ApplyOptions(k *Keeper, opts ...Option) {
+	for _, o := range opts {
+		o.apply(k)
+	}
+}
+
+// SetTransferKeeper replaces the ICS-20 transfer keeper of a keeper built by the app
+func SetTransferKeeper(k *Keeper, tk ICS20TransferKeeper) {
+	k.transferKeeper = tk
+}
+
+// SetICAControllerKeeper replaces the interchain accounts controller keeper of a keeper built by the app
+func SetICAControllerKeeper(k *Keeper, ick ICAControllerKeeper) {
+	k.icaKeeper = ick
+}
@@ -5,6 +5,9 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -31,13 +34,216 @@ func InitGenesis(ctx sdk.Context, k *Keeper, state types.GenesisState) ([]abci.V
 	}
 	// panic(2)
 	// set params
-	k.SetParams(ctx, state.Params)
-	fmt.Println("AFTER SET PARAMS")
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		return nil, err
+	}
+
+	// store the wills, the secondary indexes are rebuilt with them
+	for i := range state.Wills {
+		will := &state.Wills[i]
+		if err := k.setWill(ctx, will); err != nil {
+			return nil, errors.Wrapf(err, "will %s", will.ID)
+		}
+		// the claim windows still open are queued again from the components
+		for _, component := range will.Components {
+			if component.Status != types.ComponentStatusActive {
+				continue
+			}
+			if err := k.queueLapse(ctx, will.ID, component); err != nil {
+				return nil, errors.Wrapf(err, "will %s", will.ID)
+			}
+		}
+	}
+
+	escrowed := sdk.NewCoins()
+	for _, escrow := range state.Escrows {
+		if _, err := k.GetWillByID(ctx, escrow.WillId); err != nil {
+			return nil, errors.Wrap(err, "escrow")
+		}
+		if err := k.setEscrow(ctx, escrow); err != nil {
+			return nil, errors.Wrapf(err, "escrow of will %s", escrow.WillId)
+		}
+		escrowed = escrowed.Add(escrow.Coins...).Add(escrow.Deposit...)
+	}
+	for _, nullifier := range state.Nullifiers {
+		if err := k.nullifiers.Set(ctx, collections.Join(nullifier.Scheme, nullifier.Nullifier)); err != nil {
+			return nil, errors.Wrapf(err, "%s nullifier", nullifier.Scheme)
+		}
+	}
+	for _, approval := range state.Approvals {
+		if _, err := k.GetWillByID(ctx, approval.WillId); err != nil {
+			return nil, errors.Wrap(err, "approval")
+		}
+		if err := k.approvals.Set(ctx, collections.Join3(approval.WillId, approval.ComponentId, collections.Join(approval.Claimer, approval.Guardian))); err != nil {
+			return nil, errors.Wrapf(err, "approval of will %s", approval.WillId)
+		}
+	}
+	for _, receipt := range state.Receipts {
+		if _, err := k.GetWillByID(ctx, receipt.WillId); err != nil {
+			return nil, errors.Wrap(err, "receipt")
+		}
+		if err := k.receipts.Set(ctx, collections.Join(receipt.WillId, receipt.Index), receipt); err != nil {
+			return nil, errors.Wrapf(err, "receipt of will %s", receipt.WillId)
+		}
+	}
+	for _, willID := range state.Queue {
+		will, err := k.GetWillByID(ctx, willID)
+		if err != nil {
+			return nil, errors.Wrap(err, "queue")
+		}
+		if will.Status != types.WillStatusQueued {
+			return nil, errors.Wrapf(types.ErrInvalid, "will %s in the queue has status %s", will.ID, will.Status)
+		}
+		if err := k.pushQueue(ctx, will.ID); err != nil {
+			return nil, errors.Wrapf(err, "queueing will %s", will.ID)
+		}
+	}
+	for _, packet := range state.PendingPackets {
+		if _, err := k.GetWillByID(ctx, packet.WillId); err != nil {
+			return nil, errors.Wrap(err, "pending packet")
+		}
+		if err := k.pendingPackets.Set(ctx, collections.Join(packet.Channel, packet.Sequence), packet); err != nil {
+			return nil, errors.Wrapf(err, "pending packet of will %s", packet.WillId)
+		}
+	}
+	for _, retry := range state.PacketRetries {
+		if _, err := k.GetWillByID(ctx, retry.WillId); err != nil {
+			return nil, errors.Wrap(err, "packet retry")
+		}
+		if err := k.packetRetries.Set(ctx, collections.Join3(retry.Height, retry.WillId, retry.ComponentId), retry); err != nil {
+			return nil, errors.Wrapf(err, "packet retry of will %s", retry.WillId)
+		}
+	}
+	// the escrowed assets come with the module account balance from the bank genesis
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !balance.IsAllGTE(escrowed) {
+		return nil, errors.Wrapf(types.ErrInsufficientEscrow, "module account holds %s, wills escrow %s", balance, escrowed)
+	}
 	return nil, nil
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
-	var genState types.GenesisState
-	return &genState
+	wills := []types.Will{}
+	if err := keeper.IterateWills(ctx, func(will *types.Will) bool {
+		wills = append(wills, *will)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+	escrows := []types.WillEscrow{}
+	if err := keeper.IterateEscrows(ctx, func(escrow types.WillEscrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	}); err != nil {
+		panic(err)
+	}
+	nullifiers := []types.Nullifier{}
+	if err := keeper.nullifiers.Walk(ctx, nil, func(key collections.Pair[string, []byte]) (bool, error) {
+		nullifiers = append(nullifiers, types.Nullifier{Scheme: key.K1(), Nullifier: key.K2()})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	approvals := []types.Approval{}
+	if err := keeper.approvals.Walk(ctx, nil, func(key collections.Triple[string, string, collections.Pair[string, uint32]]) (bool, error) {
+		approvals = append(approvals, types.Approval{WillId: key.K1(), ComponentId: key.K2(), Claimer: key.K3().K1(), Guardian: key.K3().K2()})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	receipts := []types.ExecutionReceipt{}
+	if err := keeper.receipts.Walk(ctx, nil, func(_ collections.Pair[string, uint32], receipt types.ExecutionReceipt) (bool, error) {
+		receipts = append(receipts, receipt)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	queue, err := keeper.QueuedWillIDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	pendingPackets := []types.PendingPacket{}
+	if err := keeper.pendingPackets.Walk(ctx, nil, func(_ collections.Pair[string, uint64], packet types.PendingPacket) (bool, error) {
+		pendingPackets = append(pendingPackets, packet)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	packetRetries := []types.PacketRetry{}
+	if err := keeper.packetRetries.Walk(ctx, nil, func(_ collections.Triple[int64, string, string], retry types.PacketRetry) (bool, error) {
+		packetRetries = append(packetRetries, retry)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Params:         keeper.GetParams(ctx),
+		PortId:         keeper.GetPort(ctx),
+		Wills:          wills,
+		Escrows:        escrows,
+		Nullifiers:     nullifiers,
+		Approvals:      approvals,
+		Receipts:       receipts,
+		Queue:          queue,
+		PendingPackets: pendingPackets,
+		PacketRetries:  packetRetries,
+	}
+}
+
+// RebaseWillHeights moves the trigger height and next condition check height of every live
+// will, and the check-in height, claim window lapse heights and vesting start heights of
+// every will, and the heights packet retries are due at, back by offset blocks. It is used by
+// zero height exports so that wills keep the number of blocks left until they fire or are
+// checked, their claim windows until they close, their vesting schedules until they end, and
+// their packets until they are sent again, on the new chain. Trigger times need no rebasing.
+func (k Keeper) RebaseWillHeights(ctx sdk.Context, offset int64) error {
+	var wills []*types.Will
+	if err := k.IterateWills(ctx, func(will *types.Will) bool {
+		wills = append(wills, will)
+		return false
+	}); err != nil {
+		return err
+	}
+	// every live will moves by the same offset, so no height gets more wills than it had
+	for _, will := range wills {
+		will.LastCheckIn = max(will.LastCheckIn-offset, 0)
+		if will.Status == types.WillStatusLive && will.TriggerTime == nil && will.Condition == nil {
+			will.Height = max(will.Height-offset, 1)
+		}
+		if will.Status == types.WillStatusLive && will.Condition != nil {
+			will.Condition.NextCheckHeight = max(will.Condition.NextCheckHeight-offset, 1)
+		}
+		for _, component := range will.Components {
+			if claim := component.GetClaim(); claim != nil && claim.LapseHeight > 0 {
+				claim.LapseHeight = max(claim.LapseHeight-offset, 1)
+			}
+			if vesting := component.GetVesting(); vesting != nil && will.Status == types.WillStatusExpired {
+				// the start height may become negative, the schedule only depends on the blocks elapsed since
+				vesting.StartHeight -= offset
+			}
+		}
+		if err := k.setWill(ctx, will); err != nil {
+			return err
+		}
+	}
+	var retries []types.PacketRetry
+	if err := k.packetRetries.Walk(ctx, nil, func(_ collections.Triple[int64, string, string], retry types.PacketRetry) (bool, error) {
+		retries = append(retries, retry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, retry := range retries {
+		if err := k.packetRetries.Remove(ctx, collections.Join3(retry.Height, retry.WillId, retry.ComponentId)); err != nil {
+			return err
+		}
+	}
+	for _, retry := range retries {
+		retry.Height = max(retry.Height-offset, 1)
+		if err := k.packetRetries.Set(ctx, collections.Join3(retry.Height, retry.WillId, retry.ComponentId), retry); err != nil {
+			return err
+		}
+	}
+	return nil
 }
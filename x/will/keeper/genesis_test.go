@@ -0,0 +1,418 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/keeper"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	srcKeeper, srcCtx, srcApp := setupAppKeeper(t)
+
+	creatorAddr := sdk.AccAddress("genesis-creator_____")
+	beneficiaryAddr := sdk.AccAddress("genesis-beneficiary_")
+	setupWithFundedAccount(t, srcApp, srcCtx, srcKeeper, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	params := types.DefaultParams()
+	params.CreationDeposit = sdk.NewCoins(sdk.NewInt64Coin("uwill", 5))
+	require.NoError(t, srcKeeper.SetParams(srcCtx, params))
+
+	live, err := srcKeeper.CreateWill(srcCtx, &types.MsgCreateWillRequest{
+		Creator:          creatorAddr.String(),
+		Name:             "live will",
+		Beneficiary:      beneficiaryAddr.String(),
+		Height:           20,
+		InactivityWindow: 10,
+		Components:       []*types.ExecutionComponent{transferComponent("t", beneficiaryAddr, 30)},
+	})
+	require.NoError(t, err)
+	cancelled, err := srcKeeper.CreateWill(srcCtx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "cancelled will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      20,
+	})
+	require.NoError(t, err)
+	_, err = srcKeeper.CancelWill(srcCtx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: cancelled.ID})
+	require.NoError(t, err)
+
+	exported := keeper.ExportGenesis(srcCtx, srcKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, params, exported.Params)
+	require.Len(t, exported.Wills, 2)
+	require.Equal(t, []types.WillEscrow{{
+		WillId:  live.ID,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("uwill", 30)),
+		Deposit: sdk.NewCoins(sdk.NewInt64Coin("uwill", 5)),
+	}}, exported.Escrows)
+
+	// import into a fresh chain that holds the escrowed assets in the module account
+	dstKeeper, dstCtx, _ := setupAppKeeper(t)
+	require.NoError(t, dstKeeper.GetBankKeeper().MintCoins(dstCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uwill", 35))))
+	_, err = keeper.InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+	assert.Equal(t, exported, keeper.ExportGenesis(dstCtx, dstKeeper))
+
+	// the creator index is rebuilt
+	wills, err := dstKeeper.ListWillsByAddress(dstCtx, creatorAddr.String())
+	require.NoError(t, err)
+	assert.Len(t, wills, 2)
+
+	// the height index is rebuilt, so the live will fires on the new chain
+	require.NoError(t, dstKeeper.BeginBlocker(dstCtx.WithBlockHeight(20)))
+	fired, err := dstKeeper.GetWillByID(dstCtx, live.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WillStatusExpired, fired.Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 30), dstKeeper.GetBankKeeper().GetBalance(dstCtx, beneficiaryAddr, "uwill"))
+
+	// the execution receipts of the fired will move along with it
+	afterFiring := keeper.ExportGenesis(dstCtx, dstKeeper)
+	require.NoError(t, afterFiring.Validate())
+	require.Len(t, afterFiring.Receipts, 1)
+	assert.Equal(t, live.ID, afterFiring.Receipts[0].WillId)
+	assert.Equal(t, types.ComponentStatusExecuted, afterFiring.Receipts[0].Status)
+	nextKeeper, nextCtx, _ := setupAppKeeper(t)
+	_, err = keeper.InitGenesis(nextCtx, nextKeeper, *afterFiring)
+	require.NoError(t, err)
+	assert.Equal(t, afterFiring, keeper.ExportGenesis(nextCtx, nextKeeper))
+
+	t.Run("escrow not backed by the module account", func(t *testing.T) {
+		k, ctx, _ := setupAppKeeper(t)
+		_, err := keeper.InitGenesis(ctx, k, *exported)
+		require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+	})
+}
+
+func TestGenesisClaimWindows(t *testing.T) {
+	srcKeeper, srcCtx, srcApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("genesis-creator_____")
+	residuaryAddr := sdk.AccAddress("genesis-residuary___")
+	setupWithFundedAccount(t, srcApp, srcCtx, srcKeeper, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 20)))
+
+	component := claimComponent("c", types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}})
+	component.GetClaim().Window = &types.ClaimWindow{Blocks: 5}
+	component.GetClaim().Fallback = &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+		Address: residuaryAddr.String(),
+		Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(20)},
+	}}}
+	will, err := srcKeeper.CreateWill(srcCtx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "windowed will",
+		Beneficiary: creatorAddr.String(),
+		Height:      2,
+		Components:  []*types.ExecutionComponent{component},
+	})
+	require.NoError(t, err)
+	require.NoError(t, srcKeeper.BeginBlocker(srcCtx.WithBlockHeight(2)))
+
+	// a zero height export keeps the blocks left in the window
+	srcCtx = srcCtx.WithBlockHeight(4)
+	require.NoError(t, srcKeeper.RebaseWillHeights(srcCtx, srcCtx.BlockHeight()))
+	exported := keeper.ExportGenesis(srcCtx, srcKeeper)
+	require.NoError(t, exported.Validate())
+	assert.Equal(t, int64(3), exported.Wills[0].Components[0].GetClaim().LapseHeight)
+
+	// the open claim window is queued again on import
+	dstKeeper, dstCtx, _ := setupAppKeeper(t)
+	require.NoError(t, dstKeeper.GetBankKeeper().MintCoins(dstCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uwill", 20))))
+	_, err = keeper.InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+	require.NoError(t, dstKeeper.BeginBlocker(dstCtx.WithBlockHeight(2)))
+	active, err := dstKeeper.GetWillByID(dstCtx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusActive, active.Components[0].Status)
+
+	require.NoError(t, dstKeeper.BeginBlocker(dstCtx.WithBlockHeight(3)))
+	lapsed, err := dstKeeper.GetWillByID(dstCtx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusLapsed, lapsed.Components[0].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 20), dstKeeper.GetBankKeeper().GetBalance(dstCtx, residuaryAddr, "uwill"))
+}
+
+func TestGenesisRebaseWillHeights(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	creatorAddr := sdk.AccAddress("rebase-creator______")
+
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:          creatorAddr.String(),
+		Name:             "rebased will",
+		Beneficiary:      creatorAddr.String(),
+		Height:           120,
+		InactivityWindow: 10,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, kpr.RebaseWillHeights(ctx, ctx.BlockHeight()))
+	rebased, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), rebased.Height)
+
+	// the will now fires at the rebased height
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(20)))
+	fired, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WillStatusExpired, fired.Status)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	creator := sdk.AccAddress("genesis-creator_____").String()
+	claimer := sdk.AccAddress("genesis-claimer_____").String()
+	otherClaimer := sdk.AccAddress("genesis-other_______").String()
+	validWill := func(id string, height int64) types.Will {
+		return types.Will{ID: id, Creator: creator, Height: height, Status: types.WillStatusLive}
+	}
+	specs := map[string]struct {
+		mutate func(*types.GenesisState)
+		expErr bool
+	}{
+		"default": {
+			mutate: func(*types.GenesisState) {},
+		},
+		"wills and escrows": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10), validWill("b", 10)}
+				gs.Escrows = []types.WillEscrow{{WillId: "a", Coins: sdk.NewCoins(sdk.NewInt64Coin("uwill", 1))}}
+			},
+		},
+		"invalid params": {
+			mutate: func(gs *types.GenesisState) { gs.Params.MaxWillsPerHeight = 0 },
+			expErr: true,
+		},
+		"invalid port": {
+			mutate: func(gs *types.GenesisState) { gs.PortId = "" },
+			expErr: true,
+		},
+		"duplicate will": {
+			mutate: func(gs *types.GenesisState) { gs.Wills = []types.Will{validWill("a", 10), validWill("a", 11)} },
+			expErr: true,
+		},
+		"unknown will status": {
+			mutate: func(gs *types.GenesisState) {
+				w := validWill("a", 10)
+				w.Status = "dormant"
+				gs.Wills = []types.Will{w}
+			},
+			expErr: true,
+		},
+		"invalid creator": {
+			mutate: func(gs *types.GenesisState) {
+				w := validWill("a", 10)
+				w.Creator = "creator-address"
+				gs.Wills = []types.Will{w}
+			},
+			expErr: true,
+		},
+		"invalid remote channel": {
+			mutate: func(gs *types.GenesisState) {
+				w := validWill("a", 10)
+				w.RemoteChannels = []string{"channel-0", "not a channel"}
+				gs.Wills = []types.Will{w}
+			},
+			expErr: true,
+		},
+		"more live wills at a height than are scheduled in a block": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Params.MaxWillsPerHeight = 1
+				gs.Wills = []types.Will{validWill("a", 10), validWill("b", 10)}
+			},
+		},
+		"queue": {
+			mutate: func(gs *types.GenesisState) {
+				a, b := validWill("a", 10), validWill("b", 10)
+				a.Status, b.Status = types.WillStatusQueued, types.WillStatusQueued
+				gs.Wills = []types.Will{a, b}
+				gs.Queue = []string{"b", "a"}
+			},
+		},
+		"queued will not in the queue": {
+			mutate: func(gs *types.GenesisState) {
+				a := validWill("a", 10)
+				a.Status = types.WillStatusQueued
+				gs.Wills = []types.Will{a}
+			},
+			expErr: true,
+		},
+		"live will in the queue": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Queue = []string{"a"}
+			},
+			expErr: true,
+		},
+		"will in the queue twice": {
+			mutate: func(gs *types.GenesisState) {
+				a := validWill("a", 10)
+				a.Status = types.WillStatusQueued
+				gs.Wills = []types.Will{a}
+				gs.Queue = []string{"a", "a"}
+			},
+			expErr: true,
+		},
+		"unknown will in the queue": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Queue = []string{"a"}
+			},
+			expErr: true,
+		},
+		"pending packets": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.PendingPackets = []types.PendingPacket{
+					{Channel: "channel-0", Sequence: 1, WillId: "a", ComponentId: "send", SettledStatus: types.ComponentStatusExecuted},
+					{Channel: "channel-1", Sequence: 1, WillId: "a", ComponentId: "send", SettledStatus: types.ComponentStatusExecuted},
+				}
+			},
+		},
+		"pending packet for unknown will": {
+			mutate: func(gs *types.GenesisState) {
+				gs.PendingPackets = []types.PendingPacket{{Channel: "channel-0", Sequence: 1, WillId: "a", ComponentId: "send", SettledStatus: types.ComponentStatusExecuted}}
+			},
+			expErr: true,
+		},
+		"pending packet without a component": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.PendingPackets = []types.PendingPacket{{Channel: "channel-0", Sequence: 1, WillId: "a", SettledStatus: types.ComponentStatusExecuted}}
+			},
+			expErr: true,
+		},
+		"duplicate pending packet": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				packet := types.PendingPacket{Channel: "channel-0", Sequence: 1, WillId: "a", ComponentId: "send", SettledStatus: types.ComponentStatusExecuted}
+				gs.PendingPackets = []types.PendingPacket{packet, packet}
+			},
+			expErr: true,
+		},
+		"packet retries": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.PacketRetries = []types.PacketRetry{
+					{WillId: "a", ComponentId: "send", Height: 12, Attempt: 1},
+					{WillId: "a", ComponentId: "msg", Height: 12, Attempt: 2},
+				}
+			},
+		},
+		"packet retry for unknown will": {
+			mutate: func(gs *types.GenesisState) {
+				gs.PacketRetries = []types.PacketRetry{{WillId: "a", ComponentId: "send", Height: 12, Attempt: 1}}
+			},
+			expErr: true,
+		},
+		"packet retry without an attempt": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.PacketRetries = []types.PacketRetry{{WillId: "a", ComponentId: "send", Height: 12}}
+			},
+			expErr: true,
+		},
+		"duplicate packet retry": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				retry := types.PacketRetry{WillId: "a", ComponentId: "send", Height: 12, Attempt: 1}
+				gs.PacketRetries = []types.PacketRetry{retry, retry}
+			},
+			expErr: true,
+		},
+		"escrow for unknown will": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Escrows = []types.WillEscrow{{WillId: "a"}}
+			},
+			expErr: true,
+		},
+		"duplicate escrow": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Escrows = []types.WillEscrow{{WillId: "a"}, {WillId: "a"}}
+			},
+			expErr: true,
+		},
+		"nullifiers": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Nullifiers = []types.Nullifier{{Scheme: "schnorr", Nullifier: []byte{1}}, {Scheme: "custom", Nullifier: []byte{1}}}
+			},
+		},
+		"duplicate nullifier": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Nullifiers = []types.Nullifier{{Scheme: "schnorr", Nullifier: []byte{1}}, {Scheme: "schnorr", Nullifier: []byte{1}}}
+			},
+			expErr: true,
+		},
+		"empty nullifier": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Nullifiers = []types.Nullifier{{Scheme: "schnorr"}}
+			},
+			expErr: true,
+		},
+		"approvals": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Approvals = []types.Approval{
+					{WillId: "a", ComponentId: "c", Guardian: 0, Claimer: claimer},
+					{WillId: "a", ComponentId: "c", Guardian: 1, Claimer: claimer},
+					{WillId: "a", ComponentId: "c", Guardian: 1, Claimer: otherClaimer},
+				}
+			},
+		},
+		"duplicate approval": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Approvals = []types.Approval{{WillId: "a", ComponentId: "c", Guardian: 1, Claimer: claimer}, {WillId: "a", ComponentId: "c", Guardian: 1, Claimer: claimer}}
+			},
+			expErr: true,
+		},
+		"approval without claimer": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Approvals = []types.Approval{{WillId: "a", ComponentId: "c", Guardian: 1}}
+			},
+			expErr: true,
+		},
+		"approval for unknown will": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Approvals = []types.Approval{{WillId: "a", ComponentId: "c", Claimer: claimer}}
+			},
+			expErr: true,
+		},
+		"receipts": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Receipts = []types.ExecutionReceipt{{WillId: "a", Index: 0}, {WillId: "a", Index: 1}}
+			},
+		},
+		"duplicate receipt": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Wills = []types.Will{validWill("a", 10)}
+				gs.Receipts = []types.ExecutionReceipt{{WillId: "a", Index: 1}, {WillId: "a", Index: 1}}
+			},
+			expErr: true,
+		},
+		"receipt for unknown will": {
+			mutate: func(gs *types.GenesisState) {
+				gs.Receipts = []types.ExecutionReceipt{{WillId: "a"}}
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			spec.mutate(gs)
+			err := gs.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// Gas charged to verify a Groth16 proof, about 15 gas per microsecond of work
+// like the secp256k1 signature verification cost of the auth module.
+const (
+	// GasGnarkVerifyBase covers decoding the verifying key and proof, including their G2 subgroup checks
+	GasGnarkVerifyBase uint64 = 30_000
+	// GasGnarkVerifyPerPairing is charged for each of the pairings a verification computes
+	GasGnarkVerifyPerPairing uint64 = 15_000
+	// GasGnarkVerifyPerPublicInput is charged for each public input folded into the verifying key
+	GasGnarkVerifyPerPublicInput uint64 = 1_000
+)
+
+// gnarkClaimScheme verifies Groth16 proofs over BN254 against the verification key
+// of a gnark claim component. The will pins every public input but the last, which
+// is the ClaimerInput of the claimer, so a claim carries the proof alone and a proof
+// copied from another claim does not verify.
+type gnarkClaimScheme struct{}
+
+func (gnarkClaimScheme) Name() string { return types.ClaimSchemeGnark }
+
+// ValidateComponent checks a gnark claim component holds a usable verifying key
+// and pins every public input the key takes besides the claimer. A key whose K
+// point of an input is the point at infinity accepts proofs for any value of it,
+// so the pinned inputs and the claimer would not bind the proof to anything.
+func (gnarkClaimScheme) ValidateComponent(component *types.ClaimComponent) error {
+	scheme := component.GetGnark()
+	if scheme == nil {
+		return errors.Wrap(types.ErrInvalid, "gnark verification key not found in the component")
+	}
+	vk, err := groth16.UnmarshalVerifyingKey(scheme.VerificationKey)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "gnark verification key: %s", err)
+	}
+	if vk.NumPublicInputs() == 0 {
+		return errors.Wrap(types.ErrInvalid, "gnark verification key must take the claimer as its last public input")
+	}
+	if free := vk.FreeInputs(); len(free) > 0 {
+		return errors.Wrapf(types.ErrInvalid, "gnark verification key ignores public inputs %v", free)
+	}
+	inputs, err := groth16.UnmarshalPublicInputs(scheme.PublicInputs)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "gnark public inputs: %s", err)
+	}
+	if len(inputs) != vk.NumPublicInputs()-1 {
+		return errors.Wrapf(types.ErrInvalid, "gnark component pins %d public inputs, its verification key takes %d besides the claimer", len(inputs), vk.NumPublicInputs()-1)
+	}
+	return nil
+}
+
+// GasCost charges for decoding, the pairings and every public input the verification key declares
+func (gnarkClaimScheme) GasCost(component *types.ClaimComponent, _ *types.MsgClaimRequest) uint64 {
+	var inputs int
+	if scheme := component.GetGnark(); scheme != nil {
+		inputs = groth16.DeclaredPublicInputs(scheme.VerificationKey)
+	}
+	return GasGnarkVerifyBase + groth16.NumPairings*GasGnarkVerifyPerPairing + uint64(inputs)*GasGnarkVerifyPerPublicInput
+}
+
+func (gnarkClaimScheme) VerifyClaim(_ context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error {
+	claim := msg.GetGnarkClaim()
+	if claim == nil {
+		return errors.Wrapf(types.ErrInvalid, "expected a gnark claim, got %T", msg.ClaimType)
+	}
+	if len(claim.PublicInputs) != 0 {
+		return errors.Wrap(types.ErrInvalidProof, "the will pins the public inputs of a gnark claim")
+	}
+	scheme := component.GetGnark()
+	vk, err := groth16.UnmarshalVerifyingKey(scheme.VerificationKey)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "gnark verification key: %s", err)
+	}
+	proof, err := groth16.UnmarshalProof(claim.Proof)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "%s", err)
+	}
+	inputs, err := groth16.UnmarshalPublicInputs(scheme.PublicInputs)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "gnark public inputs: %s", err)
+	}
+	inputs = append(inputs, groth16.ClaimerInput(msg.Claimer))
+	if err := groth16.Verify(vk, proof, inputs); err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "%s", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"strconv"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// validateICA checks the connection and messages of an ICA component
+func validateICA(ica *types.ICAComponent) error {
+	if err := host.ConnectionIdentifierValidator(ica.ConnectionId); err != nil {
+		return errors.Wrapf(types.ErrInvalid, "ICA connection: %s", err)
+	}
+	if len(ica.Msgs) == 0 {
+		return errors.Wrap(types.ErrInvalid, "ICA component without messages")
+	}
+	for i, bz := range ica.Msgs {
+		var msg codectypes.Any
+		if err := msg.Unmarshal(bz); err != nil {
+			return errors.Wrapf(types.ErrInvalid, "ICA message %d is not a protobuf Any: %s", i, err)
+		}
+		if msg.TypeUrl == "" {
+			return errors.Wrapf(types.ErrInvalid, "ICA message %d without a type URL", i)
+		}
+	}
+	if len(ica.Memo) > icatypes.MaxMemoCharLength {
+		return errors.Wrapf(types.ErrInvalid, "ICA memo must not exceed %d characters", icatypes.MaxMemoCharLength)
+	}
+	return nil
+}
+
+// ICAOwner returns the owner of the interchain accounts the will module holds for a creator. The
+// owner is prefixed by the module name, so the accounts are apart from those the creator
+// registers itself.
+func ICAOwner(creator string) string {
+	return types.ModuleName + "." + creator
+}
+
+// registerInterchainAccounts registers the interchain accounts the ICA components of a new or updated will
+// use. An account that already has an open channel on its connection is reused.
+func (k Keeper) registerInterchainAccounts(ctx sdk.Context, will *types.Will) error {
+	registered := make(map[string]struct{})
+	for _, component := range will.Components {
+		ica := component.GetIca()
+		if ica == nil {
+			continue
+		}
+		if _, ok := registered[ica.ConnectionId]; ok {
+			continue
+		}
+		registered[ica.ConnectionId] = struct{}{}
+		if err := k.registerInterchainAccount(ctx, will, ica.ConnectionId); err != nil {
+			return errors.Wrapf(err, "registering the interchain account of component %s on %s", component.Id, ica.ConnectionId)
+		}
+	}
+	return nil
+}
+
+// registerInterchainAccount registers the interchain account of the creator of a will on a
+// connection, unless the account has an open channel there
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, will *types.Will, connectionID string) error {
+	owner := ICAOwner(will.Creator)
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalid, "interchain account owner %s: %s", owner, err)
+	}
+	if _, found := k.icaKeeper.GetOpenActiveChannel(ctx, connectionID, portID); found {
+		return nil
+	}
+	if err := k.icaKeeper.RegisterInterchainAccount(ctx, connectionID, owner, ""); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_ica_registered",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("owner", owner),
+			sdk.NewAttribute("port_id", portID),
+			sdk.NewAttribute("connection_id", connectionID),
+		),
+	)
+	return nil
+}
+
+// reopenInterchainAccount registers the interchain account of an ICA component again when its
+// channel is not open as the will fires. The ordered channel of an account closes when one of its
+// transactions times out, and the new channel serves the wills firing once it is open. A failure
+// is only logged, as the component fails either way.
+func (k Keeper) reopenInterchainAccount(ctx sdk.Context, will *types.Will, connectionID string) {
+	if err := k.registerInterchainAccount(ctx, will, connectionID); err != nil {
+		ctx.Logger().Error("registering the interchain account again failed", "will_id", will.ID, "connection_id", connectionID, "err", err)
+	}
+}
+
+// ExecuteICA sends the messages of an ICA component to its interchain account. The component is
+// pending until the transaction is acknowledged.
+func (k Keeper) ExecuteICA(ctx sdk.Context, component *types.ExecutionComponent, will types.Will) error {
+	ica := component.GetIca()
+	if ica == nil {
+		return errors.Wrapf(types.ErrInvalid, "component %s is not an ICA component", component.Id)
+	}
+	portID, err := icatypes.NewControllerPortID(ICAOwner(will.Creator))
+	if err != nil {
+		return err
+	}
+	channelID, found := k.icaKeeper.GetOpenActiveChannel(ctx, ica.ConnectionId, portID)
+	if !found {
+		return errors.Wrapf(icatypes.ErrActiveChannelNotFound, "no open interchain account channel on %s for %s", ica.ConnectionId, portID)
+	}
+	msgs := make([]*codectypes.Any, len(ica.Msgs))
+	for i, bz := range ica.Msgs {
+		msgs[i] = &codectypes.Any{}
+		if err := msgs[i].Unmarshal(bz); err != nil {
+			return errors.Wrapf(types.ErrInvalid, "ICA message %d: %s", i, err)
+		}
+	}
+	tx := icatypes.CosmosTx{Messages: msgs}
+	data, err := tx.Marshal()
+	if err != nil {
+		return err
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: ica.Memo,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(k.GetParams(ctx).IBCTimeout).UnixNano())
+	sequence, err := k.icaKeeper.SendTx(ctx, nil, ica.ConnectionId, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return errors.Wrapf(err, "sending the transaction of component %s", component.Id)
+	}
+	if err := k.pendingPackets.Set(ctx, collections.Join(channelID, sequence), types.PendingPacket{
+		Channel:       channelID,
+		Sequence:      sequence,
+		WillId:        will.ID,
+		ComponentId:   component.Id,
+		SettledStatus: types.ComponentStatusExecuted,
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_ica_tx_sent",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("component_id", component.Id),
+			sdk.NewAttribute("connection_id", ica.ConnectionId),
+			sdk.NewAttribute("channel", channelID),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute("msgs", strconv.Itoa(len(msgs))),
+		),
+	)
+	return nil
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"context"
 	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 	"time"
@@ -14,7 +13,6 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
-	"go.dedis.ch/kyber/v3/group/edwards25519"
 
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
@@ -26,11 +24,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
-	"github.com/CosmWasm/wasmd/x/will/schemes/schnorr"
+	"github.com/CosmWasm/wasmd/x/will/schemes/pedersen"
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
@@ -42,6 +41,15 @@ type IKeeper interface {
 	GetWillByID(ctx context.Context, id string) (*types.Will, error)
 	ListWillsByAddress(ctx context.Context, address string) ([]*types.Will, error)
 	Claim(ctx context.Context, msg *types.MsgClaimRequest) error
+	CheckIn(ctx context.Context, msg *types.MsgCheckInRequest) (*types.Will, error)
+	FundWill(ctx context.Context, msg *types.MsgFundWillRequest) (types.WillEscrow, error)
+	UpdateWill(ctx context.Context, msg *types.MsgUpdateWillRequest) (*types.Will, error)
+	CancelWill(ctx context.Context, msg *types.MsgCancelWillRequest) (sdk.Coins, error)
+	WithdrawVested(ctx context.Context, msg *types.MsgWithdrawVestedRequest) (sdk.Coins, error)
+	ExecuteWill(ctx context.Context, msg *types.MsgExecuteWillRequest) (sdk.Coins, error)
+	SendWillPacket(ctx context.Context, msg *types.MsgSendWillPacketRequest) (uint64, error)
+	GetAuthority() string
+	SetParams(ctx context.Context, ps types.Params) error
 }
 
 type IContractCall interface {
@@ -70,9 +78,28 @@ type (
 		// capabilityKeeper CapabilityKeeper
 		capabilityKeeper capabilitykeeper.Keeper
 		accountKeeper    authkeeper.AccountKeeper
-
-		params    collections.Item[types.Params]
-		authority string
+		transferKeeper   ICS20TransferKeeper
+		icaKeeper        ICAControllerKeeper
+
+		params     collections.Item[types.Params]
+		wills      *collections.IndexedMap[string, types.Will, WillIndexes]
+		escrows    collections.Map[string, types.WillEscrow]
+		nullifiers collections.KeySet[collections.Pair[string, []byte]]
+		approvals  collections.KeySet[collections.Triple[string, string, collections.Pair[string, uint32]]]
+		// claim windows by the block height or time they close at
+		lapsesByHeight collections.KeySet[collections.Triple[int64, string, string]]
+		lapsesByTime   collections.KeySet[collections.Triple[time.Time, string, string]]
+		receipts       collections.Map[collections.Pair[string, uint32], types.ExecutionReceipt]
+		// wills that fired, in the order they are executed
+		queue          collections.Map[uint64, string]
+		queueSequence  collections.Sequence
+		queuePositions collections.Map[string, uint64]
+		pendingPackets collections.Map[collections.Pair[string, uint64], types.PendingPacket]
+		// packets that timed out, by the block height they are sent again at
+		packetRetries collections.Map[collections.Triple[int64, string, string], types.PacketRetry]
+		authority     string
+
+		claimSchemes map[string]ClaimScheme
 	}
 
 	// ScopedKeeper struct {
@@ -100,9 +127,12 @@ func NewKeeper(
 	bk bankkeeper.Keeper,
 	pwk wasmkeeper.PermissionedKeeper,
 	ak authkeeper.AccountKeeper,
+	tk ICS20TransferKeeper,
+	ick ICAControllerKeeper,
+	authority string,
+	opts ...Option,
 ) Keeper {
-	// fmt.Println("NewKeeper:")
-	// sb := collections.NewSchemaBuilder(storeService)
+	sb := collections.NewSchemaBuilder(storeService)
 
 	// sk := ScopedKeeper {
 	// 		cdc      codec.BinaryCodec
@@ -127,12 +157,35 @@ func NewKeeper(
 		permissionedWasmKeeper: pwk,
 		capabilityKeeper:       capabilityKeeper,
 		accountKeeper:          ak,
+		transferKeeper:         tk,
+		icaKeeper:              ick,
+		params:                 collections.NewItem(sb, collections.NewPrefix(types.ParamsKey), "params", codec.CollValue[types.Params](cdc)),
+		wills:                  NewWillsMap(sb, cdc),
+		escrows:                NewEscrowsMap(sb, cdc),
+		nullifiers:             NewNullifiersSet(sb),
+		approvals:              NewApprovalsSet(sb),
+		lapsesByHeight:         NewLapsesByHeightSet(sb),
+		lapsesByTime:           NewLapsesByTimeSet(sb),
+		receipts:               NewReceiptsMap(sb, cdc),
+		queue:                  NewExecutionQueueMap(sb),
+		queueSequence:          NewExecutionQueueSequence(sb),
+		queuePositions:         NewQueuePositionsMap(sb),
+		pendingPackets:         NewPendingPacketsMap(sb, cdc),
+		packetRetries:          NewPacketRetriesMap(sb, cdc),
+		authority:              authority,
+		claimSchemes:           make(map[string]ClaimScheme),
+	}
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+	for _, o := range opts {
+		o.apply(keeper)
 	}
 
 	return *keeper
 }
 
-// GetParams returns the total set of wasm parameters.
+// GetParams returns the total set of will parameters.
 func (k Keeper) GetParams(ctx context.Context) types.Params {
 	p, err := k.params.Get(ctx)
 	if err != nil {
@@ -142,18 +195,11 @@ func (k Keeper) GetParams(ctx context.Context) types.Params {
 }
 
 // SetParams sets all will parameters.
-// func (k Keeper) SetParams(ctx context.Context, ps types.Params) error {
-// 	return k.params.Set(ctx, ps)
-// }
-
-// SetParams sets the transfer module parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	fmt.Println("SetParams k.storeKey")
-	fmt.Println(k.storeKey)
-	// store := ctx.KVStore(k.storeKey)
-	store := k.storeService.OpenKVStore(ctx)
-	bz := k.cdc.MustMarshal(&params)
-	store.Set([]byte(types.ParamsKey), bz)
+func (k Keeper) SetParams(ctx context.Context, ps types.Params) error {
+	if err := ps.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.params.Set(ctx, ps)
 }
 
 // GetAuthority returns the x/will module's authority.
@@ -196,17 +242,11 @@ func TruncateHash(input []byte, n int) ([]byte, error) {
 @param
 */
 func (k Keeper) GetWillByID(ctx context.Context, id string) (*types.Will, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx) // Make sure you have a way to convert or access sdk.Context
-	store := k.storeService.OpenKVStore(sdkCtx)
-	var will types.Will
 	fmt.Println("GetWillByID: " + id)
-	willBz, willErr := store.Get(types.GetWillKey(id))
-	fmt.Println("========= get will by id ============")
-	fmt.Println(willBz)
-	if willErr != nil {
-		return nil, fmt.Errorf("will with ID %s not found", id)
+	will, err := k.wills.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrWillNotFound, "will with ID %s not found", id)
 	}
-	k.cdc.MustUnmarshal(willBz, &will)
 	return &will, nil
 }
 
@@ -219,8 +259,8 @@ func (k Keeper) GetWillByID(ctx context.Context, id string) (*types.Will, error)
 //		fmt.Println("New Will ID: ", willID)
 //		return willID
 //	}
-func createWillId(creator string, name string, beneficiary string, height int64) string {
-	baseString := fmt.Sprintf("%s|%s|%s|%d", creator, name, beneficiary, height)
+func createWillId(creator string, name string, beneficiary string, trigger string) string {
+	baseString := fmt.Sprintf("%s|%s|%s|%s", creator, name, beneficiary, trigger)
 	hash := sha256.Sum256([]byte(baseString))
 	willID := fmt.Sprintf("did:will:%x", hash[:])
 	fmt.Println("New Will ID: ", willID)
@@ -233,16 +273,73 @@ func createWillId(creator string, name string, beneficiary string, height int64)
 @param
 */
 func (k *Keeper) CreateWill(ctx context.Context, msg *types.MsgCreateWillRequest) (*types.Will, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	if sdk.UnwrapSDKContext(ctx).BlockHeight() > msg.Height {
-		// var errheight error
-		fmt.Println("Target Block height is less than the current block height")
-		// return nil, errors.Wrap(errheight, "inside k.createWill, block height is greater than submitted will execution height")
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "block height %d is greater than submitted will execution height %d", sdk.UnwrapSDKContext(ctx).BlockHeight(), msg.Height)
+	if msg.InactivityWindow < 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "inactivity window %d must not be negative", msg.InactivityWindow)
+	}
+	if msg.InactivityDuration < 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "inactivity duration %s must not be negative", msg.InactivityDuration)
+	}
+	if _, ok := types.ExecutionMode_name[int32(msg.ExecutionMode)]; !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown execution mode %d", msg.ExecutionMode)
+	}
+	for _, channel := range msg.RemoteChannels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "remote channel %q: %s", channel, err)
+		}
+	}
+	if msg.Condition != nil {
+		if err := validateConditionTrigger(msg.Height, msg.InactivityWindow, msg.TriggerTime, msg.InactivityDuration); err != nil {
+			return nil, errors.Wrap(err, "inside k.createWill")
+		}
+	}
+	// a dead man's switch with a duration and no explicit trigger time first fires one duration from now
+	if msg.TriggerTime == nil && msg.InactivityDuration > 0 {
+		triggerTime := sdk.UnwrapSDKContext(ctx).BlockTime().Add(msg.InactivityDuration)
+		msg.TriggerTime = &triggerTime
+	}
+	trigger := strconv.FormatInt(msg.Height, 10)
+	switch {
+	case msg.Condition != nil:
+		if err := validateCondition(msg.Condition, k.GetParams(ctx).MaxConditionGas); err != nil {
+			return nil, errors.Wrap(err, "inside k.createWill")
+		}
+		// the condition is first evaluated one interval from now
+		msg.Condition.NextCheckHeight = sdk.UnwrapSDKContext(ctx).BlockHeight() + conditionInterval(msg.Condition)
+		trigger = fmt.Sprintf("%s|%s|%s|%s|%s", msg.Condition.Contract, msg.Condition.QueryMsg, msg.Condition.Path, msg.Condition.Operator, msg.Condition.Value)
+	case msg.TriggerTime != nil:
+		if err := validateTimeTrigger(ctx, msg.Height, msg.InactivityWindow, *msg.TriggerTime); err != nil {
+			return nil, errors.Wrap(err, "inside k.createWill")
+		}
+		trigger = msg.TriggerTime.UTC().Format(time.RFC3339Nano)
+	default:
+		// a dead man's switch without an explicit height first fires one window from now
+		if msg.Height == 0 && msg.InactivityWindow > 0 {
+			msg.Height = sdk.UnwrapSDKContext(ctx).BlockHeight() + msg.InactivityWindow
+			trigger = strconv.FormatInt(msg.Height, 10)
+		}
+		if sdk.UnwrapSDKContext(ctx).BlockHeight() > msg.Height {
+			// var errheight error
+			fmt.Println("Target Block height is less than the current block height")
+			// return nil, errors.Wrap(errheight, "inside k.createWill, block height is greater than submitted will execution height")
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "block height %d is greater than submitted will execution height %d", sdk.UnwrapSDKContext(ctx).BlockHeight(), msg.Height)
 
+		}
+		if msg.InactivityWindow > 0 {
+			if err := k.validateTriggerHorizon(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight()+msg.InactivityWindow); err != nil {
+				return nil, errors.Wrap(err, "inactivity window")
+			}
+		}
+	}
+	if err := k.validateWillSpec(ctx, msg.Height, msg.TriggerTime == nil && msg.Condition == nil, msg.Components); err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill")
+	}
+	// everything the will pays out is escrowed up front so it is there when the will fires
+	required, err := RequiredEscrow(msg.Components)
+	if err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill")
 	}
 	// Concatenate values to generate a unique hash
-	concatValues := createWillId(msg.Creator, msg.Name, msg.Beneficiary, msg.Height)
+	concatValues := createWillId(msg.Creator, msg.Name, msg.Beneficiary, trigger)
 	// idBytes := []byte(concatValues)
 
 	// Generate a truncated hash of the concatenated values
@@ -267,77 +364,323 @@ func (k *Keeper) CreateWill(ctx context.Context, msg *types.MsgCreateWillRequest
 		Name:        msg.Name,
 		Beneficiary: msg.Beneficiary,
 		Height:      msg.Height,
-		Status:      "live",
+		Status:      types.WillStatusLive,
 		Components:  msg.Components,
+
+		InactivityWindow: msg.InactivityWindow,
+
+		TriggerTime:        msg.TriggerTime,
+		InactivityDuration: msg.InactivityDuration,
+		Condition:          msg.Condition,
+		ExecutionMode:      msg.ExecutionMode,
+		RemoteChannels:     msg.RemoteChannels,
 	}
-	// Marshal the will object to bytes
-	willBz := k.cdc.MustMarshal(&will)
 	fmt.Println("inside k.createWill: " + concatValues)
-	if willBz == nil {
-		var errBz error
-		return nil, errors.Wrap(errBz, "inside k.createWill, willBz is nil") // Make sure to handle the error appropriately
+
+	// Store the will, the creator, beneficiary, height and status indexes follow it
+	if err := k.setWill(ctx, &will); err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill storeErr, KV store set threw an error")
+	}
+	if _, err := k.escrowCoins(ctx, will.ID, will.Creator, required); err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill")
+	}
+	if err := k.escrowDeposit(ctx, will.ID, will.Creator, k.GetParams(ctx).CreationDeposit); err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill")
+	}
+	if err := k.registerInterchainAccounts(sdk.UnwrapSDKContext(ctx), &will); err != nil {
+		return nil, errors.Wrap(err, "inside k.createWill")
 	}
 
-	// Use the GetWillKey function to get a unique byte key for this will
-	key := types.GetWillKey(concatValues)
-	// key := types.GetWillKey("zmxjiudojne844jdsbndsbdyuikdbaazxqetrsdshudjsdhuekdsxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxnxnmcnmcndhdiohsiodsdhsdoshdsdjksdhjksdsdsdhjsdjsdhjksdjshjdhjshdjksjdhsjdhks")
-	fmt.Println("KEY")
-	fmt.Println(key)
+	return &will, nil
+}
 
-	// Store the marshaled will in the module's store
-	// storeErr := store.Set(key, willBz)
-	storeErr := store.Set([]byte(key), willBz)
-	if storeErr != nil {
-		return nil, errors.Wrap(storeErr, "inside k.createWill storeErr, KV store set threw an error")
+// validateWillSpec checks a will's trigger height and components against the module params.
+// The trigger horizons are measured in blocks, so they only bound wills triggering at a height.
+func (k Keeper) validateWillSpec(ctx context.Context, height int64, atHeight bool, components []*types.ExecutionComponent) error {
+	params := k.GetParams(ctx)
+	if uint32(len(components)) > params.MaxComponentsPerWill {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "will has %d components, the maximum is %d", len(components), params.MaxComponentsPerWill)
+	}
+	if err := validateDependencies(components); err != nil {
+		return err
+	}
+	for _, component := range components {
+		componentType, err := types.ComponentTypeName(component)
+		if err != nil {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if !params.IsComponentTypeEnabled(componentType) {
+			return errors.Wrapf(types.ErrComponentTypeDisabled, "component %s is of type %s", component.Id, componentType)
+		}
+		if claim := component.GetClaim(); claim != nil {
+			scheme, err := k.claimScheme(claim)
+			if err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+			if err := scheme.ValidateComponent(claim); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+			if err := validateClaimWindow(claim); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+		if distribution := component.GetDistribution(); distribution != nil {
+			if err := validateDistribution(distribution); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+		if vesting := component.GetVesting(); vesting != nil {
+			if err := validateVesting(vesting); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+		if ibcMsg := component.GetIbcMsg(); ibcMsg != nil {
+			if err := validateIBCMsg(ibcMsg); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+		if send := component.GetIbcSend(); send != nil {
+			if err := validateIBCSend(send); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+		if ica := component.GetIca(); ica != nil {
+			if err := validateICA(ica); err != nil {
+				return errors.Wrapf(err, "component %s", component.Id)
+			}
+		}
+	}
+	if !atHeight {
+		return nil
+	}
+	return k.validateTriggerHorizon(ctx, height)
+}
+
+// validateTimeTrigger checks that a will with a trigger time has no trigger height or inactivity
+// window, and that its trigger time has not passed.
+func validateTimeTrigger(ctx context.Context, height, inactivityWindow int64, triggerTime time.Time) error {
+	if height != 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "a will triggers at either a height or a time, not both")
+	}
+	if inactivityWindow != 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "a will with a trigger time is checked in to with an inactivity duration, not a window")
 	}
-	///////////// store at height
-	// Assuming you want to store the will's ID under a key derived from its height for some indexing purpose
-	// Handling storage for heightKey with WillIds message
-	heightKey := types.GetWillKey(strconv.Itoa(int(will.Height)))
-	var willIdsAtHeight types.WillIds
-	existingWillsBz, _ := store.Get([]byte(heightKey)) // Simplified error handling
-	if existingWillsBz != nil {
-		k.cdc.MustUnmarshal(existingWillsBz, &willIdsAtHeight)
+	if blockTime := sdk.UnwrapSDKContext(ctx).BlockTime(); triggerTime.Before(blockTime) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "block time %s is after submitted will trigger time %s", blockTime, triggerTime)
 	}
+	return nil
+}
 
-	// TODO: make this a chain param to be changed via governance
-	if len(willIdsAtHeight.Ids) > 10 {
-		var errBlockHeightLength error
-		return nil, errors.Wrapf(errBlockHeightLength, "error: cannot add will during create will, too many wills at block height: %s", willIdsAtHeight.Ids)
+// validateConditionTrigger checks that a will with a condition trigger has no trigger height or
+// time, nor an inactivity window or duration, as there is nothing for a check-in to move.
+func validateConditionTrigger(height, inactivityWindow int64, triggerTime *time.Time, inactivityDuration time.Duration) error {
+	if height != 0 || triggerTime != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "a will triggers at either a height, a time or a condition")
+	}
+	if inactivityWindow != 0 || inactivityDuration != 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "a will with a condition trigger cannot be checked in to")
 	}
+	return nil
+}
 
-	willIdsAtHeight.Ids = append(willIdsAtHeight.Ids, will.ID)
-	updatedHeightBz := k.cdc.MustMarshal(&willIdsAtHeight)
-	store.Set([]byte(heightKey), updatedHeightBz)
+// validateTriggerHorizon checks that a trigger height is within the min and max trigger horizon.
+func (k Keeper) validateTriggerHorizon(ctx context.Context, height int64) error {
+	params := k.GetParams(ctx)
+	horizon := height - sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if horizon < params.MinTriggerHorizon {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "trigger height %d is %d blocks away, the minimum is %d", height, horizon, params.MinTriggerHorizon)
+	}
+	if params.MaxTriggerHorizon != 0 && horizon > params.MaxTriggerHorizon {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "trigger height %d is %d blocks away, the maximum is %d", height, horizon, params.MaxTriggerHorizon)
+	}
+	return nil
+}
 
-	// Handling storage for creator key, ensuring unique insertion
-	creatorKey := types.GetWillKey(msg.Creator)
-	var willIdsAtCreator types.WillIds
-	existingWillsForCreatorBz, _ := store.Get([]byte(creatorKey)) // Simplified error handling
-	if existingWillsForCreatorBz != nil {
-		k.cdc.MustUnmarshal(existingWillsForCreatorBz, &willIdsAtCreator)
+/*
+@name CheckIn
+@desc heartbeat for a dead man's switch, moves a live will's trigger height to the
+current height plus its inactivity window, or its trigger time to the current block
+time plus its inactivity duration
+@param msg MsgCheckInRequest signed by the creator of the will
+*/
+func (k *Keeper) CheckIn(ctx context.Context, msg *types.MsgCheckInRequest) (*types.Will, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	will, err := k.GetWillByID(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if will.Creator != msg.Creator {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the creator of will %s can check in", will.ID)
+	}
+	if will.Status != types.WillStatusLive {
+		return nil, errors.Wrapf(types.ErrWillNotLive, "will %s has status %s", will.ID, will.Status)
 	}
-	if !contains(willIdsAtCreator.Ids, will.ID) {
-		willIdsAtCreator.Ids = append(willIdsAtCreator.Ids, will.ID)
+	if will.TriggerTime != nil {
+		if will.InactivityDuration <= 0 {
+			return nil, errors.Wrapf(types.ErrNoInactivityWindow, "will %s", will.ID)
+		}
+		triggerTime := sdkCtx.BlockTime().Add(will.InactivityDuration)
+		will.TriggerTime = &triggerTime
+	} else {
+		if will.InactivityWindow <= 0 {
+			return nil, errors.Wrapf(types.ErrNoInactivityWindow, "will %s", will.ID)
+		}
+		will.Height = sdkCtx.BlockHeight() + will.InactivityWindow
+	}
+	will.LastCheckIn = sdkCtx.BlockHeight()
+	if err := k.setWill(ctx, will); err != nil {
+		return nil, errors.Wrap(err, "inside k.CheckIn, KV store set threw an error")
 	}
-	updatedCreatorBz := k.cdc.MustMarshal(&willIdsAtCreator)
-	store.Set([]byte(creatorKey), updatedCreatorBz)
 
-	fmt.Println("KEEPER TEST DEBUG:")
-	fmt.Println(will.ID)
-	fmt.Println(willIdsAtHeight.Ids)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("will_checkin",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("creator", will.Creator),
+			sdk.NewAttribute("height", strconv.FormatInt(will.Height, 10)),
+			sdk.NewAttribute("trigger_time", formatTriggerTime(will.TriggerTime)),
+		),
+	)
+	return will, nil
+}
 
-	return &will, nil
+// getOwnLiveWill loads a will for a creator-only operation, checking ownership and that it is live.
+func (k Keeper) getOwnLiveWill(ctx context.Context, creator string, willID string) (*types.Will, error) {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil {
+		return nil, err
+	}
+	if will.Creator != creator {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the creator of will %s can change it", will.ID)
+	}
+	if will.Status != types.WillStatusLive {
+		return nil, errors.Wrapf(types.ErrWillNotLive, "will %s has status %s", will.ID, will.Status)
+	}
+	return will, nil
 }
 
-// contains checks if a string is present in a slice of strings.
-func contains(slice []string, item string) bool {
-	for _, s := range slice {
-		if s == item {
-			return true
+/*
+@name UpdateWill
+@desc changes the beneficiary, trigger height or time, or components of a live will, keeping its ID.
+Any escrow shortfall is taken from the creator, and new components that need less return the
+surplus to the creator. The interchain accounts of ICA components are registered as on creation.
+@param msg MsgUpdateWillRequest signed by the creator of the will, zero values keep the current setting
+*/
+func (k *Keeper) UpdateWill(ctx context.Context, msg *types.MsgUpdateWillRequest) (*types.Will, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	will, err := k.getOwnLiveWill(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Beneficiary != "" {
+		will.Beneficiary = msg.Beneficiary
+	}
+	if len(msg.Components) > 0 {
+		will.Components = msg.Components
+	}
+	newHeight := will.Height
+	if msg.Height != 0 {
+		newHeight = msg.Height
+	}
+	switch {
+	case will.Condition != nil:
+		if err := validateConditionTrigger(newHeight, will.InactivityWindow, msg.TriggerTime, will.InactivityDuration); err != nil {
+			return nil, errors.Wrap(err, "inside k.UpdateWill")
+		}
+	case will.TriggerTime != nil:
+		if msg.TriggerTime != nil {
+			will.TriggerTime = msg.TriggerTime
+		}
+		if err := validateTimeTrigger(ctx, newHeight, will.InactivityWindow, *will.TriggerTime); err != nil {
+			return nil, errors.Wrap(err, "inside k.UpdateWill")
+		}
+	default:
+		if msg.TriggerTime != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "will %s triggers at a height, not a time", will.ID)
+		}
+		if sdkCtx.BlockHeight() > newHeight {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "block height %d is greater than submitted will execution height %d", sdkCtx.BlockHeight(), newHeight)
+		}
+	}
+	if err := k.validateWillSpec(ctx, newHeight, will.TriggerTime == nil && will.Condition == nil, will.Components); err != nil {
+		return nil, errors.Wrap(err, "inside k.UpdateWill")
+	}
+	if err := k.registerInterchainAccounts(sdkCtx, will); err != nil {
+		return nil, errors.Wrap(err, "inside k.UpdateWill")
+	}
+
+	will.Height = newHeight
+
+	// the updated components must still be fully covered by the escrow
+	required, err := RequiredEscrow(will.Components)
+	if err != nil {
+		return nil, errors.Wrap(err, "inside k.UpdateWill")
+	}
+	escrow, err := k.GetEscrow(ctx, will.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.escrowCoins(ctx, will.ID, will.Creator, escrowShortfall(required, escrow.Coins)); err != nil {
+		return nil, errors.Wrap(err, "inside k.UpdateWill")
+	}
+	// components that need less than before leave escrow the will never pays out
+	if surplus := escrowSurplus(escrow.Coins, required); len(msg.Components) > 0 && !surplus.IsZero() {
+		if err := k.releaseEscrowToCreator(sdkCtx, will, surplus); err != nil {
+			return nil, errors.Wrap(err, "inside k.UpdateWill")
 		}
 	}
-	return false
+
+	if err := k.setWill(ctx, will); err != nil {
+		return nil, errors.Wrap(err, "inside k.UpdateWill, KV store set threw an error")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("will_updated",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("beneficiary", will.Beneficiary),
+			sdk.NewAttribute("height", strconv.FormatInt(will.Height, 10)),
+			sdk.NewAttribute("trigger_time", formatTriggerTime(will.TriggerTime)),
+		),
+	)
+	return will, nil
+}
+
+// formatTriggerTime formats the trigger time of a will for events, empty for wills without one
+func formatTriggerTime(triggerTime *time.Time) string {
+	if triggerTime == nil {
+		return ""
+	}
+	return triggerTime.UTC().Format(time.RFC3339Nano)
+}
+
+/*
+@name CancelWill
+@desc revokes a live will, once it is no longer live it never fires,
+and whatever it holds in escrow is returned to the creator
+@param msg MsgCancelWillRequest signed by the creator of the will
+*/
+func (k *Keeper) CancelWill(ctx context.Context, msg *types.MsgCancelWillRequest) (sdk.Coins, error) {
+	will, err := k.getOwnLiveWill(ctx, msg.Creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	will.Status = types.WillStatusCancelled
+	if err := k.setWill(ctx, will); err != nil {
+		return nil, errors.Wrap(err, "inside k.CancelWill, KV store set threw an error")
+	}
+	refund, err := k.RefundEscrow(ctx, will)
+	if err != nil {
+		return nil, errors.Wrap(err, "inside k.CancelWill")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent("will_cancelled",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("creator", will.Creator),
+			sdk.NewAttribute("refund", refund.String()),
+		),
+	)
+	return refund, nil
 }
 
 /*
@@ -347,40 +690,133 @@ func contains(slice []string, item string) bool {
 */
 
 func (k Keeper) ListWillsByAddress(ctx context.Context, address string) ([]*types.Will, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	store := k.storeService.OpenKVStore(sdkCtx)
-
-	// Use address to construct the key for fetching associated will IDs
-	addressKey := types.GetWillKey(address)
-	willIDsBz, err := store.Get([]byte(addressKey))
+	wills, err := willsByIndex(ctx, k, k.wills.Indexes.Creator, address)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch will IDs for address")
+		return nil, errors.Wrap(err, "failed to fetch wills for address")
+	}
+
+	return wills, nil
+}
+
+// ClaimableComponents returns a page of the active claim components the access control of which
+// admits the given address, public ones included. The page runs over the claimant index, first
+// over the wills naming the address and then over the wills with a public claim component, so
+// the page key is a claimant index key and the limit counts the wills visited.
+func (k Keeper) ClaimableComponents(ctx context.Context, address string, pageReq *query.PageRequest) ([]types.ClaimableComponent, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) != 0 {
+		return nil, nil, errors.Wrap(types.ErrInvalid, "either offset or key is expected, got both")
+	}
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
 	}
-	if willIDsBz == nil {
-		// No wills associated with this address
-		return []*types.Will{}, nil
+	index := k.wills.Indexes.Claimant.refKeys
+	claimantKeys := []string{address, anyClaimant}
+	if pageReq.Reverse {
+		claimantKeys = []string{anyClaimant, address}
+	}
+	var start *collections.Pair[string, string]
+	if len(pageReq.Key) != 0 {
+		_, key, err := index.KeyCodec().Decode(pageReq.Key)
+		if err != nil || (key.K1() != address && key.K1() != anyClaimant) {
+			return nil, nil, errors.Wrap(types.ErrInvalid, "page key is not a claimant index key of the address")
+		}
+		for claimantKeys[0] != key.K1() {
+			claimantKeys = claimantKeys[1:]
+		}
+		start = &key
 	}
 
-	// Deserialize the will IDs
-	var willIds types.WillIds
-	err = k.cdc.Unmarshal(willIDsBz, &willIds)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal will IDs")
+	var (
+		claimable = []types.ClaimableComponent{}
+		offset    = pageReq.Offset
+		visited   uint64
+		total     uint64
+		nextKey   []byte
+	)
+	for _, claimant := range claimantKeys {
+		rng := collections.NewPrefixedPairRange[string, string](claimant)
+		if start != nil && start.K1() == claimant {
+			if pageReq.Reverse {
+				rng = rng.EndInclusive(start.K2())
+			} else {
+				rng = rng.StartInclusive(start.K2())
+			}
+		}
+		if pageReq.Reverse {
+			rng = rng.Descending()
+		}
+		if err := index.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+			if claimant == anyClaimant {
+				// the wills naming the address are listed with its own index entries
+				named, err := index.Has(ctx, collections.Join(address, key.K2()))
+				if err != nil || named {
+					return err != nil, err
+				}
+			}
+			total++
+			switch {
+			case offset > 0:
+				offset--
+			case visited < limit:
+				visited++
+				will, err := k.wills.Get(ctx, key.K2())
+				if err != nil {
+					return true, err
+				}
+				components, err := claimableComponents(will, address)
+				if err != nil {
+					return true, err
+				}
+				claimable = append(claimable, components...)
+			case nextKey == nil:
+				bz := make([]byte, index.KeyCodec().Size(key))
+				if _, err := index.KeyCodec().Encode(bz, key); err != nil {
+					return true, err
+				}
+				nextKey = bz
+				return !countTotal, nil
+			}
+			return false, nil
+		}); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to fetch wills for claimant")
+		}
+		if nextKey != nil && !countTotal {
+			break
+		}
+	}
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = total
 	}
+	return claimable, pageRes, nil
+}
 
-	// Fetch and collect the wills by their IDs
-	var wills []*types.Will
-	for _, willID := range willIds.Ids {
-		will, err := k.GetWillByID(ctx, willID)
-		if err != nil {
-			// Log the error and continue to the next ID if a specific will cannot be fetched
-			fmt.Printf("Error fetching will by ID %s: %v\n", willID, err)
+// claimableComponents returns the active claim components of a will the access control of which
+// admits the given address
+func claimableComponents(will types.Will, address string) ([]types.ClaimableComponent, error) {
+	var claimable []types.ClaimableComponent
+	for _, component := range will.Components {
+		claim, ok := component.ComponentType.(*types.ExecutionComponent_Claim)
+		if !ok || component.Status != types.ComponentStatusActive || !claim.Claim.Access.Admits(address) {
 			continue
 		}
-		wills = append(wills, will)
+		scheme, err := types.ClaimSchemeName(claim.Claim)
+		if err != nil {
+			return nil, errors.Wrapf(err, "will %s component %s", will.ID, component.Id)
+		}
+		_, public := claim.Claim.Access.AccessType.(*types.ClaimAccessControl_Public)
+		claimable = append(claimable, types.ClaimableComponent{
+			WillId:      will.ID,
+			ComponentId: component.Id,
+			Scheme:      scheme,
+			Public:      public,
+		})
 	}
-
-	return wills, nil
+	return claimable, nil
 }
 
 /*
@@ -389,16 +825,8 @@ func (k Keeper) ListWillsByAddress(ctx context.Context, address string) ([]*type
 @param
 */
 func (k Keeper) updateWillStatusAndStore(ctx context.Context, will *types.Will, componentIndex int) error {
-	fmt.Println("Updating will status and storing it")
-	store := k.storeService.OpenKVStore(ctx)
-	// concatValues := createWillId(will.Creator, will.Name, will.Beneficiary, will.Height)
-	// willID := hex.EncodeToString([]byte(concatValues))
-	willID := createWillId(will.Creator, will.Name, will.Beneficiary, will.Height)
-	key := types.GetWillKey(willID)
-	fmt.Println(fmt.Sprintf("Storing will with ID: %s", willID))
-
-	willBz := k.cdc.MustMarshal(will)
-	storeErr := store.Set(key, willBz)
+	// the will ID is fixed at creation, check-ins move the height it was derived from
+	storeErr := k.setWill(ctx, will)
 	if storeErr != nil {
 		return errors.Wrapf(storeErr, "error: could not save will ID with updated component status")
 	}
@@ -438,8 +866,19 @@ func (k Keeper) Claim(ctx context.Context, msg *types.MsgClaimRequest) error {
 		return fmt.Errorf("will with ID %s is blank", msg.WillId)
 	}
 
+	// claimers need not wait for the execution queue, claiming from a due will executes it first
+	if will.Status == types.WillStatusLive || will.Status == types.WillStatusQueued {
+		claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "claimer %s: %s", msg.Claimer, err)
+		}
+		if _, err := k.executeDueWill(sdk.UnwrapSDKContext(ctx), will, claimer); err != nil {
+			return errors.Wrap(err, "executing will before the claim")
+		}
+	}
+
 	// will must be expired
-	if will.Status != "expired" {
+	if will.Status != types.WillStatusExpired {
 		fmt.Println("CANNOT CLAIM WILL, AS IT IS NOT EXPIRED")
 		return fmt.Errorf("will with ID %s is NOT EXPIRED", msg.WillId)
 	}
@@ -466,7 +905,7 @@ func (k Keeper) Claim(ctx context.Context, msg *types.MsgClaimRequest) error {
 	// At this point, you have the index of the component being claimed.
 	// var component *types.ExecutionComponent = will.Components[componentIndex]
 	// You can now check its status before proceeding with the claim.
-	if will.Components[componentIndex].Status != "active" {
+	if will.Components[componentIndex].Status != types.ComponentStatusActive {
 		fmt.Printf("component with ID %s is not active and cannot be claimed\n", msg.ComponentId)
 		return fmt.Errorf("component with ID %s is not active and cannot be claimed", msg.ComponentId)
 	}
@@ -477,177 +916,65 @@ func (k Keeper) Claim(ctx context.Context, msg *types.MsgClaimRequest) error {
 		return fmt.Errorf("component with ID %s, Access Errored: %s", msg.ComponentId, accessErr)
 	}
 
-	var claimErr error
-
-	// Process the claim based on its type
-	switch claim := msg.ClaimType.(type) {
-	case *types.MsgClaimRequest_SchnorrClaim:
-
-		// todo: invoke schno
-
-		// Assuming the public key and signature are provided as byte slices in the claim
-		fmt.Println(claim)
-		// TODO: pass in the component, not the component id lol
-		claimErr = k.processSchnorrClaim(ctx, claim, will, componentIndex)
-		if claimErr != nil {
-			return fmt.Errorf("component with ID %s processSchnorrClaim FAILED and cannot be claimed: %s", msg.ComponentId, claimErr)
-		}
-
-		fmt.Println("Schnorr signature verified and saved now successfully.")
-		k.OutputHandler(sdk.UnwrapSDKContext(ctx), will.Components[componentIndex], *will)
-
-	case *types.MsgClaimRequest_PedersenClaim:
-
-		// Process PedersenClaim
-		fmt.Printf("Processing Pedersen claim with commitment: %x, blinding factor: %x, and value: %x\n", claim.PedersenClaim.Commitment, claim.PedersenClaim.BlindingFactor, claim.PedersenClaim.Value)
-		// TODO
-		fmt.Println(claim)
-
-		claimErr = k.processPedersenClaim(ctx, will, componentIndex, claim)
-		if claimErr != nil {
-			return fmt.Errorf("component with ID %s processPedersenClaim FAILED and cannot be claimed: %s", msg.ComponentId, claimErr)
+	claimComponent := will.Components[componentIndex].GetClaim()
+	if claimComponent == nil {
+		return errors.Wrapf(types.ErrInvalid, "component with ID %s is not a claim component", msg.ComponentId)
+	}
+	scheme, err := k.claimScheme(claimComponent)
+	if err != nil {
+		return errors.Wrapf(err, "component with ID %s", msg.ComponentId)
+	}
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(scheme.GasCost(claimComponent, msg), scheme.Name()+" claim: verify")
+	var nullifier []byte
+	if nullifying, ok := scheme.(NullifyingClaimScheme); ok {
+		if nullifier, err = k.checkNullifier(ctx, nullifying, claimComponent, msg); err != nil {
+			return errors.Wrapf(err, "component with ID %s cannot be claimed", msg.ComponentId)
 		}
-
-		fmt.Println("Pedersen commitments verified and saved now successfully.")
-		k.OutputHandler(sdk.UnwrapSDKContext(ctx), will.Components[componentIndex], *will)
-
-	case *types.MsgClaimRequest_GnarkClaim:
-		// Process GnarkClaim
-		fmt.Printf("Processing Gnark claim with proof: %x and public inputs: %x\n", claim.GnarkClaim.Proof, claim.GnarkClaim.PublicInputs)
-		// TODO
-
-		k.OutputHandler(sdk.UnwrapSDKContext(ctx), will.Components[componentIndex], *will)
-
-	default:
-		// Handle unknown claim type
-		fmt.Println("unknown claim type provided")
-		return fmt.Errorf("unknown claim type provided")
 	}
-
-	if claimErr != nil {
-		return claimErr // Properly propagate the error
+	if err := scheme.VerifyClaim(ctx, claimComponent, msg); err != nil {
+		return errors.Wrapf(err, "component with ID %s cannot be claimed", msg.ComponentId)
 	}
-
-	// Assuming the claim has been validated successfully, you can then update the will's status or components accordingly
-	return nil
-}
-
-/*
-@name
-@desc
-@param
-*/
-func (k Keeper) processSchnorrClaim(ctx context.Context, claim *types.MsgClaimRequest_SchnorrClaim, will *types.Will, componentIndex int) error {
-	// publicKeyBytes := claim.SchnorrClaim.PublicKey // The public key bytes
-	// NOTE: use the public key from the will
-	// publicKeyBytes, _ := hex.DecodeString(string(claim.SchnorrClaim.PublicKey))
-	// publicKeyBytes, _ := hex.DecodeString(string(claim.SchnorrClaim.PublicKey))
-	publicKeyBytes, _ := hex.DecodeString(string(claim.SchnorrClaim.PublicKey))
-
-	fmt.Printf("string claim.SchnorrClaim.PublicKey %s: \n", string(claim.SchnorrClaim.PublicKey))
-	fmt.Printf("claim.SchnorrClaim.PublicKey %s: \n", claim.SchnorrClaim.PublicKey)
-	fmt.Printf("publicKeyBytes %s: \n", publicKeyBytes)
-
-	// signatureBytes := claim.SchnorrClaim.Signature // The signature bytes
-	signatureBytes, _ := hex.DecodeString(string(claim.SchnorrClaim.Signature))
-	fmt.Printf("string claim.SchnorrClaim.Signature %s: \n", string(claim.SchnorrClaim.Signature))
-	fmt.Printf("claim.SchnorrClaim.Signature %s: \n", claim.SchnorrClaim.Signature)
-	fmt.Printf("Signature %s: \n", signatureBytes)
-
-	message := claim.SchnorrClaim.Message // The message as a byte slice
-	// message, _ := hex.DecodeString(string(claim.SchnorrClaim.Message))
-
-	curve := edwards25519.NewBlakeSHA256Ed25519()
-	// Convert the public key bytes to a kyber.Point
-	publicKeyPoint := curve.Point()
-	if err := publicKeyPoint.UnmarshalBinary(publicKeyBytes); err != nil {
-		fmt.Printf("failed to unmarshal public key: %v\n", err)
-		return fmt.Errorf("failed to unmarshal public key: %v", err)
-	}
-
-	// Assuming the signature consists of R and S components concatenated
-	// and that each component is of equal length
-	sigLen := len(signatureBytes) / 2
-	rBytes := signatureBytes[:sigLen]
-	sBytes := signatureBytes[sigLen:]
-
-	// Convert R and S bytes to kyber.Point and kyber.Scalar respectively
-	r := curve.Point()
-	if err := r.UnmarshalBinary(rBytes); err != nil {
-		fmt.Printf("failed to unmarshal R component: %v", err)
-		return fmt.Errorf("failed to unmarshal R component: %v", err)
-	}
-	s := curve.Scalar().SetBytes(sBytes)
-
-	// Hash the message to a scalar using your Schnorr Hash function
-	messageScalar := schnorr.Hash(string(message) + string(hex.EncodeToString(publicKeyBytes))) // Convert the message to a string if your Hash function expects a string
-
-	// Construct the Signature struct
-	schnorrSignature := schnorr.Signature{R: r, S: s}
-
-	// Verify the Schnorr signature
-	if !schnorr.Verify(messageScalar, schnorrSignature, publicKeyPoint) {
-		return fmt.Errorf("schnorr signature verification failed")
-		// panic(99)
-	}
-	// panic(99)
-
-	// TODO: IF MESSAGE IS ENCRYPTED:?
-	// verify the encrypted message matches one stored in will
-
-	fmt.Println("Schnorr signature verified successfully.")
-	will.Components[componentIndex].Status = "claimed"
-	return k.updateWillStatusAndStore(ctx, will, componentIndex)
-}
-
-/*
-@name
-@desc
-@param
-*/
-func (k Keeper) processPedersenClaim(ctx context.Context, will *types.Will, componentIndex int, claim *types.MsgClaimRequest_PedersenClaim) error {
-	fmt.Println("Starting processPedersenClaim")
-
-	// Extract the Pedersen commitment from the component
-	storedCommitment := will.Components[componentIndex].GetClaim().GetPedersen()
-
-	if storedCommitment == nil {
-		return fmt.Errorf("Error: Pedersen commitment not found in the component")
+	if nullifier != nil {
+		if err := k.nullifiers.Set(ctx, collections.Join(scheme.Name(), nullifier)); err != nil {
+			return err
+		}
 	}
-
-	fmt.Println("1: ", storedCommitment.Commitment)
-	// Deserialize the stored commitment and target commitment
-	storedCommitmentPoint, err := k.DeserializeCommitment(storedCommitment.Commitment)
-	if err != nil {
-		return fmt.Errorf("failed to deserialize stored commitment: %v", err)
+	if threshold, ok := scheme.(ThresholdClaimScheme); ok {
+		approved, err := k.approve(ctx, threshold, claimComponent, msg)
+		if err != nil {
+			return errors.Wrapf(err, "component with ID %s", msg.ComponentId)
+		}
+		if required := threshold.Threshold(claimComponent); approved < required {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+				sdk.NewEvent("will_claim_approved",
+					sdk.NewAttribute("will_id", msg.WillId),
+					sdk.NewAttribute("component_id", msg.ComponentId),
+					sdk.NewAttribute("approvals", strconv.FormatUint(uint64(approved), 10)),
+					sdk.NewAttribute("threshold", strconv.FormatUint(uint64(required), 10)),
+				),
+			)
+			return nil
+		}
+		if err := k.clearApprovals(ctx, msg.WillId, msg.ComponentId); err != nil {
+			return err
+		}
 	}
 
-	fmt.Println("2")
-	claimCommitmentPoint, err := k.DeserializeCommitment(claim.PedersenClaim.Commitment)
-	if err != nil {
-		return fmt.Errorf("failed to deserialize claimed commitment: %v", err)
+	will.Components[componentIndex].Status = types.ComponentStatusClaimed
+	if err := k.dequeueLapse(ctx, will.ID, will.Components[componentIndex]); err != nil {
+		return err
 	}
-
-	fmt.Println("3")
-	targetCommitmentPoint, err := k.DeserializeCommitment(storedCommitment.TargetCommitment)
-	if err != nil {
-		return fmt.Errorf("failed to deserialize target commitment: %v", err)
+	if err := k.updateWillStatusAndStore(ctx, will, componentIndex); err != nil {
+		return err
 	}
-
-	// Add commitments
-	resultCommitment := k.AddCommitments(storedCommitmentPoint, claimCommitmentPoint)
-	fmt.Println(storedCommitmentPoint)
-	fmt.Println(claimCommitmentPoint)
-	fmt.Println(targetCommitmentPoint)
-	fmt.Println(resultCommitment)
-	// Check if the result matches the target
-	if !resultCommitment.Equals(&targetCommitmentPoint) {
-		return fmt.Errorf("commitment verification failed")
+	// a claim whose output cannot be paid fails, so neither the component nor the proof is used up
+	if err := k.OutputHandler(sdk.UnwrapSDKContext(ctx), will.Components[componentIndex], *will); err != nil {
+		return errors.Wrapf(err, "paying the output of component %s", msg.ComponentId)
 	}
+	k.refundSurplus(sdk.UnwrapSDKContext(ctx), will.ID)
 
-	fmt.Println("Commitment verified successfully.")
-	will.Components[componentIndex].Status = "claimed"
-	return k.updateWillStatusAndStore(ctx, will, componentIndex)
+	// Assuming the claim has been validated successfully, you can then update the will's status or components accordingly
+	return nil
 }
 
 /*
@@ -683,12 +1010,7 @@ func (k Keeper) AddCommitments(a, b ristretto.Point) ristretto.Point {
 
 // Deserialize a commitment from bytes to a ristretto.Point
 func (k Keeper) DeserializeCommitment(data []byte) (ristretto.Point, error) {
-	var point ristretto.Point
-	err := point.UnmarshalBinary(data)
-	if err != nil {
-		return ristretto.Point{}, err // return an empty point on error
-	}
-	return point, nil
+	return pedersen.UnmarshalCommitment(data)
 }
 
 // ///////////////////////////////////// expirations
@@ -733,127 +1055,23 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	blockHeight := ctx.BlockHeight()
 	fmt.Printf("Processing wills at block height: %d\n", blockHeight)
 
-	// Access the store
-	store := k.storeService.OpenKVStore(ctx)
-
-	// Construct the height key to fetch will IDs associated with the current block height
-	heightKey := types.GetWillKey(strconv.Itoa(int(blockHeight)))
-	willIDsBz, err := store.Get([]byte(heightKey))
-
-	// If there is an error fetching the will IDs or if there are no wills for this block height, return early
-	if err != nil || willIDsBz == nil {
-		fmt.Println("No wills to process for this block height or unable to fetch will IDs.")
-		return nil
+	// claim components whose claim window closed run their fallback outputs
+	if err := k.lapseClaims(ctx); err != nil {
+		return errors.Wrapf(err, "lapsing claims at block height %d", blockHeight)
 	}
 
-	// Deserialize the list of will IDs
-	var willIds types.WillIds
-	err = k.cdc.Unmarshal(willIDsBz, &willIds)
-	if err != nil {
-		fmt.Printf("Error unmarshaling will IDs: %v\n", err)
-		return nil
+	// the packets of will components that timed out are sent again once their backoff is over
+	if err := k.retryPackets(ctx); err != nil {
+		return errors.Wrapf(err, "retrying will packets at block height %d", blockHeight)
 	}
 
-	// Iterate over each will ID
-	for _, willID := range willIds.Ids {
-		// Fetch the will object using its ID
-		will, err := k.GetWillByID(ctx, willID)
-		if err != nil {
-			fmt.Printf("Error fetching will with ID %s: %v\n", willID, err)
-			continue // Proceed to the next will if there's an issue fetching this one
-		}
-
-		// Perform the desired operations on the will object here
-		// This is where you would implement the logic specific to your application's requirements
-		fmt.Printf("Successfully fetched will with ID %s for further processing.\n", will.ID)
-
-		// if the will is not live, because this transition should only happen is the will is going from inactive->active (maybe terminology can be better)
-		if will.Status != "live" {
-			fmt.Printf("Error executing will components with WILL ID %s, will is NOT EXPIRED: %v\n", willID, err)
-			continue
-		}
-
-		for component_index, component := range will.Components {
-			fmt.Printf("Iterating over compnents for will ID %s for further processing.\n", will.ID)
-			fmt.Println(component_index)
-			fmt.Println(component)
-			switch c := component.ComponentType.(type) {
-
-			case *types.ExecutionComponent_Transfer:
-				fmt.Printf("Transfer component found, to: %s, amount: %s\n", c.Transfer.To, c.Transfer.Amount.String())
-
-				// TODO: actually execute the token send
-				transferErr := k.ExecuteTransfer(ctx, component, *will)
-				if transferErr != nil {
-					continue
-				}
-
-				// update status to executed
-				component.Status = "executed"
-
-				// TODO: should we do outputs on execution components, or only claims?
-				// HandleOutput()
-				// k.OutputHandler(ctx, component, *will)
-
-			case *types.ExecutionComponent_Claim:
-				fmt.Printf("Claim component found, evidence")
-				// set all claimable components to active - can now have claims submitted
-				component.Status = "active"
-				// fmt.Printf("Claim component found, evidence: %s\n", c.Claim.Evidence)
-
-				// TODO:
-				// DONT EXECUTE HandleOutput()
-			case *types.ExecutionComponent_Contract:
-
-				_, err := k.ExecuteContract(ctx, c, will.Creator)
-				if err != nil {
-					// Handle error, maybe log it or take appropriate action.
-					continue
-				}
-
-				// Update the status based on the execution result.
-				component.Status = "executed"
-				// Handle other component outputs if necessary.
-				// k.OutputHandler(ctx, component, *will)
-
-			case *types.ExecutionComponent_IbcMsg:
-				// send an IBC message
-				// TODO: DEV TESTING FOR SENDIBCMESSAGE
-				// HandleOutput()
-
-				k.SendIBCMessage(sdk.UnwrapSDKContext(ctx), component, *will)
-				// change status depending on result
-				component.Status = "executed"
-
-				// k.OutputHandler(ctx, component, *will)
-
-			case *types.ExecutionComponent_IbcSend:
-				// change status depending on result
-				component.Status = "executed"
-
-			default:
-				fmt.Println("Unknown component type found")
-			}
-		}
-
-		fmt.Printf("Will ID: %s, Name: %s, Beneficiary: %s, Height: %d\n", will.ID, will.Name, will.Beneficiary, will.Height)
-
-		// update will
-		will.Status = "expired"
-		// concatValues := createWillId(will.Creator, will.Name, will.Beneficiary, will.Height)
-		// willID := hex.EncodeToString([]byte(concatValues))
-		// willID := hex.EncodeToString(idString)
-		willID := createWillId(will.Creator, will.Name, will.Beneficiary, will.Height)
-		key := types.GetWillKey(willID)
-		fmt.Println(fmt.Printf("BEGIN BLOCKER WILL EXECUTED: %s", willID))
-
-		willBz := k.cdc.MustMarshal(will)
-		storeErr := store.Set(key, willBz)
-
-		if storeErr != nil {
-			return errors.Wrapf(storeErr, "inside k.beginBlocker storeErr, KV store set threw an error after updating will: %s", will.ID)
-		}
-
+	// the due wills join the execution queue, whatever does not fit in the gas of this block
+	// is carried over to the next ones
+	if err := k.scheduleDueWills(ctx); err != nil {
+		return err
+	}
+	if err := k.processQueue(ctx); err != nil {
+		return errors.Wrapf(err, "processing the will execution queue at block height %d", blockHeight)
 	}
 
 	// DEBUG
@@ -886,8 +1104,8 @@ func (k Keeper) OutputHandler(ctx sdk.Context, component *types.ExecutionCompone
 			return err
 		}
 		coins := sdk.NewCoins(*output.OutputTransfer.Amount)
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, coins); err != nil {
-			return fmt.Errorf("failed to send coins: %v", err)
+		if err := k.releaseEscrow(ctx, will.ID, toAddr, coins); err != nil {
+			return fmt.Errorf("failed to send coins: %w", err)
 		}
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent("transfer",
@@ -911,9 +1129,7 @@ func (k Keeper) OutputHandler(ctx sdk.Context, component *types.ExecutionCompone
 		return nil
 
 	case *types.ComponentOutput_OutputIbcSend:
-		// Adjust to match the correct parameters and method definition
-		// data := []byte(output.OutputIbcSend.Denom) // Simplistic assumption; adjust as needed!
-		return k.SendIBCMessage(ctx, component, will)
+		return k.sendOutputTransfer(ctx, component, will, output.OutputIbcSend)
 
 	case *types.ComponentOutput_OutputEmit:
 		ctx.EventManager().EmitEvent(
@@ -982,33 +1198,13 @@ func (k *Keeper) ExecuteTransfer(ctx sdk.Context, component *types.ExecutionComp
 	// Prepare the coins for transfer
 	coins := sdk.NewCoins(*transferComponent.Transfer.Amount)
 
-	// Parse addresses
-	// fromAddr, err := sdk.AccAddressFromBech32(transferComponent.Transfer.From)
-
-	// fmt.Println(transferComponent.Transfer.From)
-	fmt.Println(will.Creator)
-	fmt.Println(transferComponent.Transfer.To)
-
-	fromAddr, err := sdk.AccAddressFromBech32(will.Creator)
-	if err != nil {
-		return fmt.Errorf("parsing from address failed: %w", err)
-	}
-
 	toAddr, err := sdk.AccAddressFromBech32(transferComponent.Transfer.To)
 	if err != nil {
 		return fmt.Errorf("parsing to address failed: %w", err)
 	}
 
-	// fromAddr := sdk.AccAddress{}
-	// toAddr := sdk.AccAddress{}
-
-	// Use the bank module's SendCoinsFromAccountToAccount to transfer the coins
-	fmt.Println("ExecuteTransfer")
-	fmt.Println(fromAddr)
-	fmt.Println(toAddr)
-
-	fmt.Println(coins)
-	if err := k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, coins); err != nil {
+	// pay out of the coins escrowed for the will at creation
+	if err := k.releaseEscrow(ctx, will.ID, toAddr, coins); err != nil {
 		return fmt.Errorf("failed to send coins: %w", err)
 	}
 
@@ -1062,64 +1258,89 @@ func (k Keeper) ExecutePrivateTransfer(ctx sdk.Context, component *types.Executi
 
 //////////////////////////////////////////////// IBC
 
-// SendIBCMessage sends an IBC message from the specified port and channel with the given data
+// validateIBCMsg checks the channel and will packet of an IBC message component
+func validateIBCMsg(msg *types.IBCMsgComponent) error {
+	if err := host.ChannelIdentifierValidator(msg.Channel); err != nil {
+		return errors.Wrapf(types.ErrInvalid, "IBC message channel: %s", err)
+	}
+	if _, err := types.DecodeWillPacketData(msg.Data); err != nil {
+		return err
+	}
+	return validateRetryPolicy(msg.Retry)
+}
+
+// SendIBCMessage sends the will packet of an IBC message component from the will port. The
+// packet acts for the creator of the will, and a will triggered notification is about the will
+// itself, whatever the component holds. The component is pending until the packet is
+// acknowledged.
 func (k *Keeper) SendIBCMessage(ctx sdk.Context, component *types.ExecutionComponent, will types.Will) error {
-	fmt.Println("SendIBCMessage 1")
-	var channelID, portID string
-	var data []byte
-
-	channelID = component.GetIbcMsg().Channel
-	portID = component.GetIbcMsg().PortId
-	data = component.GetIbcMsg().Data // Assuming Data is a field in IbcContractCall
-	fmt.Println("send ibc messsage DEBUG CHANNEL KEEPER")
-	fmt.Println(k.GetChannelKeeper())
-	fmt.Println(k.channelKeeper)
-	// panic(99)
-	// sequence, found := k.GetChannelKeeper().GetNextSequenceSend(ctx, portID, channelID)
-	sequence, found := k.GetChannelKeeper().GetNextSequenceSend(ctx, "will", channelID)
-	fmt.Println("will.keeper SendIBCMessage, sequence: ", sequence, " : ", found)
-	if !found {
-		fmt.Println("sequence not found for channel")
-		return fmt.Errorf("sequence not found for channel")
-	}
-
-	timeoutHeight := clienttypes.NewHeight(0, 10000)
-	fmt.Println("timeoutHeight")
-	fmt.Println(timeoutHeight)
-
-	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + 100000000000
-	fmt.Println("timeoutTimestamp")
-	fmt.Println(timeoutTimestamp)
-
-	packet := channeltypes.NewPacket(data, sequence, "will", channelID, portID, channelID, timeoutHeight, timeoutTimestamp)
-	fmt.Println("will.keeper SendIBCMessage, packet: ", packet)
-
-	// var capabilityName string = host.ChannelCapabilityPath(portID, channelID)
-	var capabilityName string = host.ChannelCapabilityPath("will", channelID)
-
-	fmt.Println("capabilityName: ", capabilityName)
-	channelCap, ok := k.scopedKeeper.GetCapability(ctx, capabilityName)
-	channelCap1, ok := k.scopedKeeper.GetCapability(ctx, capabilityName)
-	fmt.Println("will.keeper SendIBCMessage, channelCap: ", channelCap, " : ", capabilityName)
-	fmt.Println("will.keeper SendIBCMessage, channelCap1: ", channelCap1, " : ", capabilityName)
-
-	/////////
+	return k.sendWillPacket(ctx, component, will, 0)
+}
 
-	if !ok {
-		return fmt.Errorf("channel capability not found")
+// sendWillPacket sends the will packet of an IBC message component, attempt counts the times it
+// was sent again after a timeout
+func (k Keeper) sendWillPacket(ctx sdk.Context, component *types.ExecutionComponent, will types.Will, attempt uint32) error {
+	ibcMsg := component.GetIbcMsg()
+	if ibcMsg == nil {
+		return errors.Wrapf(types.ErrInvalid, "component %s is not an IBC message", component.Id)
+	}
+	data, err := types.DecodeWillPacketData(ibcMsg.Data)
+	if err != nil {
+		return errors.Wrapf(err, "component %s", component.Id)
+	}
+	data.Sender = will.Creator
+	if triggered := data.GetTriggered(); triggered != nil {
+		triggered.WillId, triggered.Height = will.ID, ctx.BlockHeight()
+	}
+	bz, err := data.GetBytes()
+	if err != nil {
+		return err
+	}
+
+	sequence, err := k.sendPacket(ctx, ibcMsg.Channel, bz)
+	if err != nil {
+		return err
 	}
+	if err := k.pendingPackets.Set(ctx, collections.Join(ibcMsg.Channel, sequence), types.PendingPacket{
+		Channel:       ibcMsg.Channel,
+		Sequence:      sequence,
+		WillId:        will.ID,
+		ComponentId:   component.Id,
+		SettledStatus: types.ComponentStatusExecuted,
+		Attempt:       attempt,
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_packet_sent",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("component_id", component.Id),
+			sdk.NewAttribute("channel", ibcMsg.Channel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+		),
+	)
+	return nil
+}
 
-	////////////////
-	fmt.Println("k.GetChannelKeeper().GetAllChannels()")
-	fmt.Println(k.GetChannelKeeper().GetAllChannels(ctx))
-	_, err := k.GetChannelKeeper().SendPacket(ctx, channelCap, portID, channelID, timeoutHeight, timeoutTimestamp, packet.GetData())
-	return err
+// sendPacket sends encoded will packet data over a channel of the will port
+func (k Keeper) sendPacket(ctx sdk.Context, channelID string, bz []byte) (uint64, error) {
+	portID := k.GetPort(ctx)
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, errors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "port %s channel %s", portID, channelID)
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(k.GetParams(ctx).IBCTimeout).UnixNano())
+	sequence, err := k.GetChannelKeeper().SendPacket(ctx, channelCap, portID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, bz)
+	if err != nil {
+		return 0, errors.Wrapf(err, "sending will packet over channel %s", channelID)
+	}
+	return sequence, nil
 }
 
 // hasCapability checks if the transfer module owns the port capability for the desired port
 func (k *Keeper) hasCapability(ctx sdk.Context, portID string) bool {
 	var portPath string = host.PortPath(portID)
-	fmt.Println("portpath: %s", portPath)
 	_, ok := k.scopedKeeper.GetCapability(ctx, portPath)
 	return ok
 }
@@ -1151,8 +1372,12 @@ func (k *Keeper) BindPort(ctx sdk.Context, portID string) error {
 
 // GetPort returns the portID for the transfer module. Used in ExportGenesis
 func (k *Keeper) GetPort(ctx sdk.Context) string {
-	store := ctx.KVStore(k.storeKey)
-	return string(store.Get(types.PortKey))
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PortKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
 }
 
 // SetPort sets the portID for the transfer module. Used in InitGenesis
@@ -1,116 +1,79 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	// Import the tm-db package
 	// dbm "github.com/tendermint/tm-db" // Import the tm-db package
 	"github.com/bwesterb/go-ristretto"
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
-	dbm "github.com/cosmos/cosmos-db"
+	cmttypes "github.com/cometbft/cometbft/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
-
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	// "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3"
 
 	// "cosmossdk.io/core/store"
 	// corestoretypes "cosmossdk.io/core/store"
-	"cosmossdk.io/log"
 	"cosmossdk.io/math"
-	corestore "cosmossdk.io/store"
-
-	// corestoretypes "cosmossdk.io/core/store"
-	storemetrics "cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/CosmWasm/wasmd/app"
+	wasmtestdata "github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/will"
 	"github.com/CosmWasm/wasmd/x/will/keeper"
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16/groth16testing"
 	"github.com/CosmWasm/wasmd/x/will/schemes/pedersen"
+	"github.com/CosmWasm/wasmd/x/will/schemes/schnorr"
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
 func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
-	// func setupKeeper(t *testing.T) *keeper.Keeper {
-	// w3llApp, ctx := app.Setup(t)
-	willchainApp := app.Setup(t)
-
-	// config for native denom
-	// cfg := sdk.GetConfig()
-	// cfg.SetBech32PrefixForAccount("will", "willpub")
-
-	mockedCodec := willchainApp.AppCodec()
-
-	// channelKeeper := w3llApp.IBCKeeper.ChannelKeeper
-	// channelKeeper := w3llApp.WillKeeper.ChannelKeeper
-	channelKeeper := willchainApp.GetIBCKeeper().ChannelKeeper
-	// scopedKeeper := w3llApp.ScopedIBCKeeper
-	// scopedKeeper := w3llApp.WillKeeper.ScopedKeeper
-	scopedKeeper := willchainApp.ScopedIBCKeeper
-	fmt.Println(channelKeeper)
-	fmt.Println(scopedKeeper)
-	// Initialize DB and store
-	memDB := dbm.NewMemDB()
-	// ms := corestore.NewCommitMultiStore(memDB) // Initialize the MultiStore with the in-memory DB
-	ms := corestore.NewCommitMultiStore(memDB, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
-	// keyWill := storetypes.NewKVStoreKey(types.StoreKey)
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
-	ibcStoreKey := storetypes.NewKVStoreKey(ibctransfertypes.StoreKey)    // IBC store key
-	ibcExportedStoreKey := storetypes.NewKVStoreKey(ibcexported.StoreKey) // IBC store key
-
-	storeservice := runtime.NewKVStoreService(storeKey)
-
-	// Create and mount store keys
-	// ms.MountStoreWithDB(keyWill, storetypes.StoreTypeIAVL, memDB)
-	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, memDB)
-	ms.MountStoreWithDB(ibcStoreKey, storetypes.StoreTypeIAVL, memDB)         // Mount the IBC store
-	ms.MountStoreWithDB(ibcExportedStoreKey, storetypes.StoreTypeIAVL, memDB) // Mount the IBC store
-
-	// dev
-	bankStoreKey := storetypes.NewKVStoreKey("acc")
-	ms.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, memDB)
-	bankStoreKey2 := storetypes.NewKVStoreKey("bank")
-	ms.MountStoreWithDB(bankStoreKey2, storetypes.StoreTypeIAVL, memDB)
-
-	// ms.MountStoreWithDB(keyAcc, storetypes.StoreTypeIAVL, memDB)
-	// ms.MountStoreWithDB(string("acc"), storetypes.StoreTypeIAVL, memDB)
-
-	require.NoError(t, ms.LoadLatestVersion())
-
-	// Create context
-	ctx := sdk.NewContext(ms, tmproto.Header{}, true, log.NewNopLogger())
-	// clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper)
-	// connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
-	// portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	// channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, &portKeeper, scopedKeeper)
-
-	// Initialize keeper with the store key
-	// TODO: FIX
-	// k := keeper.NewKeeper(mockedCodec, storeservice, nil, channelKeeper, scopedKeeper)
-	// w3llApp.PermissionedWasmKeeper = *wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
-	k := keeper.NewKeeper(
-		mockedCodec,
-		storeservice,
-		nil,
-		channelKeeper,
-		willchainApp.GetIBCKeeper().PortKeeper,
-		willchainApp.ScopedWillKeeper,
-		willchainApp.ScopedIBCKeeper,
-		*willchainApp.CapabilityKeeper,
-		willchainApp.WasmKeeper,
-		willchainApp.GetBankKeeper(),
-		willchainApp.PermissionedWasmKeeper,
-		willchainApp.GetAccountKeeper(),
-	)
-	return &k, ctx
-	// return &k
+	k, ctx, _ := setupAppKeeper(t)
+	return k, ctx
+}
+
+// setupAppKeeper returns the will keeper of a freshly initialised app, so that the bank
+// and account keepers used for escrow are backed by the app's own stores.
+func setupAppKeeper(t *testing.T) (*keeper.Keeper, sdk.Context, *app.WasmApp) {
+	t.Helper()
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
+	}
+	willchainApp := app.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc}, "willchain-testing", nil, balance)
+	// start from the first block so tests can schedule wills from height 1
+	ctx := willchainApp.BaseApp.NewContext(false).WithBlockHeight(1)
+	return &willchainApp.WillKeeper, ctx, willchainApp
 }
 
 // /*
@@ -153,336 +116,2467 @@ func TestKeeperCreateWill(t *testing.T) {
 	// Add more assertions as needed to compare other fields
 }
 
-// TODO: write test for will execution transfer component
-
-func TestKeeperClaimWithSchnorrSignature(t *testing.T) {
+func TestKeeperCheckIn(t *testing.T) {
 	kpr, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:          "creator-address",
+		Name:             "Dead Man's Switch",
+		Beneficiary:      "beneficiary-address",
+		InactivityWindow: 10,
+		Components:       []*types.ExecutionComponent{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(11), will.Height, "height defaults to one window from creation")
 
-	// Hardcoded values from your Schnorr signature example
-	publicKeyHex := "2320a2da28561875cedbb0c25ae458e0a1d087834ae49b96a3f93cec79a8190c"
-	signatureHex := "7ab0edb9b0929b5bb4b47dfb927d071ecc5de75985662032bb52ef3c5ace640b165c6df5ea8911a6c0195a3140be5119a5b882e91b34cbcdd31ef3f5b0035b06"
+	// only the creator can check in
+	_, err = kpr.CheckIn(ctx, &types.MsgCheckInRequest{Creator: "someone-else", Id: will.ID})
+	require.Error(t, err)
 
-	message := "message-2b-signed"
-	creator := "creator-address"
+	// checking in at height 5 moves the trigger to 15
+	ctx = ctx.WithBlockHeight(5)
+	checkedIn, err := kpr.CheckIn(ctx, &types.MsgCheckInRequest{Creator: "creator-address", Id: will.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(15), checkedIn.Height)
+	require.Equal(t, int64(5), checkedIn.LastCheckIn)
 
-	msg := &types.MsgCreateWillRequest{
-		Creator:     creator,
-		Name:        "Test Will",
+	// the old trigger height no longer fires the will
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(11)))
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, "live", stored.Status)
+
+	// once the creator stops responding the will fires at the new height
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(15)))
+	stored, err = kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, "expired", stored.Status)
+
+	// an expired will cannot be checked in to
+	_, err = kpr.CheckIn(ctx.WithBlockHeight(16), &types.MsgCheckInRequest{Creator: "creator-address", Id: will.ID})
+	require.ErrorIs(t, err, types.ErrWillNotLive)
+}
+
+func TestKeeperTimeTrigger(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	now := time.Date(2029, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+	newYear := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, msg := range map[string]*types.MsgCreateWillRequest{
+		"past":              {TriggerTime: &time.Time{}},
+		"height and time":   {Height: 10, TriggerTime: &newYear},
+		"window and time":   {InactivityWindow: 10, TriggerTime: &newYear},
+		"negative duration": {InactivityDuration: -time.Hour},
+	} {
+		msg.Creator = "creator-address"
+		_, err := kpr.CreateWill(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest, name)
+	}
+
+	dated, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     "creator-address",
+		Name:        "new year",
 		Beneficiary: "beneficiary-address",
-		Height:      2,
-		Components: []*types.ExecutionComponent{
-			{
-				Name:   "SchnorrSignatureComponent",
-				Id:     "abc",
-				Status: "inactive", // This is set to a default value but should be handled by the keeper
-				ComponentType: &types.ExecutionComponent_Claim{ // Correctly initializing the union type
-					Claim: &types.ClaimComponent{
-						Access: types.ClaimAccessControl{
-							AccessType: &types.ClaimAccessControl_Private{
-								Private: &types.ClaimAccessPrivate{
-									Addresses: []string{
-										"creator-address",
-										"creator-addressB",
-									},
-								},
-							},
-						},
-						SchemeType: &types.ClaimComponent_Schnorr{ // Correctly initializing the union type
-							Schnorr: &types.SchnorrSignature{
-								PublicKey: []byte(publicKeyHex),
-								// Signature: []byte(signatureHex),
-								Message: message,
-							},
-						},
-					},
-				},
-				OutputType: &types.ComponentOutput{ // Correctly initializing the union type
-					OutputType: &types.ComponentOutput_OutputTransfer{
-						OutputTransfer: &types.OutputTransfer{
-							Address: "0xabc",
-							Denom:   "uwill",
-							Amount: &sdk.Coin{ // Proper Coin type initialization
-								Denom:  "uwill",
-								Amount: math.NewInt(100), // Amount as string if cosmos.Coin expects it
-							},
-						},
-					},
-				},
-			},
-		},
+		TriggerTime: &newYear,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), dated.Height)
+	switchWill, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:            "creator-address",
+		Name:               "180 days",
+		Beneficiary:        "beneficiary-address",
+		InactivityDuration: 180 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(180*24*time.Hour), *switchWill.TriggerTime, "trigger time defaults to one duration from creation")
+
+	// a will triggering at a time cannot be moved to a height, nor the other way round
+	_, err = kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{Creator: "creator-address", Id: dated.ID, Height: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	// nor checked in to without an inactivity duration
+	_, err = kpr.CheckIn(ctx, &types.MsgCheckInRequest{Creator: "creator-address", Id: dated.ID})
+	require.ErrorIs(t, err, types.ErrNoInactivityWindow)
+
+	status := func(id string) string {
+		stored, err := kpr.GetWillByID(ctx, id)
+		require.NoError(t, err)
+		return stored.Status
+	}
+	advance := func(height int64, blockTime time.Time) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+		require.NoError(t, kpr.BeginBlocker(ctx))
 	}
 
-	// CreateWill method is correctly implemented to handle mocks
-	will, err := kpr.CreateWill(sdk.UnwrapSDKContext(ctx), msg)
+	// block times drift, the will fires in the first block at or after its trigger time
+	advance(2, newYear.Add(-time.Second))
+	assert.Equal(t, types.WillStatusLive, status(dated.ID))
+	advance(3, newYear.Add(7*time.Second))
+	assert.Equal(t, types.WillStatusExpired, status(dated.ID))
+
+	// checking in pushes the trigger time one duration past the check-in
+	checkIn := newYear.Add(100 * 24 * time.Hour)
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(checkIn)
+	checkedIn, err := kpr.CheckIn(ctx, &types.MsgCheckInRequest{Creator: "creator-address", Id: switchWill.ID})
 	require.NoError(t, err)
-	assert.NotNil(t, will)
+	require.Equal(t, checkIn.Add(180*24*time.Hour), *checkedIn.TriggerTime)
+	advance(5, now.Add(200*24*time.Hour))
+	assert.Equal(t, types.WillStatusLive, status(switchWill.ID))
+	advance(6, checkIn.Add(180*24*time.Hour))
+	assert.Equal(t, types.WillStatusExpired, status(switchWill.ID))
+}
 
-	fmt.Println("====[TEST]HERE IS THE PRACTICE WILL[TEST]=====")
-	fmt.Println((will))
+func TestKeeperTimeTriggerPerBlockLimit(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.MaxWillsPerHeight = 2
+	require.NoError(t, kpr.SetParams(ctx, params))
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	// many wills may share a trigger time, they fire over several blocks
+	var ids []string
+	for i := 0; i < 5; i++ {
+		triggerTime := now.Add(time.Duration(i) * time.Millisecond)
+		will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+			Creator:     "creator-address",
+			Name:        fmt.Sprintf("will %d", i),
+			Beneficiary: "beneficiary-address",
+			TriggerTime: &triggerTime,
+		})
+		require.NoError(t, err)
+		ids = append(ids, will.ID)
+	}
+	expired := func() (n int) {
+		for _, id := range ids {
+			stored, err := kpr.GetWillByID(ctx, id)
+			require.NoError(t, err)
+			if stored.Status == types.WillStatusExpired {
+				n++
+			}
+		}
+		return n
+	}
+	for height, want := range []int{2, 4, 5} {
+		ctx = ctx.WithBlockHeight(int64(height) + 2).WithBlockTime(now.Add(time.Hour))
+		require.NoError(t, kpr.BeginBlocker(ctx))
+		assert.Equal(t, want, expired())
+	}
+}
 
-	// a will has already been created and you have its ID
-	willID := will.ID // Replace with the actual will ID
-	componentID := will.Components[0].Id
+// TODO: write test for will execution transfer component
 
-	// verify will upon creation time status is live
-	require.Equal(t, will.Status, "live")
+func TestEvaluateCondition(t *testing.T) {
+	result := []byte(`{"amount":[{"denom":"uwill","amount":"1000"}],"drained":false,"price":"1.25","owner":{"name":"vault"}}`)
+	for name, spec := range map[string]struct {
+		condition types.WasmCondition
+		met       bool
+		err       bool
+	}{
+		"string number below":     {condition: types.WasmCondition{Path: "amount.0.amount", Operator: types.ConditionOperator_CONDITION_OPERATOR_LESS, Value: "1001"}, met: true},
+		"string number not below": {condition: types.WasmCondition{Path: "amount.0.amount", Operator: types.ConditionOperator_CONDITION_OPERATOR_LESS, Value: `"1000"`}},
+		"decimal at least":        {condition: types.WasmCondition{Path: "price", Operator: types.ConditionOperator_CONDITION_OPERATOR_GREATER_OR_EQUAL, Value: "1.25"}, met: true},
+		"numbers equal by value":  {condition: types.WasmCondition{Path: "price", Value: "1.250"}, met: true},
+		"bool":                    {condition: types.WasmCondition{Path: "drained", Value: "true"}},
+		"bool not equal":          {condition: types.WasmCondition{Path: "drained", Operator: types.ConditionOperator_CONDITION_OPERATOR_NOT_EQUAL, Value: "true"}, met: true},
+		"object":                  {condition: types.WasmCondition{Path: "owner", Value: `{"name":"vault"}`}, met: true},
+		"missing field":           {condition: types.WasmCondition{Path: "owner.address", Value: `"vault"`}, err: true},
+		"index out of range":      {condition: types.WasmCondition{Path: "amount.1.amount", Value: "0"}, err: true},
+		"ordering a string":       {condition: types.WasmCondition{Path: "owner.name", Operator: types.ConditionOperator_CONDITION_OPERATOR_GREATER, Value: "0"}, err: true},
+	} {
+		met, err := keeper.EvaluateCondition(&spec.condition, result)
+		if spec.err {
+			require.Error(t, err, name)
+			continue
+		}
+		require.NoError(t, err, name)
+		assert.Equal(t, spec.met, met, name)
+	}
+}
 
-	// verify the will claimable component is inactive
-	require.Equal(t, will.Components[0].Status, "inactive")
+func TestKeeperConditionTrigger(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	// contracts are instantiated at a block time
+	ctx = ctx.WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	creatorAddr := sdk.AccAddress("cond-creator________")
+	vaultAddr := sdk.AccAddress("cond-vault__________")
+	heirAddr := sdk.AccAddress("cond-heir___________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, vaultAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+
+	// hackatom answers balance queries of any account, standing in for a vault or price oracle
+	codeID, _, err := willchainApp.PermissionedWasmKeeper.Create(ctx, creatorAddr, wasmtestdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]string{"verifier": creatorAddr.String(), "beneficiary": heirAddr.String()})
+	require.NoError(t, err)
+	contractAddr, _, err := willchainApp.PermissionedWasmKeeper.Instantiate(ctx, codeID, creatorAddr, nil, initMsg, "oracle", nil)
+	require.NoError(t, err)
 
-	// roll height forward
-	ctx_future := sdk.UnwrapSDKContext(ctx).WithBlockHeight(2)
+	drained := func() *types.WasmCondition {
+		return &types.WasmCondition{
+			Contract: contractAddr.String(),
+			QueryMsg: []byte(fmt.Sprintf(`{"other_balance":{"address":%q}}`, vaultAddr.String())),
+			Path:     "amount.0.amount",
+			Operator: types.ConditionOperator_CONDITION_OPERATOR_LESS,
+			Value:    `"500"`,
+			Interval: 2,
+		}
+	}
+	rescueAmount := sdk.NewInt64Coin("uwill", 100)
+	createMsg := func(name string, condition *types.WasmCondition) *types.MsgCreateWillRequest {
+		return &types.MsgCreateWillRequest{
+			Creator:     creatorAddr.String(),
+			Name:        name,
+			Beneficiary: heirAddr.String(),
+			Condition:   condition,
+			Components: []*types.ExecutionComponent{{
+				Id: "rescue",
+				ComponentType: &types.ExecutionComponent_Transfer{Transfer: &types.TransferComponent{
+					To:     heirAddr.String(),
+					Amount: &rescueAmount,
+				}},
+			}},
+		}
+	}
 
-	// run the begin blocker with the updated block height for the will to execute.
-	kpr.BeginBlocker(ctx_future)
+	for name, mutate := range map[string]func(*types.MsgCreateWillRequest){
+		"with height":          func(msg *types.MsgCreateWillRequest) { msg.Height = 10 },
+		"with inactivity":      func(msg *types.MsgCreateWillRequest) { msg.InactivityWindow = 10 },
+		"bad contract":         func(msg *types.MsgCreateWillRequest) { msg.Condition.Contract = "oracle" },
+		"query not json":       func(msg *types.MsgCreateWillRequest) { msg.Condition.QueryMsg = []byte("verifier") },
+		"ordering a string":    func(msg *types.MsgCreateWillRequest) { msg.Condition.Value = `"drained"` },
+		"negative interval":    func(msg *types.MsgCreateWillRequest) { msg.Condition.Interval = -1 },
+		"gas above max":        func(msg *types.MsgCreateWillRequest) { msg.Condition.GasLimit = types.DefaultMaxConditionGas + 1 },
+		"next check preset":    func(msg *types.MsgCreateWillRequest) { msg.Condition.NextCheckHeight = 2 },
+		"empty path segment":   func(msg *types.MsgCreateWillRequest) { msg.Condition.Path = "amount..amount" },
+		"unknown operator":     func(msg *types.MsgCreateWillRequest) { msg.Condition.Operator = 9 },
+		"condition value text": func(msg *types.MsgCreateWillRequest) { msg.Condition.Value = "drained" },
+	} {
+		msg := createMsg("invalid", drained())
+		mutate(msg)
+		_, err := kpr.CreateWill(ctx, msg)
+		require.Error(t, err, name)
+	}
 
-	// verify will is expired now, and claimable
-	will_for_claimable_check, err_claimable_check := kpr.GetWillByID(ctx, will.ID)
-	require.NoError(t, err_claimable_check)
-	fmt.Println("WILL FOR CLAIMABLE CHECK:")
-	fmt.Println(will_for_claimable_check)
-	// verify will is now expired, since after expiry
-	require.Equal(t, will_for_claimable_check.Status, "expired")
+	will, err := kpr.CreateWill(ctx, createMsg("rescue", drained()))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), will.Condition.NextCheckHeight, "the condition is first evaluated one interval from now")
+	// a query that cannot run within its gas limit fails, not the block
+	starved := drained()
+	starved.GasLimit = 1000
+	starvedWill, err := kpr.CreateWill(ctx, createMsg("starved", starved))
+	require.NoError(t, err)
+	_, err = kpr.CheckIn(ctx, &types.MsgCheckInRequest{Creator: creatorAddr.String(), Id: will.ID})
+	require.ErrorIs(t, err, types.ErrNoInactivityWindow)
 
-	// verify the will's claimable component is now active
-	require.Equal(t, will_for_claimable_check.Components[0].Status, "active")
+	stored := func(id string) *types.Will {
+		w, err := kpr.GetWillByID(ctx, id)
+		require.NoError(t, err)
+		return w
+	}
+	advance := func(height int64) {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, kpr.BeginBlocker(ctx))
+	}
 
-	// Construct the claim request with the Schnorr claim
-	claimMsg := &types.MsgClaimRequest{
-		WillId:      willID,
-		Claimer:     creator,
-		ComponentId: componentID,
-		ClaimType: &types.MsgClaimRequest_SchnorrClaim{
-			SchnorrClaim: &types.SchnorrClaim{
-				PublicKey: []byte(publicKeyHex),
-				Signature: []byte(signatureHex),
-				Message:   message,
-			},
+	advance(2)
+	advance(3)
+	assert.Equal(t, types.WillStatusLive, stored(will.ID).Status, "the vault still holds its balance")
+	assert.Equal(t, int64(5), stored(will.ID).Condition.NextCheckHeight)
+	assert.Equal(t, types.WillStatusLive, stored(starvedWill.ID).Status)
+	assert.Equal(t, int64(5), stored(starvedWill.ID).Condition.NextCheckHeight)
+
+	// the gas of a condition query is charged to whoever evaluates it, running out of it or not
+	executeGas := func(id string) uint64 {
+		txCtx, _ := ctx.CacheContext()
+		txCtx = txCtx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+		_, err := kpr.ExecuteWill(txCtx, &types.MsgExecuteWillRequest{Executor: heirAddr.String(), WillId: id})
+		require.Error(t, err)
+		return txCtx.GasMeter().GasConsumed()
+	}
+	hungry := func(name string, gasLimit uint64) string {
+		condition := drained()
+		condition.GasLimit = gasLimit
+		hungryWill, err := kpr.CreateWill(ctx, createMsg(name, condition))
+		require.NoError(t, err)
+		return hungryWill.ID
+	}
+	small, large := hungry("hungry-1", 1000), hungry("hungry-2", 1500)
+	assert.Equal(t, uint64(500), executeGas(large)-executeGas(small))
+	assert.Greater(t, executeGas(will.ID), executeGas(large))
+
+	// the vault is drained, the will fires at the next evaluation, not before
+	require.NoError(t, willchainApp.BankKeeper.SendCoins(ctx, vaultAddr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 600))))
+	advance(4)
+	assert.Equal(t, types.WillStatusLive, stored(will.ID).Status)
+	advance(5)
+	assert.Equal(t, types.WillStatusExpired, stored(will.ID).Status)
+	assert.Equal(t, types.ComponentStatusExecuted, stored(will.ID).Components[0].Status)
+	assert.Equal(t, "100uwill", willchainApp.BankKeeper.GetBalance(ctx, heirAddr, "uwill").String())
+	var met []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "will_condition_met" {
+			met = append(met, event.Attributes[0].Value)
+		}
+	}
+	assert.Equal(t, []string{will.ID}, met)
+	assert.Equal(t, types.WillStatusLive, stored(starvedWill.ID).Status, "a query out of gas never meets the condition")
+
+	// governance can switch condition triggers off
+	params := kpr.GetParams(ctx)
+	params.MaxConditionGas = 0
+	require.NoError(t, kpr.SetParams(ctx, params))
+	_, err = kpr.CreateWill(ctx, createMsg("disabled", drained()))
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
+
+func TestKeeperExecutionReceipts(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	creatorAddr := sdk.AccAddress("exec-creator________")
+	heirAddr := sdk.AccAddress("exec-heir___________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+
+	// releasing hackatom pays its balance to its beneficiary and responds with data
+	codeID, _, err := willchainApp.PermissionedWasmKeeper.Create(ctx, creatorAddr, wasmtestdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]string{"verifier": creatorAddr.String(), "beneficiary": heirAddr.String()})
+	require.NoError(t, err)
+	contractAddr, _, err := willchainApp.PermissionedWasmKeeper.Instantiate(ctx, codeID, creatorAddr, nil, initMsg, "release", sdk.NewCoins(sdk.NewInt64Coin("uwill", 10)))
+	require.NoError(t, err)
+
+	transfer := func(id, to string, amount int64, dependsOn ...string) *types.ExecutionComponent {
+		coin := sdk.NewInt64Coin("uwill", amount)
+		return &types.ExecutionComponent{
+			Id:            id,
+			DependsOn:     dependsOn,
+			ComponentType: &types.ExecutionComponent_Transfer{Transfer: &types.TransferComponent{To: to, Amount: &coin}},
+		}
+	}
+	createMsg := func(name string, mode types.ExecutionMode, components ...*types.ExecutionComponent) *types.MsgCreateWillRequest {
+		return &types.MsgCreateWillRequest{
+			Creator:       creatorAddr.String(),
+			Name:          name,
+			Beneficiary:   heirAddr.String(),
+			Height:        2,
+			Components:    components,
+			ExecutionMode: mode,
+		}
+	}
+
+	// dependencies must name earlier components, so the components can run in order
+	_, err = kpr.CreateWill(ctx, createMsg("forward", types.ExecutionMode_EXECUTION_MODE_BEST_EFFORT,
+		transfer("first", heirAddr.String(), 1, "second"), transfer("second", heirAddr.String(), 1)))
+	require.ErrorIs(t, err, types.ErrInvalid)
+	_, err = kpr.CreateWill(ctx, createMsg("unknown mode", 7, transfer("first", heirAddr.String(), 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	bestEffort, err := kpr.CreateWill(ctx, createMsg("best effort", types.ExecutionMode_EXECUTION_MODE_BEST_EFFORT,
+		transfer("pay", heirAddr.String(), 100),
+		transfer("broken", "not-an-address", 50),
+		transfer("after-broken", heirAddr.String(), 20, "broken"),
+		&types.ExecutionComponent{
+			Id:            "release",
+			DependsOn:     []string{"pay"},
+			ComponentType: &types.ExecutionComponent_Contract{Contract: &types.ContractComponent{Address: contractAddr.String(), Data: []byte(`{"release":{}}`)}},
 		},
+	))
+	require.NoError(t, err)
+	atomic, err := kpr.CreateWill(ctx, createMsg("atomic", types.ExecutionMode_EXECUTION_MODE_ATOMIC,
+		transfer("pay", heirAddr.String(), 100),
+		transfer("broken", "not-an-address", 50),
+		transfer("late", heirAddr.String(), 10),
+	))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	querier := keeper.NewGrpcQuerier(kpr)
+	receipts := func(id string) []types.ExecutionReceipt {
+		res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: id})
+		require.NoError(t, err)
+		return res.Receipts
+	}
+	statuses := func(receipts []types.ExecutionReceipt) []string {
+		var statuses []string
+		for _, receipt := range receipts {
+			statuses = append(statuses, receipt.Status)
+		}
+		return statuses
 	}
 
-	// Process the claim
-	err = kpr.Claim(sdk.UnwrapSDKContext(ctx_future), claimMsg)
-	require.NoError(t, err, "processing Schnorr claim should not produce an error")
-	// TODO: verify will components status is not active anymore after successful claim
+	// a best effort will keeps what succeeded and skips what depends on a failure
+	got := receipts(bestEffort.ID)
+	require.Equal(t, []string{types.ComponentStatusExecuted, types.ComponentStatusFailed, types.ComponentStatusSkipped, types.ComponentStatusExecuted}, statuses(got))
+	assert.Empty(t, got[0].Error)
+	assert.NotZero(t, got[0].GasUsed)
+	assert.Contains(t, got[1].Error, "parsing to address failed")
+	assert.Contains(t, got[2].Error, "broken")
+	assert.Equal(t, []byte{0xf0, 0x0b, 0xaa}, got[3].Data, "the receipt holds the contract response")
+	assert.Equal(t, int64(2), got[3].Height)
+	stored, err := kpr.GetWillByID(ctx, bestEffort.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WillStatusExpired, stored.Status)
+	for i, component := range stored.Components {
+		assert.Equal(t, got[i].Status, component.Status)
+	}
 
-	will_for_status_check, err_status_check := kpr.GetWillByID(ctx, will.ID)
-	require.NoError(t, err_status_check)
+	// an atomic will keeps nothing once a component fails and returns its escrow
+	got = receipts(atomic.ID)
+	require.Equal(t, []string{types.ComponentStatusReverted, types.ComponentStatusFailed, types.ComponentStatusSkipped}, statuses(got))
+	stored, err = kpr.GetWillByID(ctx, atomic.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WillStatusExpired, stored.Status)
+	assert.Equal(t, types.ComponentStatusReverted, stored.Components[0].Status)
+	escrow, err := kpr.GetEscrow(ctx, atomic.ID)
+	require.NoError(t, err)
+	assert.True(t, escrow.Coins.IsZero())
 
-	// verify the will's claimable component's status is now claimed
-	require.Equal(t, will_for_status_check.Components[0].Status, "claimed")
+	// the heir got the best effort payment and the contract balance, not the reverted payment
+	assert.Equal(t, "110uwill", willchainApp.BankKeeper.GetBalance(ctx, heirAddr, "uwill").String())
+	assert.Equal(t, "890uwill", willchainApp.BankKeeper.GetBalance(ctx, creatorAddr, "uwill").String(), "the atomic escrow and the payment of the failed component were returned")
+	_, err = querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: "did:will:unknown"})
+	require.Error(t, err)
 }
 
-func TestKeeperClaimWithPedersenCommitment(t *testing.T) {
-	kpr, ctx := setupKeeper(t) // Initialize your test environment
-	creator := "will1p0k8gygawzpggzwftv7cv47zvgg8zaun5xucxz"
-	// beneficiary := "will156mw28alhpenp4lknweat6432dux34uydx590v"
-	beneficiary := "will156mw28alhpenp4lknweat6432dux34uydx590v"
+func TestKeeperExecutionQueue(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("queue-creator_______")
+	heirAddr := sdk.AccAddress("queue-heir__________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+	querier := keeper.NewGrpcQuerier(kpr)
+	params := kpr.GetParams(ctx)
+	params.MaxWillsPerHeight = 2
+	require.NoError(t, kpr.SetParams(ctx, params))
+
+	create := func(name string, height int64, components ...*types.ExecutionComponent) string {
+		will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+			Creator:     creatorAddr.String(),
+			Name:        name,
+			Beneficiary: heirAddr.String(),
+			Height:      height,
+			Components:  components,
+		})
+		require.NoError(t, err)
+		return will.ID
+	}
+	status := func(id string) string {
+		will, err := kpr.GetWillByID(ctx, id)
+		require.NoError(t, err)
+		return will.Status
+	}
+	advance := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, kpr.BeginBlocker(ctx))
+	}
+
+	// a will created in the block of its trigger height fires in the next one
+	ctx = ctx.WithBlockHeight(5)
+	sameBlock := create("same block", 5, transferComponent("open", heirAddr, 10), transferComponent("t", heirAddr, 10))
+	advance(6)
+	require.Equal(t, types.WillStatusExpired, status(sameBlock))
+	res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: sameBlock})
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 2)
+	// the first transfer also pays for creating the heir account
+	transferGas := res.Receipts[1].GasUsed
+	require.NotZero(t, transferGas)
+
+	// the gas of a block fits one transfer
+	params.MaxBlockExecutionGas = transferGas + transferGas/2
+	require.NoError(t, kpr.SetParams(ctx, params))
+
+	// a height holds more wills than are scheduled or executed in a block
+	var ids []string
+	for i := 0; i < 4; i++ {
+		ids = append(ids, create(fmt.Sprintf("will %d", i), 10, transferComponent("t", heirAddr, 10)))
+	}
+	count := func(want string) (n int) {
+		for _, id := range ids {
+			if status(id) == want {
+				n++
+			}
+		}
+		return n
+	}
+	advance(10)
+	assert.Equal(t, 1, count(types.WillStatusExpired))
+	assert.Equal(t, 1, count(types.WillStatusQueued))
+	assert.Equal(t, 2, count(types.WillStatusLive))
+	carried, err := kpr.QueuedWillIDs(ctx)
+	require.NoError(t, err)
+	require.Len(t, carried, 1)
+	// queued wills cannot be changed anymore
+	_, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: carried[0]})
+	require.ErrorIs(t, err, types.ErrWillNotLive)
+
+	// the carried over will runs first, the wills left due are scheduled behind it
+	advance(11)
+	assert.Equal(t, types.WillStatusExpired, status(carried[0]))
+	assert.Equal(t, 2, count(types.WillStatusExpired))
+	assert.Equal(t, 2, count(types.WillStatusQueued))
+	// the queued wills can be listed by status
+	all, err := querier.AllWills(ctx, &types.QueryAllWillsRequest{Status: types.WillStatusQueued})
+	require.NoError(t, err)
+	require.Len(t, all.Wills, 2)
+	for _, will := range all.Wills {
+		assert.Equal(t, types.WillStatusQueued, will.Status)
+	}
+	advance(12)
+	advance(13)
+	assert.Equal(t, 4, count(types.WillStatusExpired))
+	queued, err := kpr.QueuedWillIDs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+	assert.Equal(t, "60uwill", kpr.GetBankKeeper().GetBalance(ctx, heirAddr, "uwill").String())
+
+	// a will that does not fit in the gas of a whole block still runs, and runs out of it
+	ctx = ctx.WithBlockHeight(14)
+	tooBig := create("too big", 15, transferComponent("first", heirAddr, 10), transferComponent("second", heirAddr, 10))
+	advance(15)
+	require.Equal(t, types.WillStatusExpired, status(tooBig))
+	res, err = querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: tooBig})
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 2)
+	assert.Equal(t, types.ComponentStatusFailed, res.Receipts[1].Status)
+	assert.Contains(t, res.Receipts[1].Error, "out of gas")
+	assert.LessOrEqual(t, res.Receipts[0].GasUsed+res.Receipts[1].GasUsed, params.MaxBlockExecutionGas)
+}
 
-	// Generate random scalars for value and blinding factor
-	var valueScalar, blindingFactorScalar ristretto.Scalar
-	valueScalar.Rand()
-	blindingFactorScalar.Rand()
+func TestKeeperExecuteWill(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("execute-creator_____")
+	heirAddr := sdk.AccAddress("execute-heir________")
+	executorAddr := sdk.AccAddress("execute-executor____")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+	bankKeeper := kpr.GetBankKeeper()
+	params := kpr.GetParams(ctx)
+	params.CreationDeposit = sdk.NewCoins(sdk.NewInt64Coin("uwill", 11))
+	require.NoError(t, kpr.SetParams(ctx, params))
+
+	privateKey, publicKey := schnorr.RandomKeyPair()
+	publicKeyBytes, err := publicKey.MarshalBinary()
+	require.NoError(t, err)
+	create := func(name string, height int64, components ...*types.ExecutionComponent) string {
+		will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+			Creator:     creatorAddr.String(),
+			Name:        name,
+			Beneficiary: heirAddr.String(),
+			Height:      height,
+			Components:  components,
+		})
+		require.NoError(t, err)
+		return will.ID
+	}
+	execute := func(willID string) (sdk.Coins, error) {
+		return kpr.ExecuteWill(ctx, &types.MsgExecuteWillRequest{Executor: executorAddr.String(), WillId: willID})
+	}
+	status := func(id string) string {
+		will, err := kpr.GetWillByID(ctx, id)
+		require.NoError(t, err)
+		return will.Status
+	}
+	balance := func(addr sdk.AccAddress) string {
+		return bankKeeper.GetBalance(ctx, addr, "uwill").String()
+	}
 
-	var H ristretto.Point
-	H.Rand()
+	ctx = ctx.WithBlockHeight(5)
+	due := create("due", 10, transferComponent("t", heirAddr, 100))
+	assert.Equal(t, "889uwill", balance(creatorAddr))
 
-	// Commit to the random value using the random blinding factor
-	originalCommitment := pedersen.CommitTo(&H, &blindingFactorScalar, &valueScalar)
+	// only due wills can be executed
+	_, err = execute(due)
+	require.ErrorIs(t, err, types.ErrWillNotDue)
+	_, err = execute("did:will:unknown")
+	require.ErrorIs(t, err, types.ErrWillNotFound)
 
-	addedCommitment := kpr.AddCommitments(originalCommitment, originalCommitment)
+	// the transaction must have the gas for the components, they do not fail for lack of it
+	ctx = ctx.WithBlockHeight(10)
+	_, err = kpr.ExecuteWill(ctx.WithGasMeter(storetypes.NewGasMeter(20_000)), &types.MsgExecuteWillRequest{Executor: executorAddr.String(), WillId: due})
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	assert.Equal(t, types.WillStatusLive, status(due))
+
+	// anyone can execute a due will for half the deposit, rounded down, the creator gets the rest back
+	bounty, err := execute(due)
+	require.NoError(t, err)
+	assert.Equal(t, "5uwill", bounty.String())
+	assert.Equal(t, types.WillStatusExpired, status(due))
+	assert.Equal(t, "5uwill", balance(executorAddr))
+	assert.Equal(t, "100uwill", balance(heirAddr))
+	assert.Equal(t, "895uwill", balance(creatorAddr))
+	_, err = execute(due)
+	require.ErrorIs(t, err, types.ErrWillNotDue)
+	// the execution queue finds nothing left to run
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	assert.Equal(t, "100uwill", balance(heirAddr))
+
+	// a queued will can be executed ahead of the queue
+	params.MaxBlockExecutionGas = 1
+	require.NoError(t, kpr.SetParams(ctx, params))
+	first := create("first in line", 12, transferComponent("t", heirAddr, 10))
+	second := create("second in line", 12, transferComponent("t", heirAddr, 10))
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	queued, err := kpr.QueuedWillIDs(ctx)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Contains(t, []string{first, second}, queued[0])
+	assert.Equal(t, types.WillStatusQueued, status(queued[0]))
+	_, err = execute(queued[0])
+	require.NoError(t, err)
+	assert.Equal(t, types.WillStatusExpired, status(queued[0]))
+	queued, err = kpr.QueuedWillIDs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+	assert.Equal(t, "10uwill", balance(executorAddr))
+	params.MaxBlockExecutionGas = types.DefaultMaxBlockExecutionGas
+	require.NoError(t, kpr.SetParams(ctx, params))
+
+	// claiming from a due will executes it first, the claimer is paid as its executor
+	claimable := create("claimable", 15, &types.ExecutionComponent{
+		Id: "sig",
+		ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+			Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Private{Private: &types.ClaimAccessPrivate{Addresses: []string{heirAddr.String()}}}},
+			SchemeType: &types.ClaimComponent_Schnorr{Schnorr: &types.SchnorrSignature{PublicKey: publicKeyBytes}},
+		}},
+		OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+			Address: heirAddr.String(),
+			Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(30)},
+		}}},
+	})
+	signature, err := schnorr.SignMessage(types.SchnorrClaimDigest(ctx.ChainID(), claimable, "sig", heirAddr.String()), privateKey).MarshalBinary()
+	require.NoError(t, err)
+	claim := &types.MsgClaimRequest{
+		WillId:      claimable,
+		Claimer:     heirAddr.String(),
+		ComponentId: "sig",
+		ClaimType:   &types.MsgClaimRequest_SchnorrClaim{SchnorrClaim: &types.SchnorrClaim{Signature: signature}},
+	}
+	require.ErrorIs(t, kpr.Claim(ctx, claim), types.ErrWillNotDue)
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, kpr.Claim(ctx, claim))
+	assert.Equal(t, types.WillStatusExpired, status(claimable))
+	assert.Equal(t, "145uwill", balance(heirAddr))
+}
+
+func TestKeeperEscrow(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	bankKeeper := kpr.GetBankKeeper()
+
+	creatorAddr := sdk.AccAddress("escrow-creator______")
+	beneficiaryAddr := sdk.AccAddress("escrow-beneficiary__")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 200)))
 
-	// Create a will including the Pedersen commitment
 	msg := &types.MsgCreateWillRequest{
-		Creator:     creator,
-		Name:        "Test Will",
-		Beneficiary: "will1p0k8gygawzpggzwftv7cv47zvgg8zaun5xucxz",
-		Height:      2,
+		Creator:     creatorAddr.String(),
+		Name:        "Escrowed Will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      10,
 		Components: []*types.ExecutionComponent{
 			{
-				Name:   "PedersenCommitmentComponent",
-				Id:     "component-id",
-				Status: "inactive",
-				ComponentType: &types.ExecutionComponent_Claim{
-					Claim: &types.ClaimComponent{
-						Access: types.ClaimAccessControl{
-							AccessType: &types.ClaimAccessControl_Private{
-								Private: &types.ClaimAccessPrivate{
-									Addresses: []string{beneficiary},
-								},
-							},
-						},
-						SchemeType: &types.ClaimComponent_Pedersen{
-							Pedersen: &types.PedersenCommitment{
-								Commitment:       originalCommitment.Bytes(),
-								TargetCommitment: addedCommitment.Bytes(),
-							},
-						},
-					},
-				},
-				OutputType: &types.ComponentOutput{ // Correctly initializing the union type
-					OutputType: &types.ComponentOutput_OutputTransfer{
-						OutputTransfer: &types.OutputTransfer{
-							Address: "will156mw28alhpenp4lknweat6432dux34uydx590v",
-							Denom:   "uwill",
-							Amount: &sdk.Coin{ // Proper Coin type initialization
-								Denom:  "uwill",
-								Amount: math.NewInt(100), // Amount as string if cosmos.Coin expects it
-							},
-						},
+				Name: "transfer",
+				Id:   "transfer-1",
+				ComponentType: &types.ExecutionComponent_Transfer{
+					Transfer: &types.TransferComponent{
+						To:     beneficiaryAddr.String(),
+						Denom:  "uwill",
+						Amount: &sdk.Coin{Denom: "uwill", Amount: math.NewInt(120)},
 					},
 				},
 			},
 		},
 	}
 
-	will, err := kpr.CreateWill(sdk.UnwrapSDKContext(ctx), msg)
+	// an unfunded creator cannot create the will
+	poorMsg := *msg
+	poorMsg.Creator = sdk.AccAddress("escrow-poor-creator_").String()
+	_, err := kpr.CreateWill(ctx, &poorMsg)
+	require.Error(t, err)
+
+	will, err := kpr.CreateWill(ctx, msg)
 	require.NoError(t, err)
-	require.NotNil(t, will)
 
-	// Advance the block height to simulate time passage
-	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(2)
-	kpr.BeginBlocker(ctx)
+	// the transfer amount moved from the creator into escrow
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 120)), escrow.Coins)
+	require.Equal(t, math.NewInt(80), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
 
-	// Simulate a claim with the same commitment
-	claimMsg := &types.MsgClaimRequest{
-		WillId:      will.ID,
-		Claimer:     beneficiary,
-		ComponentId: "component-id",
-		ClaimType: &types.MsgClaimRequest_PedersenClaim{
-			PedersenClaim: &types.PedersenClaim{
-				Commitment: originalCommitment.Bytes(),
+	// only the creator can top up
+	_, err = kpr.FundWill(ctx, &types.MsgFundWillRequest{Creator: beneficiaryAddr.String(), Id: will.ID, Amount: sdk.NewCoins(sdk.NewInt64Coin("uwill", 1))})
+	require.Error(t, err)
+	escrow, err = kpr.FundWill(ctx, &types.MsgFundWillRequest{Creator: creatorAddr.String(), Id: will.ID, Amount: sdk.NewCoins(sdk.NewInt64Coin("uwill", 50))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 170)), escrow.Coins)
+
+	// the creator spending their balance no longer affects the payout
+	require.NoError(t, bankKeeper.SendCoins(ctx, creatorAddr, beneficiaryAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 30))))
+
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(10)))
+	require.Equal(t, math.NewInt(150), bankKeeper.GetBalance(ctx, beneficiaryAddr, "uwill").Amount)
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, "executed", stored.Components[0].Status)
+
+	// what the components did not pay out of the escrow went back to the creator
+	require.Equal(t, math.NewInt(50), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+	escrow, err = kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	require.True(t, escrow.Coins.IsZero())
+}
+
+func transferComponent(id string, to sdk.AccAddress, amount int64) *types.ExecutionComponent {
+	return &types.ExecutionComponent{
+		Name: "transfer",
+		Id:   id,
+		ComponentType: &types.ExecutionComponent_Transfer{
+			Transfer: &types.TransferComponent{
+				To:     to.String(),
+				Denom:  "uwill",
+				Amount: &sdk.Coin{Denom: "uwill", Amount: math.NewInt(amount)},
 			},
 		},
 	}
+}
+
+func TestKeeperDistribution(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("split-creator_______")
+	aliceAddr := sdk.AccAddress("split-alice_________")
+	bobAddr := sdk.AccAddress("split-bob___________")
+	carolAddr := sdk.AccAddress("split-carol_________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000), sdk.NewInt64Coin("uatom", 50)))
+
+	shares := []types.DistributionShare{
+		{Address: aliceAddr.String(), Weight: 1},
+		{Address: bobAddr.String(), Weight: 1},
+		{Address: carolAddr.String(), Weight: 1},
+	}
+	fixed := func(id string, remainder types.DistributionRemainder, coins ...sdk.Coin) *types.ExecutionComponent {
+		return &types.ExecutionComponent{Id: id, ComponentType: &types.ExecutionComponent_Distribution{Distribution: &types.DistributionComponent{
+			Shares:    shares,
+			Source:    &types.DistributionComponent_Coins{Coins: &types.DistributionCoins{Amount: sdk.NewCoins(coins...)}},
+			Remainder: remainder,
+		}}}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "split will",
+		Beneficiary: aliceAddr.String(),
+		Height:      2,
+	}
+	for name, distribution := range map[string]*types.DistributionComponent{
+		"no shares":       {Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "uwill"}},
+		"zero weight":     {Shares: []types.DistributionShare{{Address: aliceAddr.String()}}, Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "uwill"}},
+		"no source":       {Shares: shares},
+		"bad denom":       {Shares: shares, Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "!"}},
+		"empty coins":     {Shares: shares, Source: &types.DistributionComponent_Coins{Coins: &types.DistributionCoins{}}},
+		"unknown mode":    {Shares: shares, Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "uwill"}, Remainder: 7},
+		"duplicate share": {Shares: append([]types.DistributionShare{shares[0]}, shares...), Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "uwill"}},
+	} {
+		createMsg.Components = []*types.ExecutionComponent{{Id: "invalid", ComponentType: &types.ExecutionComponent_Distribution{Distribution: distribution}}}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.Error(t, err, name)
+	}
 
-	err = kpr.Claim(sdk.UnwrapSDKContext(ctx), claimMsg)
+	createMsg.Components = []*types.ExecutionComponent{
+		fixed("first", types.DistributionRemainder_DISTRIBUTION_REMAINDER_FIRST_BENEFICIARY, sdk.NewInt64Coin("uwill", 100), sdk.NewInt64Coin("uatom", 50)),
+		fixed("burn", types.DistributionRemainder_DISTRIBUTION_REMAINDER_BURN, sdk.NewInt64Coin("uwill", 200)),
+		{Id: "balance", ComponentType: &types.ExecutionComponent_Distribution{Distribution: &types.DistributionComponent{
+			Shares: []types.DistributionShare{
+				{Address: aliceAddr.String(), Weight: 70},
+				{Address: bobAddr.String(), Weight: 30},
+			},
+			Source: &types.DistributionComponent_BalanceDenom{BalanceDenom: "uwill"},
+		}}},
+	}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	// only the fixed coins are escrowed
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
 	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 300), sdk.NewInt64Coin("uatom", 50)), escrow.Coins)
+	supply := kpr.GetBankKeeper().GetSupply(ctx, "uwill").Amount
 
-	updatedWill, err := kpr.GetWillByID(sdk.UnwrapSDKContext(ctx), will.ID)
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	for _, component := range stored.Components {
+		assert.Equal(t, types.ComponentStatusExecuted, component.Status, component.Id)
+	}
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return kpr.GetBankKeeper().GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	// first: 100uwill and 50uatom split three ways, the remainders of 1uwill and 2uatom go to alice
+	// burn: 200uwill split three ways, the remainder of 2uwill is burned
+	// balance: the creator's remaining 700uwill split 70/30
+	assert.Equal(t, int64(34+66+490), balance(aliceAddr, "uwill"))
+	assert.Equal(t, int64(18), balance(aliceAddr, "uatom"))
+	assert.Equal(t, int64(33+66+210), balance(bobAddr, "uwill"))
+	assert.Equal(t, int64(16), balance(bobAddr, "uatom"))
+	assert.Equal(t, int64(33+66), balance(carolAddr, "uwill"))
+	assert.Equal(t, int64(16), balance(carolAddr, "uatom"))
+	assert.Equal(t, int64(0), balance(creatorAddr, "uwill"))
+	assert.Equal(t, supply.SubRaw(2), kpr.GetBankKeeper().GetSupply(ctx, "uwill").Amount)
+	escrow, err = kpr.GetEscrow(ctx, will.ID)
 	require.NoError(t, err)
-	require.Equal(t, "claimed", updatedWill.Components[0].Status)
+	assert.True(t, escrow.Coins.IsZero())
+
+	var payouts int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "will_distribution_payout" {
+			payouts++
+		}
+	}
+	assert.Equal(t, 8, payouts)
 }
 
-// Converts a string to a scalar value using SHA256 hash.
-func stringToScalar(data string) ristretto.Scalar {
-	var scalar ristretto.Scalar
-	hash := sha256.Sum256([]byte(data)) // hash is a [32]byte array
-	fmt.Println("String to scaler: ", data, "and hash: ", hash)
+func TestKeeperVesting(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("vest-creator________")
+	heirAddr := sdk.AccAddress("vest-heir___________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1200)))
+
+	// schedule holds the schedule of the component
+	vesting := func(id string, amount int64, schedule types.VestingComponent) *types.ExecutionComponent {
+		schedule.To = heirAddr.String()
+		schedule.Amount = sdk.NewCoins(sdk.NewInt64Coin("uwill", amount))
+		return &types.ExecutionComponent{Id: id, ComponentType: &types.ExecutionComponent_Vesting{Vesting: &schedule}}
+	}
+	linear := func(blocks int64) types.VestingComponent {
+		return types.VestingComponent{Schedule: &types.VestingComponent_Linear{Linear: &types.LinearVesting{Blocks: blocks}}}
+	}
+	cliff := func(cliffBlocks, blocks int64) types.VestingComponent {
+		return types.VestingComponent{Schedule: &types.VestingComponent_Cliff{Cliff: &types.CliffVesting{CliffBlocks: cliffBlocks, Blocks: blocks}}}
+	}
+	periods := func(amounts ...int64) types.VestingComponent {
+		periodic := &types.PeriodicVesting{}
+		for _, amount := range amounts {
+			periodic.Periods = append(periodic.Periods, types.VestingPeriod{Blocks: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("uwill", amount))})
+		}
+		return types.VestingComponent{Schedule: &types.VestingComponent_Periodic{Periodic: periodic}}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "vesting will",
+		Beneficiary: heirAddr.String(),
+		Height:      2,
+	}
+	started := vesting("started", 100, linear(10))
+	started.GetVesting().StartHeight = 1
+	for _, invalid := range []*types.ExecutionComponent{
+		vesting("no-schedule", 100, types.VestingComponent{}),
+		vesting("no-blocks", 100, linear(0)),
+		vesting("late-cliff", 100, cliff(20, 10)),
+		vesting("short-periods", 100, periods(30, 60)),
+		started,
+	} {
+		createMsg.Components = []*types.ExecutionComponent{invalid}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid, invalid.Id)
+	}
 
-	bytes := scalar.SetBytes(&hash)
-	fmt.Println("bytes: ", bytes, "and scalar: ", scalar)
-	return scalar
+	createMsg.Components = []*types.ExecutionComponent{
+		vesting("linear", 1000, linear(100)),
+		vesting("cliff", 100, cliff(10, 100)),
+		vesting("periodic", 100, periods(30, 70)),
+	}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1200)), escrow.Coins)
+
+	querier := keeper.NewGrpcQuerier(kpr)
+	vestingStatus := func(componentID string) *types.QueryVestingStatusResponse {
+		res, err := querier.VestingStatus(ctx, &types.QueryVestingStatusRequest{WillId: will.ID, ComponentId: componentID})
+		require.NoError(t, err)
+		return res
+	}
+	withdraw := func(componentID string) (int64, error) {
+		amount, err := kpr.WithdrawVested(ctx, &types.MsgWithdrawVestedRequest{Beneficiary: heirAddr.String(), WillId: will.ID, ComponentId: componentID})
+		return amount.AmountOf("uwill").Int64(), err
+	}
+
+	// nothing vests before the will fires
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)).String(), vestingStatus("linear").Remaining.String())
+	_, err = withdraw("linear")
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	ctx = ctx.WithBlockHeight(7)
+	res := vestingStatus("linear")
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 50)).String(), res.Vested.String())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 950)).String(), res.Remaining.String())
+	assert.Equal(t, int64(2), res.StartHeight)
+	assert.Equal(t, int64(102), res.EndHeight)
+	_, err = kpr.WithdrawVested(ctx, &types.MsgWithdrawVestedRequest{Beneficiary: creatorAddr.String(), WillId: will.ID, ComponentId: "linear"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	amount, err := withdraw("linear")
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), amount)
+	_, err = withdraw("linear")
+	require.ErrorIs(t, err, types.ErrInvalid, "nothing more vested in the same block")
+	// before the cliff and the end of the first period nothing vested
+	_, err = withdraw("cliff")
+	require.ErrorIs(t, err, types.ErrInvalid)
+	_, err = withdraw("periodic")
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	ctx = ctx.WithBlockHeight(12)
+	res = vestingStatus("linear")
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)).String(), res.Vested.String())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 50)).String(), res.Withdrawn.String())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 50)).String(), res.Withdrawable.String())
+	// at the cliff everything that vested linearly until then is released
+	for componentID, want := range map[string]int64{"linear": 50, "cliff": 10, "periodic": 30} {
+		amount, err := withdraw(componentID)
+		require.NoError(t, err, componentID)
+		assert.Equal(t, want, amount, componentID)
+	}
+
+	ctx = ctx.WithBlockHeight(200)
+	for componentID, want := range map[string]int64{"linear": 900, "cliff": 90, "periodic": 70} {
+		amount, err := withdraw(componentID)
+		require.NoError(t, err, componentID)
+		assert.Equal(t, want, amount, componentID)
+	}
+	assert.Equal(t, int64(1200), kpr.GetBankKeeper().GetBalance(ctx, heirAddr, "uwill").Amount.Int64())
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	for _, component := range stored.Components {
+		assert.Equal(t, types.ComponentStatusExecuted, component.Status, component.Id)
+	}
+	escrow, err = kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	assert.True(t, escrow.Coins.IsZero())
+	_, err = withdraw("linear")
+	require.ErrorIs(t, err, types.ErrInvalid)
 }
 
-// // Test with deterministic scalars derived from strings.
-func TestKeeperClaimWithConstantPedersenCommitment(t *testing.T) {
-	kpr, ctx := setupKeeper(t) // setupKeeper needs to be defined according to your context setup.
-	creator := "will1p0k8gygawzpggzwftv7cv47zvgg8zaun5xucxz"
-	// beneficiary := "will156mw28alhpenp4lknweat6432dux34uydx590v"
-	beneficiary := "will156mw28alhpenp4lknweat6432dux34uydx590v"
+func TestKeeperUpdateWill(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	bankKeeper := kpr.GetBankKeeper()
+
+	creatorAddr := sdk.AccAddress("update-creator______")
+	beneficiaryAddr := sdk.AccAddress("update-beneficiary__")
+	newBeneficiaryAddr := sdk.AccAddress("update-beneficiary2_")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "Updatable Will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      10,
+		Components:  []*types.ExecutionComponent{transferComponent("t1", beneficiaryAddr, 40)},
+	})
+	require.NoError(t, err)
 
-	// Define challenge and answer strings
-	// probably need to limit this. becase there are strings outsie of the 32/64 integer bounds/range
-	challengeString := "foo"
-	answerString := "bar"
+	// only the creator can update
+	_, err = kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{Creator: beneficiaryAddr.String(), Id: will.ID, Height: 20})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	updated, err := kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{
+		Creator:     creatorAddr.String(),
+		Id:          will.ID,
+		Beneficiary: newBeneficiaryAddr.String(),
+		Height:      20,
+		Components:  []*types.ExecutionComponent{transferComponent("t2", newBeneficiaryAddr, 70)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, will.ID, updated.ID, "an update keeps the will ID")
+	require.Equal(t, newBeneficiaryAddr.String(), updated.Beneficiary)
+	require.Equal(t, int64(20), updated.Height)
 
-	// Convert strings to deterministic scalars
-	challengeScalar := stringToScalar(challengeString)
-	answerScalar := stringToScalar(answerString)
-	fmt.Println("Challenge: ", challengeScalar, " Answer: ", answerScalar)
+	// the escrow shortfall was taken from the creator
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 70)), escrow.Coins)
+	require.Equal(t, math.NewInt(30), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+
+	// components that need less return the surplus to the creator
+	_, err = kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{
+		Creator:    creatorAddr.String(),
+		Id:         will.ID,
+		Components: []*types.ExecutionComponent{transferComponent("t2", newBeneficiaryAddr, 55)},
+	})
+	require.NoError(t, err)
+	escrow, err = kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 55)), escrow.Coins)
+	require.Equal(t, math.NewInt(45), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
 
-	var blindingFactorOriginal, blindingFactorClaim ristretto.Scalar
-	blindingFactorOriginal.Rand()
-	blindingFactorClaim.Rand()
+	// only one will is listed for the creator
+	wills, err := kpr.ListWillsByAddress(ctx, creatorAddr.String())
+	require.NoError(t, err)
+	require.Len(t, wills, 1)
 
-	// Create Pedersen commitment using these scalars
-	H := ristretto.Point{}
-	H.Rand() // Base point for commitment (publicly known and constant)
+	// the old height no longer fires the will, the new one does
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(10)))
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.WillStatusLive, stored.Status)
 
-	// Commitment from the creator (original commitment)
-	originalCommitment := pedersen.CommitTo(&H, &blindingFactorOriginal, &challengeScalar)
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(20)))
+	stored, err = kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.WillStatusExpired, stored.Status)
+	require.Equal(t, math.NewInt(55), bankKeeper.GetBalance(ctx, newBeneficiaryAddr, "uwill").Amount)
 
-	// For simplicity, using the same challenge-answer pair for the claim
-	// In real scenarios, this might be a different pair or some operation based on the original
-	claimCommitment := pedersen.CommitTo(&H, &blindingFactorClaim, &answerScalar)
+	// expired wills cannot be updated
+	_, err = kpr.UpdateWill(ctx.WithBlockHeight(21), &types.MsgUpdateWillRequest{Creator: creatorAddr.String(), Id: will.ID, Height: 30})
+	require.ErrorIs(t, err, types.ErrWillNotLive)
+}
 
-	// will creator would have to add them ahead of time? or encrypt the blinding factor?
-	addedCommitment := kpr.AddCommitments(originalCommitment, claimCommitment)
+func TestKeeperCancelWill(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	bankKeeper := kpr.GetBankKeeper()
+
+	creatorAddr := sdk.AccAddress("cancel-creator______")
+	beneficiaryAddr := sdk.AccAddress("cancel-beneficiary__")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "Cancellable Will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      10,
+		Components:  []*types.ExecutionComponent{transferComponent("t1", beneficiaryAddr, 60)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(40), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
 
-	// Create a will with Pedersen commitment component including a target commitment
-	msg := &types.MsgCreateWillRequest{
-		Creator:     creator,
-		Name:        "Test Will",
-		Beneficiary: beneficiary,
-		Height:      2,
-		Components: []*types.ExecutionComponent{
-			{
-				Name:   "PedersenCommitmentComponent",
-				Id:     "component-id",
-				Status: "inactive",
-				ComponentType: &types.ExecutionComponent_Claim{
-					Claim: &types.ClaimComponent{
-						Access: types.ClaimAccessControl{
-							AccessType: &types.ClaimAccessControl_Private{
-								Private: &types.ClaimAccessPrivate{
-									Addresses: []string{beneficiary},
-								},
-							},
-						},
-						SchemeType: &types.ClaimComponent_Pedersen{
-							Pedersen: &types.PedersenCommitment{
-								Commitment:       originalCommitment.Bytes(),
-								TargetCommitment: addedCommitment.Bytes(),
-							},
-						},
-					},
-				},
-				OutputType: &types.ComponentOutput{ // Correctly initializing the union type
-					OutputType: &types.ComponentOutput_OutputTransfer{
-						OutputTransfer: &types.OutputTransfer{
-							Address: "0xabc",
-							Denom:   "uwill",
-							Amount: &sdk.Coin{ // Proper Coin type initialization
-								Denom:  "uwill",
-								Amount: math.NewInt(100), // Amount as string if cosmos.Coin expects it
-							},
-						},
-					},
-				},
-			},
+	// only the creator can cancel
+	_, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: beneficiaryAddr.String(), Id: will.ID})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	refund, err := kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: will.ID})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 60)), refund)
+	require.Equal(t, math.NewInt(100), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.WillStatusCancelled, stored.Status)
+
+	// the will never fires
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(10)))
+	stored, err = kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.WillStatusCancelled, stored.Status)
+	require.True(t, bankKeeper.GetBalance(ctx, beneficiaryAddr, "uwill").IsZero())
+
+	// a cancelled will cannot be cancelled again
+	_, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: will.ID})
+	require.ErrorIs(t, err, types.ErrWillNotLive)
+}
+
+func TestKeeperListWillsByAddress(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	creatorA := sdk.AccAddress("creator-a___________")
+	creatorB := sdk.AccAddress("creator-b___________")
+
+	createWill := func(creator sdk.AccAddress, name string) *types.Will {
+		will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+			Creator:     creator.String(),
+			Name:        name,
+			Beneficiary: creatorB.String(),
+			Height:      10,
+		})
+		require.NoError(t, err)
+		return will
+	}
+	first := createWill(creatorA, "first")
+	second := createWill(creatorA, "second")
+	other := createWill(creatorB, "other")
+
+	wills, err := kpr.ListWillsByAddress(ctx, creatorA.String())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{first.ID, second.ID}, []string{wills[0].ID, wills[1].ID})
+
+	wills, err = kpr.ListWillsByAddress(ctx, creatorB.String())
+	require.NoError(t, err)
+	require.Len(t, wills, 1)
+	assert.Equal(t, other.ID, wills[0].ID)
+
+	// a will ID is not an address
+	wills, err = kpr.ListWillsByAddress(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Empty(t, wills)
+
+	// cancelled wills stay listed but no longer fire
+	_, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorA.String(), Id: first.ID})
+	require.NoError(t, err)
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(10)))
+	wills, err = kpr.ListWillsByAddress(ctx, creatorA.String())
+	require.NoError(t, err)
+	require.Len(t, wills, 2)
+	for _, will := range wills {
+		if will.ID == first.ID {
+			assert.Equal(t, types.WillStatusCancelled, will.Status)
+		} else {
+			assert.Equal(t, types.WillStatusExpired, will.Status)
+		}
+	}
+}
+
+func claimComponent(id string, access types.ClaimAccessControl) *types.ExecutionComponent {
+	return &types.ExecutionComponent{
+		Id: id,
+		ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+			Access:     access,
+			SchemeType: &types.ClaimComponent_Schnorr{Schnorr: &types.SchnorrSignature{PublicKey: claimComponentKey}},
+		}},
+	}
+}
+
+// claimComponentKey is the public key of the Schnorr claim components tests do not claim
+var claimComponentKey = func() []byte {
+	bz, err := schnorr.PublicKey(schnorr.Hash("claim component key")).MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}()
+
+func TestKeeperBeneficiaryAndClaimableQueries(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	querier := keeper.NewGrpcQuerier(kpr)
+	creatorAddr := sdk.AccAddress("query-creator_______")
+	heirAddr := sdk.AccAddress("query-heir__________")
+	otherAddr := sdk.AccAddress("query-other_________")
+
+	private := types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Private{
+		Private: &types.ClaimAccessPrivate{Addresses: []string{heirAddr.String()}},
+	}}
+	public := types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}}
+	heirWill, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "for the heir",
+		Beneficiary: heirAddr.String(),
+		Height:      5,
+		Components:  []*types.ExecutionComponent{claimComponent("private", private), claimComponent("public", public)},
+	})
+	require.NoError(t, err)
+	otherWill, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "for someone else",
+		Beneficiary: otherAddr.String(),
+		Height:      50,
+		Components:  []*types.ExecutionComponent{claimComponent("public", public)},
+	})
+	require.NoError(t, err)
+
+	// wills by beneficiary, paginated
+	res, err := querier.WillsByBeneficiary(ctx, &types.QueryWillsByBeneficiaryRequest{Address: heirAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Wills, 1)
+	assert.Equal(t, heirWill.ID, res.Wills[0].ID)
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	_, err = querier.WillsByBeneficiary(ctx, &types.QueryWillsByBeneficiaryRequest{Address: "not-an-address"})
+	require.Error(t, err)
+
+	// nothing is claimable before the will fires
+	claimable, err := querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: heirAddr.String()})
+	require.NoError(t, err)
+	assert.Empty(t, claimable.Components)
+
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(5)))
+	claimable, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: heirAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ClaimableComponent{
+		{WillId: heirWill.ID, ComponentId: "private", Scheme: types.ClaimSchemeSchnorr},
+		{WillId: heirWill.ID, ComponentId: "public", Scheme: types.ClaimSchemeSchnorr, Public: true},
+	}, claimable.Components)
+
+	// other addresses only see the public component
+	claimable, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: otherAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ClaimableComponent{
+		{WillId: heirWill.ID, ComponentId: "public", Scheme: types.ClaimSchemeSchnorr, Public: true},
+	}, claimable.Components)
+
+	// the claimant index follows updates
+	_, err = kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{
+		Creator:    creatorAddr.String(),
+		Id:         otherWill.ID,
+		Components: []*types.ExecutionComponent{claimComponent("private", private)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, kpr.BeginBlocker(ctx.WithBlockHeight(50)))
+	claimable, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: otherAddr.String()})
+	require.NoError(t, err)
+	assert.Len(t, claimable.Components, 1)
+	claimable, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: heirAddr.String()})
+	require.NoError(t, err)
+	assert.Len(t, claimable.Components, 3)
+	// the will with a public component that names the heir is listed once
+	assert.Equal(t, uint64(2), claimable.Pagination.Total)
+
+	// pages run over the wills naming the address, then the public ones
+	_, err = kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "not fired yet",
+		Beneficiary: otherAddr.String(),
+		Height:      500,
+		Components:  []*types.ExecutionComponent{claimComponent("public", public)},
+	})
+	require.NoError(t, err)
+	var (
+		paged []types.ClaimableComponent
+		pages int
+	)
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		claimable, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: heirAddr.String(), Pagination: pageReq})
+		require.NoError(t, err)
+		paged = append(paged, claimable.Components...)
+		pages++
+		if claimable.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: claimable.Pagination.NextKey, Limit: 1}
+	}
+	assert.Len(t, paged, 3)
+	assert.Equal(t, 3, pages)
+	// a page key of another address is rejected
+	_, err = querier.ClaimableComponents(ctx, &types.QueryClaimableComponentsRequest{Address: heirAddr.String(), Pagination: &query.PageRequest{Key: []byte("not a key")}})
+	require.Error(t, err)
+}
+
+func TestKeeperListWillsQueries(t *testing.T) {
+	kpr, ctx := setupKeeper(t)
+	querier := keeper.NewGrpcQuerier(kpr)
+	creatorAddr := sdk.AccAddress("list-creator________")
+	otherAddr := sdk.AccAddress("list-other__________")
+
+	var ids []string
+	for _, height := range []int64{5, 10, 15} {
+		will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+			Creator:     creatorAddr.String(),
+			Name:        fmt.Sprintf("will at %d", height),
+			Beneficiary: otherAddr.String(),
+			Height:      height,
+		})
+		require.NoError(t, err)
+		ids = append(ids, will.ID)
+	}
+	_, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     otherAddr.String(),
+		Name:        "other will",
+		Beneficiary: creatorAddr.String(),
+		Height:      20,
+	})
+	require.NoError(t, err)
+	_, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: ids[1]})
+	require.NoError(t, err)
+
+	t.Run("list wills by page", func(t *testing.T) {
+		page1, err := querier.ListWills(ctx, &types.QueryListWillsRequest{
+			Address:    creatorAddr.String(),
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, page1.Wills, 2)
+		assert.Equal(t, uint64(3), page1.Pagination.Total)
+		require.NotEmpty(t, page1.Pagination.NextKey)
+
+		page2, err := querier.ListWills(ctx, &types.QueryListWillsRequest{
+			Address:    creatorAddr.String(),
+			Pagination: &query.PageRequest{Key: page1.Pagination.NextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, page2.Wills, 1)
+		assert.Empty(t, page2.Pagination.NextKey)
+
+		var got []string
+		for _, will := range append(page1.Wills, page2.Wills...) {
+			got = append(got, will.ID)
+		}
+		assert.ElementsMatch(t, ids, got)
+	})
+
+	willIDs := func(wills []types.Will) []string {
+		got := []string{}
+		for _, will := range wills {
+			got = append(got, will.ID)
+		}
+		return got
+	}
+	specs := map[string]struct {
+		req    *types.QueryAllWillsRequest
+		expLen int
+		expIDs []string
+		expErr bool
+	}{
+		"all": {
+			req:    &types.QueryAllWillsRequest{},
+			expLen: 4,
+		},
+		"by status": {
+			req:    &types.QueryAllWillsRequest{Status: types.WillStatusCancelled},
+			expIDs: []string{ids[1]},
+		},
+		"by height range": {
+			req:    &types.QueryAllWillsRequest{MinHeight: 6, MaxHeight: 15},
+			expIDs: []string{ids[1], ids[2]},
+		},
+		"by status and height range": {
+			req:    &types.QueryAllWillsRequest{Status: types.WillStatusLive, MaxHeight: 15},
+			expIDs: []string{ids[0], ids[2]},
+		},
+		"paginated": {
+			req:    &types.QueryAllWillsRequest{Pagination: &query.PageRequest{Limit: 3}},
+			expLen: 3,
+		},
+		"unknown status": {
+			req:    &types.QueryAllWillsRequest{Status: "dormant"},
+			expErr: true,
+		},
+		"inverted height range": {
+			req:    &types.QueryAllWillsRequest{MinHeight: 10, MaxHeight: 5},
+			expErr: true,
 		},
 	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, err := querier.AllWills(ctx, spec.req)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expIDs != nil {
+				assert.ElementsMatch(t, spec.expIDs, willIDs(res.Wills))
+				return
+			}
+			assert.Len(t, res.Wills, spec.expLen)
+		})
+	}
+}
 
-	// Simulated creation of the will
-	will, err := kpr.CreateWill(sdk.UnwrapSDKContext(ctx), msg)
+func TestKeeperParams(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	bankKeeper := kpr.GetBankKeeper()
+
+	// genesis sets the default params
+	require.Equal(t, types.DefaultParams(), kpr.GetParams(ctx))
+
+	params := types.Params{
+		MaxWillsPerHeight:     1,
+		MaxComponentsPerWill:  1,
+		MinTriggerHorizon:     5,
+		MaxTriggerHorizon:     100,
+		CreationDeposit:       sdk.NewCoins(sdk.NewInt64Coin("uwill", 10)),
+		EnabledComponentTypes: []string{types.ComponentTypeTransfer},
+		MaxBlockExecutionGas:  types.DefaultMaxBlockExecutionGas,
+		IBCTimeout:            types.DefaultIBCTimeout,
+	}
+	require.NoError(t, kpr.SetParams(ctx, params))
+	require.Equal(t, params, kpr.GetParams(ctx))
+
+	// invalid params are rejected
+	invalid := params
+	invalid.EnabledComponentTypes = []string{"teleport"}
+	require.Error(t, kpr.SetParams(ctx, invalid))
+
+	creatorAddr := sdk.AccAddress("params-creator______")
+	beneficiaryAddr := sdk.AccAddress("params-beneficiary__")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+	newMsg := func(height int64, components ...*types.ExecutionComponent) *types.MsgCreateWillRequest {
+		return &types.MsgCreateWillRequest{
+			Creator:     creatorAddr.String(),
+			Name:        fmt.Sprintf("will at %d", height),
+			Beneficiary: beneficiaryAddr.String(),
+			Height:      height,
+			Components:  components,
+		}
+	}
+
+	specs := map[string]*types.MsgCreateWillRequest{
+		"below min trigger horizon": newMsg(3, transferComponent("t", beneficiaryAddr, 1)),
+		"above max trigger horizon": newMsg(200, transferComponent("t", beneficiaryAddr, 1)),
+		"too many components":       newMsg(10, transferComponent("t1", beneficiaryAddr, 1), transferComponent("t2", beneficiaryAddr, 1)),
+		"disabled component type": newMsg(10, &types.ExecutionComponent{
+			Id:            "c",
+			ComponentType: &types.ExecutionComponent_Contract{Contract: &types.ContractComponent{}},
+		}),
+	}
+	for name, msg := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, err := kpr.CreateWill(ctx, msg)
+			require.Error(t, err)
+		})
+	}
+
+	// the creation deposit is taken on top of the escrow
+	will, err := kpr.CreateWill(ctx, newMsg(10, transferComponent("t", beneficiaryAddr, 20)))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(70), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 10)), escrow.Deposit)
+
+	// a height holds more wills than are scheduled in a block
+	second, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "second will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+
+	// cancelling returns the escrow and the deposit
+	refund, err := kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: will.ID})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 30)), refund)
+	refund, err = kpr.CancelWill(ctx, &types.MsgCancelWillRequest{Creator: creatorAddr.String(), Id: second.ID})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 10)), refund)
+	require.Equal(t, math.NewInt(100), bankKeeper.GetBalance(ctx, creatorAddr, "uwill").Amount)
+}
+
+func TestKeeperClaimWithSchnorrSignature(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("schnorr-creator_____")
+	claimerAddr := sdk.AccAddress("schnorr-claimer_____")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 120)))
+
+	privateKey, publicKey := schnorr.RandomKeyPair()
+	publicKeyBytes, err := publicKey.MarshalBinary()
 	require.NoError(t, err)
-	require.NotNil(t, will)
 
-	// Advance the block height to simulate time passage
-	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(2)
-	kpr.BeginBlocker(ctx)
+	schnorrComponent := func(id string, publicKey []byte) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Private{Private: &types.ClaimAccessPrivate{Addresses: []string{claimerAddr.String()}}}},
+				SchemeType: &types.ClaimComponent_Schnorr{Schnorr: &types.SchnorrSignature{PublicKey: publicKey}},
+			}},
+			OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+				Address: claimerAddr.String(),
+				Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(30)},
+			}}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "schnorr will",
+		Beneficiary: claimerAddr.String(),
+		Height:      2,
+	}
+	// the component must commit to a usable public key
+	for _, key := range [][]byte{nil, []byte("2320a2da28561875cedbb0c25ae458e0"), make([]byte, 32)} {
+		createMsg.Components = []*types.ExecutionComponent{schnorrComponent("sig", key)}
+		_, err = kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid)
+	}
+
+	createMsg.Components = []*types.ExecutionComponent{schnorrComponent("sig", publicKeyBytes), schnorrComponent("other", publicKeyBytes)}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	createMsg.Name = "second schnorr will"
+	otherWill, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
 
-	// Simulate the claiming process
-	claimMsg := &types.MsgClaimRequest{
+	sign := func(chainID, willID, componentID, claimer string) []byte {
+		bz, err := schnorr.SignMessage(types.SchnorrClaimDigest(chainID, willID, componentID, claimer), privateKey).MarshalBinary()
+		require.NoError(t, err)
+		return bz
+	}
+	claim := func(willID, componentID string, signature []byte) error {
+		return kpr.Claim(ctx, &types.MsgClaimRequest{
+			WillId:      willID,
+			Claimer:     claimerAddr.String(),
+			ComponentId: componentID,
+			ClaimType: &types.MsgClaimRequest_SchnorrClaim{SchnorrClaim: &types.SchnorrClaim{
+				Signature: signature,
+			}},
+		})
+	}
+	signature := sign(ctx.ChainID(), will.ID, "sig", claimerAddr.String())
+	// the signature is bound to the component, the will, the claimer and the chain
+	require.ErrorIs(t, claim(will.ID, "other", signature), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(otherWill.ID, "sig", signature), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(will.ID, "sig", sign(ctx.ChainID(), will.ID, "sig", creatorAddr.String())), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(will.ID, "sig", sign("other-chain", will.ID, "sig", claimerAddr.String())), types.ErrInvalidProof)
+	// a key other than the committed one does not verify
+	otherKey, _ := schnorr.RandomKeyPair()
+	forged, err := schnorr.SignMessage(types.SchnorrClaimDigest(ctx.ChainID(), will.ID, "sig", claimerAddr.String()), otherKey).MarshalBinary()
+	require.NoError(t, err)
+	require.ErrorIs(t, claim(will.ID, "sig", forged), types.ErrInvalidProof)
+
+	require.NoError(t, claim(will.ID, "sig", signature))
+	claimed, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusClaimed, claimed.Components[0].Status)
+	assert.Equal(t, types.ComponentStatusActive, claimed.Components[1].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 30), kpr.GetBankKeeper().GetBalance(ctx, claimerAddr, "uwill"))
+
+	// the used signature is nullified
+	assert.Equal(t, []types.Nullifier{{Scheme: types.ClaimSchemeSchnorr, Nullifier: signature}}, keeper.ExportGenesis(ctx, kpr).Nullifiers)
+}
+
+func TestKeeperClaimWithThresholdSchnorr(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("threshold-creator___")
+	claimerAddr := sdk.AccAddress("threshold-claimer___")
+	otherAddr := sdk.AccAddress("threshold-other_____")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 90)))
+
+	privateKeys := make([]kyber.Scalar, 3)
+	keys := make([]kyber.Point, 3)
+	publicKeys := make([][]byte, 3)
+	for i := range keys {
+		privateKeys[i], keys[i] = schnorr.RandomKeyPair()
+		bz, err := keys[i].MarshalBinary()
+		require.NoError(t, err)
+		publicKeys[i] = bz
+	}
+	thresholdComponent := func(id string, publicKeys [][]byte, threshold uint32) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access: types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+				SchemeType: &types.ClaimComponent_ThresholdSchnorr{ThresholdSchnorr: &types.ThresholdSchnorr{
+					PublicKeys: publicKeys,
+					Threshold:  threshold,
+				}},
+			}},
+			OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+				Address: claimerAddr.String(),
+				Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(30)},
+			}}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "guardian will",
+		Beneficiary: claimerAddr.String(),
+		Height:      2,
+	}
+	// the threshold must be reachable and the guardian keys usable and distinct
+	for _, component := range []*types.ExecutionComponent{
+		thresholdComponent("guardians", publicKeys, 0),
+		thresholdComponent("guardians", publicKeys, 4),
+		thresholdComponent("guardians", nil, 1),
+		thresholdComponent("guardians", [][]byte{publicKeys[0], publicKeys[1], publicKeys[0]}, 2),
+		thresholdComponent("guardians", [][]byte{publicKeys[0], make([]byte, 32)}, 2),
+	} {
+		createMsg.Components = []*types.ExecutionComponent{component}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid)
+	}
+
+	createMsg.Components = []*types.ExecutionComponent{thresholdComponent("together", publicKeys, 2), thresholdComponent("one-by-one", publicKeys, 2), thresholdComponent("split", publicKeys, 2)}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	signFor := func(claimer sdk.AccAddress, componentID string, signers ...uint32) []byte {
+		digest := types.ThresholdClaimDigest(ctx.ChainID(), will.ID, componentID, claimer.String())
+		nonces := make([]kyber.Scalar, len(signers))
+		commitments := make([]kyber.Point, len(signers))
+		for i := range signers {
+			nonces[i], commitments[i] = schnorr.NewNonce()
+		}
+		r := schnorr.AggregateNonces(commitments...)
+		partials := make([]kyber.Scalar, len(signers))
+		for i, signer := range signers {
+			partial, err := schnorr.SignPartial(digest, keys, signers, signer, privateKeys[signer], nonces[i], r)
+			require.NoError(t, err)
+			partials[i] = partial
+		}
+		bz, err := schnorr.AggregateSignatures(r, partials...).MarshalBinary()
+		require.NoError(t, err)
+		return bz
+	}
+	sign := func(componentID string, signers ...uint32) []byte {
+		return signFor(claimerAddr, componentID, signers...)
+	}
+	claimAs := func(claimer sdk.AccAddress, componentID string, signature []byte, signers ...uint32) error {
+		return kpr.Claim(ctx, &types.MsgClaimRequest{
+			WillId:      will.ID,
+			Claimer:     claimer.String(),
+			ComponentId: componentID,
+			ClaimType: &types.MsgClaimRequest_ThresholdSchnorrClaim{ThresholdSchnorrClaim: &types.ThresholdSchnorrClaim{
+				Signers:   signers,
+				Signature: signature,
+			}},
+		})
+	}
+	claim := func(componentID string, signature []byte, signers ...uint32) error {
+		return claimAs(claimerAddr, componentID, signature, signers...)
+	}
+	status := func(componentIndex int) string {
+		stored, err := kpr.GetWillByID(ctx, will.ID)
+		require.NoError(t, err)
+		return stored.Components[componentIndex].Status
+	}
+
+	// two guardians approve together with one aggregate signature
+	together := sign("together", 0, 2)
+	require.ErrorIs(t, claim("together", together, 0, 1), types.ErrInvalidProof)
+	require.ErrorIs(t, claim("together", together, 2, 0), types.ErrInvalidProof)
+	require.ErrorIs(t, claim("together", together, 0, 3), types.ErrInvalidProof)
+	require.ErrorIs(t, claim("together", sign("one-by-one", 0, 2), 0, 2), types.ErrInvalidProof)
+	require.NoError(t, claim("together", together, 0, 2))
+	assert.Equal(t, types.ComponentStatusClaimed, status(0))
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 30), kpr.GetBankKeeper().GetBalance(ctx, claimerAddr, "uwill"))
+
+	// guardians approve one by one, the component is claimed at the threshold
+	first := sign("one-by-one", 1)
+	require.ErrorIs(t, claim("one-by-one", first, 0), types.ErrInvalidProof)
+	require.NoError(t, claim("one-by-one", first, 1))
+	assert.Equal(t, types.ComponentStatusActive, status(1))
+	assert.Equal(t, []types.Approval{{WillId: will.ID, ComponentId: "one-by-one", Guardian: 1, Claimer: claimerAddr.String()}}, keeper.ExportGenesis(ctx, kpr).Approvals)
+	require.ErrorIs(t, claim("one-by-one", first, 1), types.ErrProofUsed)
+	// approving again does not count twice
+	require.NoError(t, claim("one-by-one", sign("one-by-one", 1), 1))
+	assert.Equal(t, types.ComponentStatusActive, status(1))
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 30), kpr.GetBankKeeper().GetBalance(ctx, claimerAddr, "uwill"))
+
+	require.NoError(t, claim("one-by-one", sign("one-by-one", 2), 2))
+	assert.Equal(t, types.ComponentStatusClaimed, status(1))
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 60), kpr.GetBankKeeper().GetBalance(ctx, claimerAddr, "uwill"))
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).Approvals)
+
+	// approvals given to different claimers do not add up
+	require.NoError(t, claimAs(otherAddr, "split", signFor(otherAddr, "split", 0), 0))
+	require.NoError(t, claim("split", sign("split", 1), 1))
+	assert.Equal(t, types.ComponentStatusActive, status(2))
+	assert.ElementsMatch(t, []types.Approval{
+		{WillId: will.ID, ComponentId: "split", Guardian: 0, Claimer: otherAddr.String()},
+		{WillId: will.ID, ComponentId: "split", Guardian: 1, Claimer: claimerAddr.String()},
+	}, keeper.ExportGenesis(ctx, kpr).Approvals)
+	// the claimer the threshold of guardians approved claims the component
+	require.NoError(t, claim("split", sign("split", 0), 0))
+	assert.Equal(t, types.ComponentStatusClaimed, status(2))
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 90), kpr.GetBankKeeper().GetBalance(ctx, claimerAddr, "uwill"))
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).Approvals)
+}
+
+// pedersenComponent is a claim component holding a Pedersen commitment that pays out 40uwill
+func pedersenComponent(id string, access types.ClaimAccessControl, commitment ristretto.Point, bindClaimer bool, to sdk.AccAddress) *types.ExecutionComponent {
+	return &types.ExecutionComponent{
+		Id: id,
+		ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+			Access: access,
+			SchemeType: &types.ClaimComponent_Pedersen{Pedersen: &types.PedersenCommitment{
+				Commitment:  commitment.Bytes(),
+				BindClaimer: bindClaimer,
+			}},
+		}},
+		OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+			Address: to.String(),
+			Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(40)},
+		}}},
+	}
+}
+
+func pedersenClaim(willID, componentID string, claimer sdk.AccAddress, blindingFactor, value []byte) *types.MsgClaimRequest {
+	return &types.MsgClaimRequest{
+		WillId:      willID,
+		Claimer:     claimer.String(),
+		ComponentId: componentID,
+		ClaimType: &types.MsgClaimRequest_PedersenClaim{PedersenClaim: &types.PedersenClaim{
+			BlindingFactor: blindingFactor,
+			Value:          value,
+		}},
+	}
+}
+
+func TestKeeperClaimWithPedersenCommitment(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("pedersen-creator____")
+	beneficiaryAddr := sdk.AccAddress("pedersen-beneficiary")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	// the creator commits to a random value with a random blinding factor
+	var value, blindingFactor ristretto.Scalar
+	value.Rand()
+	blindingFactor.Rand()
+	commitment := pedersen.Commit(&blindingFactor, &value)
+
+	private := types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Private{Private: &types.ClaimAccessPrivate{Addresses: []string{beneficiaryAddr.String()}}}}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "pedersen will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      2,
+		Components:  []*types.ExecutionComponent{pedersenComponent("opening", private, commitment, false, beneficiaryAddr)},
+	}
+	createMsg.Components[0].GetClaim().GetPedersen().Commitment = []byte("not a commitment")
+	_, err := kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	createMsg.Components[0].GetClaim().GetPedersen().Commitment = commitment.Bytes()
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	claim := func(blindingFactor, value []byte) error {
+		return kpr.Claim(ctx, pedersenClaim(will.ID, "opening", beneficiaryAddr, blindingFactor, value))
+	}
+	// reading the commitment from state is not enough to claim
+	var otherValue ristretto.Scalar
+	otherValue.Rand()
+	require.ErrorIs(t, claim(blindingFactor.Bytes(), otherValue.Bytes()), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(otherValue.Bytes(), value.Bytes()), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(nil, value.Bytes()), types.ErrInvalidProof)
+	// the scalars must be canonical
+	nonCanonical := append([]byte{}, blindingFactor.Bytes()...)
+	nonCanonical[31] |= 0xe0
+	require.ErrorIs(t, claim(nonCanonical, value.Bytes()), types.ErrInvalidProof)
+
+	require.NoError(t, claim(blindingFactor.Bytes(), value.Bytes()))
+	claimed, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusClaimed, claimed.Components[0].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 40), kpr.GetBankKeeper().GetBalance(ctx, beneficiaryAddr, "uwill"))
+}
+
+func TestKeeperClaimWithGnarkProof(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("gnark-creator_______")
+	beneficiaryAddr := sdk.AccAddress("gnark-beneficiary___")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	// the creator sets up the MiMC circuit and pins the hash of the secret
+	cs := groth16testing.MiMCCircuit()
+	pk, vk, err := groth16testing.Setup(cs, nil)
+	require.NoError(t, err)
+	secret := big.NewInt(0xdeadf00d)
+	hash := groth16.MarshalPublicInputs([]*big.Int{groth16testing.MiMC(secret)})
+
+	gnarkComponent := func(vk []byte) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: "zk",
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access: types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+				SchemeType: &types.ClaimComponent_Gnark{Gnark: &types.GnarkZkSnark{
+					VerificationKey: vk,
+					PublicInputs:    hash,
+				}},
+			}},
+			OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+				Address: beneficiaryAddr.String(),
+				Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(40)},
+			}}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "zk will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      2,
+		Components:  []*types.ExecutionComponent{gnarkComponent([]byte("not a verification key"))},
+	}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// the will must pin the hash, or the claimer would choose the statement it proves
+	unpinned := gnarkComponent(vk.Marshal())
+	unpinned.GetClaim().GetGnark().PublicInputs = nil
+	createMsg.Components = []*types.ExecutionComponent{unpinned}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// a key whose claimer K point is the point at infinity accepts the proof from anyone
+	unbound := vk.Marshal()
+	copy(unbound[len(unbound)-groth16.G1Size:], make([]byte, groth16.G1Size))
+	createMsg.Components = []*types.ExecutionComponent{gnarkComponent(unbound)}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	createMsg.Components = []*types.ExecutionComponent{gnarkComponent(vk.Marshal())}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	witness, err := groth16testing.MiMCWitness(secret, beneficiaryAddr.String())
+	require.NoError(t, err)
+	proof, err := groth16testing.Prove(cs, pk, witness, nil)
+	require.NoError(t, err)
+	otherWitness, err := groth16testing.MiMCWitness(big.NewInt(1), beneficiaryAddr.String())
+	require.NoError(t, err)
+	otherProof, err := groth16testing.Prove(cs, pk, otherWitness, nil)
+	require.NoError(t, err)
+
+	claim := func(claimer sdk.AccAddress, proof, publicInputs []byte) error {
+		return kpr.Claim(ctx, &types.MsgClaimRequest{
+			WillId:      will.ID,
+			Claimer:     claimer.String(),
+			ComponentId: "zk",
+			ClaimType: &types.MsgClaimRequest_GnarkClaim{GnarkClaim: &types.GnarkClaim{
+				Proof:        proof,
+				PublicInputs: publicInputs,
+			}},
+		})
+	}
+	require.ErrorIs(t, claim(beneficiaryAddr, []byte("not a proof"), nil), types.ErrInvalidProof)
+	// a valid proof for another secret verifies only against its own hash, which the will does not pin
+	require.ErrorIs(t, claim(beneficiaryAddr, otherProof.Marshal(), nil), types.ErrInvalidProof)
+	require.ErrorIs(t, claim(beneficiaryAddr, otherProof.Marshal(), groth16.MarshalPublicInputs(cs.PublicInputs(otherWitness))), types.ErrInvalidProof)
+	// the proof is bound to its claimer, copying it from the mempool does not help
+	frontRunner := sdk.AccAddress("gnark-front-runner__")
+	require.ErrorIs(t, claim(frontRunner, proof.Marshal(), nil), types.ErrInvalidProof)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.NoError(t, claim(beneficiaryAddr, proof.Marshal(), nil))
+	assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, keeper.GasGnarkVerifyBase+groth16.NumPairings*keeper.GasGnarkVerifyPerPairing)
+
+	claimed, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusClaimed, claimed.Components[0].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 40), kpr.GetBankKeeper().GetBalance(ctx, beneficiaryAddr, "uwill"))
+}
+
+// preimageScheme is a custom claim scheme proven by revealing the sha256 pre-image of the component data
+type preimageScheme struct{}
+
+func (preimageScheme) Name() string { return "preimage" }
+
+func (preimageScheme) ValidateComponent(component *types.ClaimComponent) error {
+	if len(component.GetCustom().Data) != sha256.Size {
+		return types.ErrInvalid.Wrap("preimage component data must be a sha256 hash")
+	}
+	return nil
+}
+
+func (preimageScheme) GasCost(*types.ClaimComponent, *types.MsgClaimRequest) uint64 { return 7_000 }
+
+func (preimageScheme) VerifyClaim(_ context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error {
+	claim := msg.GetCustomClaim()
+	if claim == nil {
+		return types.ErrInvalid.Wrap("expected a custom claim")
+	}
+	if hash := sha256.Sum256(claim.Data); !bytes.Equal(hash[:], component.GetCustom().Data) {
+		return types.ErrInvalidProof.Wrap("not the pre-image")
+	}
+	return nil
+}
+
+func TestKeeperClaimWithCustomScheme(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("custom-creator______")
+	beneficiaryAddr := sdk.AccAddress("custom-beneficiary__")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	hash := sha256.Sum256([]byte("open sesame"))
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "custom will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      2,
+		Components: []*types.ExecutionComponent{{
+			Id: "custom",
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+				SchemeType: &types.ClaimComponent_Custom{Custom: &types.CustomClaimScheme{Scheme: "preimage", Data: hash[:]}},
+			}},
+			OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+				Address: beneficiaryAddr.String(),
+				Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(25)},
+			}}},
+		}},
+	}
+	// the scheme is unknown until it is registered
+	_, err := kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	keeper.ApplyOptions(kpr, keeper.WithClaimSchemes(preimageScheme{}))
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	claim := func(msg types.MsgClaimRequest) error {
+		msg.WillId, msg.Claimer, msg.ComponentId = will.ID, beneficiaryAddr.String(), "custom"
+		return kpr.Claim(ctx, &msg)
+	}
+	require.ErrorIs(t, claim(types.MsgClaimRequest{ClaimType: &types.MsgClaimRequest_SchnorrClaim{SchnorrClaim: &types.SchnorrClaim{}}}), types.ErrInvalid)
+	require.ErrorIs(t, claim(types.MsgClaimRequest{ClaimType: &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte("abracadabra")}}}), types.ErrInvalidProof)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.NoError(t, claim(types.MsgClaimRequest{ClaimType: &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte("open sesame")}}}))
+	assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, uint64(7_000))
+
+	claimed, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusClaimed, claimed.Components[0].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 25), kpr.GetBankKeeper().GetBalance(ctx, beneficiaryAddr, "uwill"))
+
+	// a claim whose output cannot be paid fails, the component stays claimable and its escrow stays
+	createMsg.Name, createMsg.Height = "unpayable will", 3
+	createMsg.Components[0].OutputType = &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+		Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+		Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(25)},
+	}}}
+	will, err = kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	txCtx, _ := ctx.CacheContext()
+	msg := types.MsgClaimRequest{
 		WillId:      will.ID,
-		Claimer:     beneficiary,
-		ComponentId: "component-id",
-		ClaimType: &types.MsgClaimRequest_PedersenClaim{
-			PedersenClaim: &types.PedersenClaim{
-				Commitment: claimCommitment.Bytes(),
-			},
-		},
+		ComponentId: "custom",
+		Claimer:     beneficiaryAddr.String(),
+		ClaimType:   &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte("open sesame")}},
+	}
+	require.ErrorIs(t, kpr.Claim(txCtx, &msg), sdkerrors.ErrUnauthorized)
+	unpaid, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusActive, unpaid.Components[0].Status)
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 25)), escrow.Coins)
+}
+
+func TestKeeperClaimWindows(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	keeper.ApplyOptions(kpr, keeper.WithClaimSchemes(preimageScheme{}))
+	creatorAddr := sdk.AccAddress("window-creator______")
+	heirAddr := sdk.AccAddress("window-heir_________")
+	residuaryAddr := sdk.AccAddress("window-residuary____")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 120)))
+
+	hash := sha256.Sum256([]byte("open sesame"))
+	transfer := func(to sdk.AccAddress, amount int64) *types.ComponentOutput {
+		return &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+			Address: to.String(),
+			Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(amount)},
+		}}}
+	}
+	component := func(id string, window *types.ClaimWindow, fallback *types.ComponentOutput) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+				SchemeType: &types.ClaimComponent_Custom{Custom: &types.CustomClaimScheme{Scheme: "preimage", Data: hash[:]}},
+				Window:     window,
+				Fallback:   fallback,
+			}},
+			OutputType: transfer(heirAddr, 30),
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "windowed will",
+		Beneficiary: heirAddr.String(),
+		Height:      2,
+	}
+	preset := component("preset", &types.ClaimWindow{Blocks: 5}, transfer(residuaryAddr, 20))
+	preset.GetClaim().LapseHeight = 3
+	for _, invalid := range []*types.ExecutionComponent{
+		component("no-fallback", &types.ClaimWindow{Blocks: 5}, nil),
+		component("no-window", nil, transfer(residuaryAddr, 20)),
+		component("both", &types.ClaimWindow{Blocks: 5, Duration: time.Hour}, transfer(residuaryAddr, 20)),
+		component("neither", &types.ClaimWindow{}, transfer(residuaryAddr, 20)),
+		component("negative", &types.ClaimWindow{Blocks: -5}, transfer(residuaryAddr, 20)),
+		preset,
+	} {
+		createMsg.Components = []*types.ExecutionComponent{invalid}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid, invalid.Id)
+	}
+
+	createMsg.Components = []*types.ExecutionComponent{
+		component("blocks", &types.ClaimWindow{Blocks: 5}, transfer(residuaryAddr, 20)),
+		component("duration", &types.ClaimWindow{Duration: time.Hour}, transfer(residuaryAddr, 30)),
+		component("claimed", &types.ClaimWindow{Blocks: 5}, transfer(residuaryAddr, 20)),
+		// the fee collector cannot receive transfers, so the fallback fails
+		component("unpayable", &types.ClaimWindow{Blocks: 5}, transfer(authtypes.NewModuleAddress(authtypes.FeeCollectorName), 20)),
+	}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	// each component escrows the larger of its output and its fallback
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 120)), escrow.Coins)
+
+	fired := ctx.BlockTime()
+	advance := func(height int64, blockTime time.Time) {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+		require.NoError(t, kpr.BeginBlocker(ctx))
+	}
+	status := func(componentIndex int) string {
+		stored, err := kpr.GetWillByID(ctx, will.ID)
+		require.NoError(t, err)
+		return stored.Components[componentIndex].Status
+	}
+	claim := func(componentID string) error {
+		return kpr.Claim(ctx, &types.MsgClaimRequest{
+			WillId:      will.ID,
+			Claimer:     heirAddr.String(),
+			ComponentId: componentID,
+			ClaimType:   &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte("open sesame")}},
+		})
+	}
+	balance := func(addr sdk.AccAddress) int64 {
+		return kpr.GetBankKeeper().GetBalance(ctx, addr, "uwill").Amount.Int64()
+	}
+
+	advance(2, fired)
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), stored.Components[0].GetClaim().LapseHeight)
+	assert.Equal(t, fired.Add(time.Hour), *stored.Components[1].GetClaim().LapseTime)
+
+	advance(3, fired.Add(time.Minute))
+	require.NoError(t, claim("claimed"))
+	assert.Equal(t, int64(30), balance(heirAddr))
+
+	// the block window closes at the lapse height
+	advance(6, fired.Add(2*time.Minute))
+	assert.Equal(t, types.ComponentStatusActive, status(0))
+	creatorBalance := balance(creatorAddr)
+	advance(7, fired.Add(3*time.Minute))
+	assert.Equal(t, types.ComponentStatusLapsed, status(0))
+	assert.Equal(t, int64(20), balance(residuaryAddr))
+	require.Error(t, claim("blocks"))
+	// the failed fallback returns its escrow to the creator, as does the lapse beyond the fallback
+	assert.Equal(t, types.ComponentStatusLapsed, status(3))
+	assert.Equal(t, creatorBalance+30+10, balance(creatorAddr))
+
+	// the time window closes with the first block at or after the lapse time
+	advance(8, fired.Add(time.Hour-time.Second))
+	assert.Equal(t, types.ComponentStatusActive, status(1))
+	advance(9, fired.Add(time.Hour))
+	assert.Equal(t, types.ComponentStatusLapsed, status(1))
+	assert.Equal(t, int64(50), balance(residuaryAddr))
+
+	// the claimed component never runs its fallback
+	advance(10, fired.Add(2*time.Hour))
+	assert.Equal(t, types.ComponentStatusClaimed, status(2))
+	assert.Equal(t, int64(30), balance(heirAddr))
+	assert.Equal(t, int64(50), balance(residuaryAddr))
+}
+
+// mockTransferKeeper records the ICS-20 transfers wills send and moves their coins from the
+// sender to the transfer escrow, as the transfer module does
+type mockTransferKeeper struct {
+	bankKeeper keeper.BankKeeper
+	sent       []*ibctransfertypes.MsgTransfer
+	err        error
+}
+
+func (m *mockTransferKeeper) Transfer(ctx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.bankKeeper.SendCoins(ctx, sender, ibctransfertypes.GetEscrowAddress(msg.SourcePort, msg.SourceChannel), sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+	m.sent = append(m.sent, msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: uint64(len(m.sent))}, nil
+}
+
+func TestKeeperIBCSend(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	keeper.ApplyOptions(kpr, keeper.WithClaimSchemes(preimageScheme{}))
+	transfers := &mockTransferKeeper{bankKeeper: kpr.GetBankKeeper()}
+	keeper.SetTransferKeeper(kpr, transfers)
+	creatorAddr := sdk.AccAddress("ibc-send-creator____")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+
+	coin := func(amount int64) *sdk.Coin {
+		c := sdk.NewInt64Coin("uwill", amount)
+		return &c
+	}
+	send := func(id string, amount int64) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_IbcSend{IbcSend: &types.IBCSendComponent{
+				Address: "osmo1heir",
+				Channel: "channel-7",
+				Amount:  coin(amount),
+				Memo:    "inheritance",
+			}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "cross-chain will",
+		Beneficiary: creatorAddr.String(),
+		Height:      2,
+	}
+	for name, mutate := range map[string]func(*types.IBCSendComponent){
+		"no receiver":    func(s *types.IBCSendComponent) { s.Address = "" },
+		"bad channel":    func(s *types.IBCSendComponent) { s.Channel = "not a channel!" },
+		"bad port":       func(s *types.IBCSendComponent) { s.PortId = "x" },
+		"no amount":      func(s *types.IBCSendComponent) { s.Amount = nil },
+		"denom mismatch": func(s *types.IBCSendComponent) { s.Denom = "uatom" },
+	} {
+		component := send("invalid", 10)
+		mutate(component.GetIbcSend())
+		createMsg.Components = []*types.ExecutionComponent{component}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.Error(t, err, name)
 	}
 
-	// Process the claim
-	err = kpr.Claim(sdk.UnwrapSDKContext(ctx), claimMsg)
+	hash := sha256.Sum256([]byte("open sesame"))
+	lapsing := &types.ExecutionComponent{
+		Id: "lapsing",
+		ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+			Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+			SchemeType: &types.ClaimComponent_Custom{Custom: &types.CustomClaimScheme{Scheme: "preimage", Data: hash[:]}},
+			Window:     &types.ClaimWindow{Blocks: 1},
+			Fallback: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputIbcSend{OutputIbcSend: &types.OutputIBCSend{
+				Channel: "channel-7",
+				Address: "osmo1residuary",
+				Amount:  coin(20),
+			}}},
+		}},
+		OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputEmit{OutputEmit: &types.OutputEmit{Message: "claimed"}}},
+	}
+	createMsg.Components = []*types.ExecutionComponent{send("paid", 100), send("bounced", 50), lapsing}
+	will, err := kpr.CreateWill(ctx, createMsg)
 	require.NoError(t, err)
 
-	// Verify the status of the commitment after processing the claim
-	updatedWill, err := kpr.GetWillByID(sdk.UnwrapSDKContext(ctx), will.ID)
+	querier := keeper.NewGrpcQuerier(kpr)
+	statuses := func() ([]string, []string) {
+		stored, err := kpr.GetWillByID(ctx, will.ID)
+		require.NoError(t, err)
+		res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: will.ID})
+		require.NoError(t, err)
+		var components, receipts []string
+		for i, component := range stored.Components {
+			components = append(components, component.Status)
+			receipts = append(receipts, res.Receipts[i].Status)
+		}
+		return components, receipts
+	}
+	transferEscrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-7")
+
+	// the transfers leave the creator's account with the coins released from escrow
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 2)
+	sent := transfers.sent[0]
+	assert.Equal(t, ibctransfertypes.PortID, sent.SourcePort)
+	assert.Equal(t, "channel-7", sent.SourceChannel)
+	assert.Equal(t, creatorAddr.String(), sent.Sender)
+	assert.Equal(t, "osmo1heir", sent.Receiver)
+	assert.Equal(t, "inheritance", sent.Memo)
+	assert.Equal(t, "100uwill", sent.Token.String())
+	assert.Equal(t, uint64(ctx.BlockTime().Add(types.DefaultIBCTimeout).UnixNano()), sent.TimeoutTimestamp)
+	assert.Equal(t, "150uwill", kpr.GetBankKeeper().GetBalance(ctx, transferEscrow, "uwill").String())
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
 	require.NoError(t, err)
-	require.Equal(t, "claimed", updatedWill.Components[0].Status)
+	assert.Equal(t, "20uwill", escrow.Coins.String())
+
+	// the components are pending until their transfers are acknowledged
+	components, receipts := statuses()
+	assert.Equal(t, []string{types.ComponentStatusPending, types.ComponentStatusPending, types.ComponentStatusActive}, components)
+	assert.Equal(t, components, receipts)
+	assert.Len(t, keeper.ExportGenesis(ctx, kpr).PendingPackets, 2)
+	require.NoError(t, kpr.SettlePacket(ctx, "channel-7", 1, true, ""))
+	require.NoError(t, kpr.SettlePacket(ctx, "channel-7", 2, false, "ABCI code: 5"))
+	// packets no will sent are ignored
+	require.NoError(t, kpr.SettlePacket(ctx, "channel-7", 99, true, ""))
+	components, receipts = statuses()
+	assert.Equal(t, []string{types.ComponentStatusExecuted, types.ComponentStatusFailed, types.ComponentStatusActive}, components)
+	assert.Equal(t, components, receipts)
+	res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: will.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "ABCI code: 5", res.Receipts[1].Error)
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).PendingPackets)
+
+	// the fallback output of a lapsed claim is sent too, its component settles back to lapsed
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 3)
+	assert.Equal(t, "osmo1residuary", transfers.sent[2].Receiver)
+	assert.Equal(t, "20uwill", transfers.sent[2].Token.String())
+	components, _ = statuses()
+	assert.Equal(t, types.ComponentStatusPending, components[2])
+	require.NoError(t, kpr.SettlePacket(ctx, "channel-7", 3, true, ""))
+	components, _ = statuses()
+	assert.Equal(t, types.ComponentStatusLapsed, components[2])
+
+	// a transfer the transfer module refuses fails its component
+	transfers.err = ibctransfertypes.ErrSendDisabled
+	createMsg.Height = 5
+	createMsg.Components = []*types.ExecutionComponent{send("refused", 30)}
+	refused, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	res, err = querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: refused.ID})
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusFailed, res.Receipts[0].Status)
+	assert.Contains(t, res.Receipts[0].Error, ibctransfertypes.ErrSendDisabled.Error())
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).PendingPackets)
+}
+
+func TestKeeperPacketRetries(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	transfers := &mockTransferKeeper{bankKeeper: kpr.GetBankKeeper()}
+	keeper.SetTransferKeeper(kpr, transfers)
+	creatorAddr := sdk.AccAddress("retry-creator_______")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 1000)))
+	bank := kpr.GetBankKeeper()
+
+	send := func(id, channel string, amount int64, retry *types.RetryPolicy) *types.ExecutionComponent {
+		coin := sdk.NewInt64Coin("uwill", amount)
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_IbcSend{IbcSend: &types.IBCSendComponent{
+				Address: "osmo1heir",
+				Channel: channel,
+				Amount:  &coin,
+				Retry:   retry,
+			}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "retried will",
+		Beneficiary: creatorAddr.String(),
+		Height:      2,
+	}
+	for name, retry := range map[string]*types.RetryPolicy{
+		"too many retries": {MaxRetries: 11},
+		"negative backoff": {MaxRetries: 1, BackoffBlocks: -1},
+	} {
+		createMsg.Components = []*types.ExecutionComponent{send("invalid", "channel-7", 10, retry)}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid, name)
+	}
+
+	retry := &types.RetryPolicy{MaxRetries: 1, BackoffBlocks: 3}
+	createMsg.Components = []*types.ExecutionComponent{
+		send("retried", "channel-7", 100, retry),
+		send("once", "channel-7", 50, nil),
+		send("rejected", "channel-7", 20, retry),
+		send("closed", "channel-9", 30, retry),
+	}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+
+	querier := keeper.NewGrpcQuerier(kpr)
+	receipt := func(i int) types.ExecutionReceipt {
+		res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: will.ID})
+		require.NoError(t, err)
+		return res.Receipts[i]
+	}
+	// the transfer module refunds a transfer that timed out to its sender, the creator
+	timeout := func(sequence uint64) {
+		sent := transfers.sent[sequence-1]
+		escrow := ibctransfertypes.GetEscrowAddress(sent.SourcePort, sent.SourceChannel)
+		require.NoError(t, bank.SendCoins(ctx, escrow, creatorAddr, sdk.NewCoins(sent.Token)))
+		require.NoError(t, kpr.OnTimeoutPacket(ctx, types.IBCPacketTimeoutMsg{Packet: types.IBCPacket{
+			Src:      types.IBCEndpoint{PortID: sent.SourcePort, ChannelID: sent.SourceChannel},
+			Sequence: sequence,
+		}}))
+	}
+	ack := func(sequence uint64, ack channeltypes.Acknowledgement) {
+		require.NoError(t, kpr.OnAckPacket(ctx, types.IBCPacketAckMsg{
+			Acknowledgement: types.IBCAcknowledgement{Data: ack.Acknowledgement()},
+			OriginalPacket:  types.IBCPacket{Src: types.IBCEndpoint{ChannelID: "channel-7"}, Sequence: sequence},
+		}))
+	}
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 4)
+
+	// a transfer that times out with retries left is sent again after its backoff, its refund
+	// waits in escrow
+	balance := bank.GetBalance(ctx, creatorAddr, "uwill")
+	timeout(1)
+	assert.Equal(t, types.ComponentStatusPending, receipt(0).Status)
+	assert.Equal(t, balance, bank.GetBalance(ctx, creatorAddr, "uwill"))
+	escrow, err := kpr.GetEscrow(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)), escrow.Coins)
+	retries := keeper.ExportGenesis(ctx, kpr).PacketRetries
+	assert.Equal(t, []types.PacketRetry{{WillId: will.ID, ComponentId: "retried", Height: 5, Attempt: 1}}, retries)
+	// a zero height export keeps the blocks left until the retry
+	cacheCtx, _ := ctx.CacheContext()
+	require.NoError(t, kpr.RebaseWillHeights(cacheCtx, 2))
+	assert.Equal(t, int64(3), keeper.ExportGenesis(cacheCtx, kpr).PacketRetries[0].Height)
+
+	// without a retry policy it fails, and the coins stay refunded to the creator
+	timeout(2)
+	assert.Equal(t, types.ComponentStatusFailed, receipt(1).Status)
+	assert.Equal(t, "packet timed out", receipt(1).Error)
+
+	// a transfer the receiving chain rejects is not sent again
+	ack(3, channeltypes.NewErrorAcknowledgement(ibctransfertypes.ErrReceiveDisabled))
+	assert.Equal(t, types.ComponentStatusFailed, receipt(2).Status)
+	assert.NotEmpty(t, receipt(2).Error)
+
+	// a channel that closes fails the components whose packets are in flight on it
+	require.NoError(t, kpr.OnCloseChannel(ctx, types.IBCChannelCloseMsg{CloseConfirm: &types.IBCCloseConfirm{
+		Channel: types.IBCChannel{Endpoint: types.IBCEndpoint{PortID: ibctransfertypes.PortID, ChannelID: "channel-9"}},
+	}}))
+	assert.Equal(t, types.ComponentStatusFailed, receipt(3).Status)
+	assert.Equal(t, "channel closed", receipt(3).Error)
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).PendingPackets)
+
+	// the retry sends the escrowed coins
+	balance = bank.GetBalance(ctx, creatorAddr, "uwill")
+	ctx = ctx.WithBlockHeight(4)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 4)
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 5)
+	assert.Equal(t, "100uwill", transfers.sent[4].Token.String())
+	assert.Equal(t, balance, bank.GetBalance(ctx, creatorAddr, "uwill"))
+	pending := keeper.ExportGenesis(ctx, kpr).PendingPackets
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint32(1), pending[0].Attempt)
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).PacketRetries)
+
+	// once it is acknowledged the component executed
+	ack(5, channeltypes.NewResultAcknowledgement([]byte{0x01}))
+	assert.Equal(t, types.ComponentStatusExecuted, receipt(0).Status)
+
+	// the creator cannot spend the refund before the retry, a retry that cannot be sent fails
+	// the component and returns the escrowed coins
+	createMsg.Height = 6
+	createMsg.Components = []*types.ExecutionComponent{send("unsent", "channel-7", 40, retry)}
+	unsent, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(6)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 6)
+	timeout(6)
+	require.NoError(t, bank.SendCoins(ctx, creatorAddr, sdk.AccAddress("somewhere-else______"), bank.GetAllBalances(ctx, creatorAddr)))
+	transfers.err = ibctransfertypes.ErrSendDisabled
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, transfers.sent, 6)
+	res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: unsent.ID})
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusFailed, res.Receipts[0].Status)
+	assert.Contains(t, res.Receipts[0].Error, ibctransfertypes.ErrSendDisabled.Error())
+	assert.Equal(t, "40uwill", bank.GetBalance(ctx, creatorAddr, "uwill").String())
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == "will_packet_retry_failed"
+	}
+	assert.True(t, failed)
+}
+
+// onceScheme is a preimageScheme whose pre-images can be revealed only once
+type onceScheme struct{ preimageScheme }
+
+func (onceScheme) Name() string { return "preimage-once" }
+
+func (onceScheme) Nullifier(_ *types.ClaimComponent, msg *types.MsgClaimRequest) ([]byte, error) {
+	return msg.GetCustomClaim().Data, nil
+}
+
+func TestKeeperClaimNullifiers(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	keeper.ApplyOptions(kpr, keeper.WithClaimSchemes(onceScheme{}))
+	creatorAddr := sdk.AccAddress("nullifier-creator___")
+	claimerAddr := sdk.AccAddress("nullifier-claimer___")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 20)))
+
+	hash := sha256.Sum256([]byte("open sesame"))
+	component := func(id string) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+				SchemeType: &types.ClaimComponent_Custom{Custom: &types.CustomClaimScheme{Scheme: "preimage-once", Data: hash[:]}},
+			}},
+			OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+				Address: claimerAddr.String(),
+				Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(10)},
+			}}},
+		}
+	}
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "nullified will",
+		Beneficiary: claimerAddr.String(),
+		Height:      2,
+		Components:  []*types.ExecutionComponent{component("first"), component("second")},
+	})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	claim := func(componentID string) error {
+		return kpr.Claim(ctx, &types.MsgClaimRequest{
+			WillId:      will.ID,
+			Claimer:     claimerAddr.String(),
+			ComponentId: componentID,
+			ClaimType:   &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte("open sesame")}},
+		})
+	}
+	require.NoError(t, claim("first"))
+	require.ErrorIs(t, claim("second"), types.ErrProofUsed)
+	assert.Equal(t, []types.Nullifier{{Scheme: "preimage-once", Nullifier: []byte("open sesame")}}, keeper.ExportGenesis(ctx, kpr).Nullifiers)
+}
+
+// Converts a string to a scalar value using SHA256 hash.
+func stringToScalar(data string) ristretto.Scalar {
+	var scalar ristretto.Scalar
+	hash := sha256.Sum256([]byte(data)) // hash is a [32]byte array
+	scalar.SetBytes(&hash)
+	return scalar
+}
+
+// Test with deterministic scalars derived from strings, bound to the claimer.
+func TestKeeperClaimWithConstantPedersenCommitment(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("creator-address_____")
+	beneficiaryAddr := sdk.AccAddress("pedersen-beneficiary")
+	frontRunnerAddr := sdk.AccAddress("pedersen-frontrunner")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+
+	// the answer is shared with the beneficiary, the commitment binds it to their address
+	answer := stringToScalar("bar")
+	blindingFactor := stringToScalar("foo")
+	boundAnswer := pedersen.BoundValue(&answer, beneficiaryAddr.String())
+	commitment := pedersen.Commit(&blindingFactor, &boundAnswer)
+
+	public := types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}}
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "bound pedersen will",
+		Beneficiary: beneficiaryAddr.String(),
+		Height:      2,
+		Components:  []*types.ExecutionComponent{pedersenComponent("component-id", public, commitment, true, beneficiaryAddr)},
+	})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+
+	// anyone may claim, yet an opening seen in the mempool is no use to another address
+	err = kpr.Claim(ctx, pedersenClaim(will.ID, "component-id", frontRunnerAddr, blindingFactor.Bytes(), answer.Bytes()))
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+	err = kpr.Claim(ctx, pedersenClaim(will.ID, "component-id", frontRunnerAddr, blindingFactor.Bytes(), boundAnswer.Bytes()))
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+
+	require.NoError(t, kpr.Claim(ctx, pedersenClaim(will.ID, "component-id", beneficiaryAddr, blindingFactor.Bytes(), answer.Bytes())))
+	updatedWill, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.ComponentStatusClaimed, updatedWill.Components[0].Status)
 }
 
 ///////////////////////////////////////////
@@ -829,3 +2923,394 @@ ctx
 // 	require.Equal(t, event.Attributes[1].Key, "port_id", "Expected attribute 'port_id'")
 // 	require.Equal(t, event.Attributes[1].Value, portID, "Expected port ID matches")
 // }
+
+func TestKeeperWillPackets(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	keeper.ApplyOptions(kpr, keeper.WithClaimSchemes(preimageScheme{}))
+	creatorAddr := sdk.AccAddress("packet-creator______")
+	heirAddr := sdk.AccAddress("packet-heir_________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+	ctx = ctx.WithBlockHeight(1)
+
+	remote := func(addr sdk.AccAddress) string {
+		bech, err := bech32.ConvertAndEncode("osmo", addr)
+		require.NoError(t, err)
+		return bech
+	}
+	recvOn := func(channel string, data types.WillPacketData) (channeltypes.Acknowledgement, error) {
+		bz, err := data.GetBytes()
+		require.NoError(t, err)
+		ack, err := kpr.OnRecvPacket(ctx, types.IBCPacketReceiveMsg{Packet: types.IBCPacket{
+			Data: bz,
+			Dest: types.IBCEndpoint{PortID: "will", ChannelID: channel},
+		}})
+		if err != nil {
+			return channeltypes.Acknowledgement{}, err
+		}
+		return ack.(channeltypes.Acknowledgement), nil
+	}
+	recv := func(data types.WillPacketData) (channeltypes.Acknowledgement, error) {
+		return recvOn("channel-3", data)
+	}
+
+	// an IBC message component must carry a valid will packet
+	hash := sha256.Sum256([]byte("open sesame"))
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:          creatorAddr.String(),
+		Name:             "remote will",
+		Beneficiary:      heirAddr.String(),
+		InactivityWindow: 10,
+		Components: []*types.ExecutionComponent{{
+			Id: "notify",
+			ComponentType: &types.ExecutionComponent_IbcMsg{IbcMsg: &types.IBCMsgComponent{
+				Channel: "channel-3",
+				Data:    []byte(`{"check_in":{}}`),
+			}},
+		}},
+	}
+	_, err := kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	createMsg.Components = []*types.ExecutionComponent{{
+		Id: "heirloom",
+		ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+			Access:     types.ClaimAccessControl{AccessType: &types.ClaimAccessControl_Public{Public: &types.ClaimAccessPublic{}}},
+			SchemeType: &types.ClaimComponent_Custom{Custom: &types.CustomClaimScheme{Scheme: "preimage", Data: hash[:]}},
+		}},
+		OutputType: &types.ComponentOutput{OutputType: &types.ComponentOutput_OutputTransfer{OutputTransfer: &types.OutputTransfer{
+			Address: heirAddr.String(),
+			Amount:  &sdk.Coin{Denom: "uwill", Amount: math.NewInt(25)},
+		}}},
+	}}
+	// remote channels must be channel identifiers
+	createMsg.RemoteChannels = []string{"not a channel"}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	createMsg.RemoteChannels = []string{"channel-3"}
+	will, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), will.Height)
+	assert.Equal(t, []string{"channel-3"}, will.RemoteChannels)
+
+	// packets that are not will packets are refused
+	_, err = kpr.OnRecvPacket(ctx, types.IBCPacketReceiveMsg{Packet: types.IBCPacket{Data: []byte("not json")}})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// only the creator checks in, from any chain deriving the same address
+	ctx = ctx.WithBlockHeight(5)
+	checkIn := &types.WillPacketData_CheckIn{CheckIn: &types.RemoteCheckInPacket{WillId: will.ID}}
+	_, err = recv(types.WillPacketData{Sender: remote(heirAddr), Packet: checkIn})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	// a chain the creator did not choose cannot check in by claiming to be the creator
+	_, err = recvOn("channel-7", types.WillPacketData{Sender: remote(creatorAddr), Packet: checkIn})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	stored, err := kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), stored.Height)
+	ack, err := recv(types.WillPacketData{Sender: remote(creatorAddr), Packet: checkIn})
+	require.NoError(t, err)
+	assert.True(t, ack.Success())
+	assert.Equal(t, types.ResultAcknowledgement, ack.GetResult())
+	stored, err = kpr.GetWillByID(ctx, will.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), stored.Height)
+
+	// a status query is answered in the acknowledgement
+	status := func() types.WillStatusAck {
+		ack, err := recv(types.WillPacketData{Packet: &types.WillPacketData_StatusQuery{StatusQuery: &types.WillStatusQueryPacket{WillId: will.ID}}})
+		require.NoError(t, err)
+		var res types.WillStatusAck
+		require.NoError(t, willchainApp.AppCodec().UnmarshalJSON(ack.GetResult(), &res))
+		return res
+	}
+	assert.Equal(t, types.WillStatusAck{
+		WillId:     will.ID,
+		Status:     "live",
+		Components: []types.WillComponentStatus{{Id: "heirloom", Status: types.ComponentStatusInactive}},
+	}, status())
+	_, err = recv(types.WillPacketData{Packet: &types.WillPacketData_StatusQuery{StatusQuery: &types.WillStatusQueryPacket{WillId: "unknown"}}})
+	require.ErrorIs(t, err, types.ErrWillNotFound)
+
+	// a triggered notification is emitted for the applications of the chain
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = recv(types.WillPacketData{
+		Sender: remote(creatorAddr),
+		Packet: &types.WillPacketData_Triggered{Triggered: &types.WillTriggeredPacket{WillId: "1", Height: 42}},
+	})
+	require.NoError(t, err)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, "will_remote_triggered", events[0].Type)
+
+	// once the will fires the heir claims from the other chain
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	claimOn := func(channel string, sender sdk.AccAddress, preimage string) error {
+		_, err := recvOn(channel, types.WillPacketData{
+			Sender: remote(sender),
+			Packet: &types.WillPacketData_Claim{Claim: &types.RemoteClaimPacket{Claim: &types.MsgClaimRequest{
+				WillId:      will.ID,
+				ComponentId: "heirloom",
+				Claimer:     heirAddr.String(),
+				ClaimType:   &types.MsgClaimRequest_CustomClaim{CustomClaim: &types.CustomClaim{Data: []byte(preimage)}},
+			}}},
+		})
+		return err
+	}
+	claim := func(sender sdk.AccAddress, preimage string) error {
+		return claimOn("channel-3", sender, preimage)
+	}
+	// a chain the creator did not choose cannot claim by claiming to be the heir
+	require.ErrorIs(t, claimOn("channel-7", heirAddr, "open sesame"), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, claim(creatorAddr, "open sesame"), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, claim(heirAddr, "abracadabra"), types.ErrInvalidProof)
+	require.NoError(t, claim(heirAddr, "open sesame"))
+	assert.Equal(t, types.ComponentStatusClaimed, status().Components[0].Status)
+	assert.Equal(t, sdk.NewInt64Coin("uwill", 25), kpr.GetBankKeeper().GetBalance(ctx, heirAddr, "uwill"))
+}
+
+func TestKeeperSendWillPacket(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	creatorAddr := sdk.AccAddress("send-creator________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+	will, err := kpr.CreateWill(ctx, &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "queried will",
+		Beneficiary: creatorAddr.String(),
+		Height:      10,
+		Components: []*types.ExecutionComponent{{
+			Id: "gift",
+			ComponentType: &types.ExecutionComponent_Transfer{Transfer: &types.TransferComponent{
+				To:     creatorAddr.String(),
+				Amount: &sdk.Coin{Denom: "uwill", Amount: math.NewInt(10)},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	send := func(sender, channel, data string) error {
+		_, err := kpr.SendWillPacket(ctx, &types.MsgSendWillPacketRequest{Sender: sender, Channel: channel, Data: []byte(data)})
+		return err
+	}
+	query := `{"status_query":{"will_id":"` + will.ID + `"}}`
+	require.ErrorIs(t, send("not an address", "channel-3", query), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, send(creatorAddr.String(), "not a channel", query), types.ErrInvalid)
+	require.ErrorIs(t, send(creatorAddr.String(), "channel-3", `{"check_in":{}}`), types.ErrInvalid)
+	// only a will that fires tells other chains it did
+	require.ErrorIs(t, send(creatorAddr.String(), "channel-3", `{"triggered":{"will_id":"`+will.ID+`"}}`), types.ErrInvalid)
+	// a valid packet is sent over the channel, which the will port must own
+	require.ErrorIs(t, send(creatorAddr.String(), "channel-3", query), channeltypes.ErrChannelCapabilityNotFound)
+
+	// the answer to a status query is emitted once the other chain acknowledges it
+	data, err := types.DecodeWillPacketData([]byte(query))
+	require.NoError(t, err)
+	bz, err := data.GetBytes()
+	require.NoError(t, err)
+	ack, err := kpr.OnRecvPacket(ctx, types.IBCPacketReceiveMsg{Packet: types.IBCPacket{Data: bz}})
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.OnAckPacket(ctx, types.IBCPacketAckMsg{
+		Acknowledgement: types.IBCAcknowledgement{Data: ack.Acknowledgement()},
+		OriginalPacket:  types.IBCPacket{Data: bz, Src: types.IBCEndpoint{PortID: "will", ChannelID: "channel-3"}, Sequence: 4},
+	}))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, "will_status_ack", events[0].Type)
+	attrs := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	assert.Equal(t, map[string]string{
+		"channel":    "channel-3",
+		"sequence":   "4",
+		"will_id":    will.ID,
+		"status":     types.WillStatusLive,
+		"components": "gift=" + types.ComponentStatusInactive,
+	}, attrs)
+}
+
+type mockICAKeeper struct {
+	registered []string
+	channels   map[string]string
+	sent       []icatypes.InterchainAccountPacketData
+	err        error
+}
+
+func (m *mockICAKeeper) RegisterInterchainAccount(_ sdk.Context, connectionID, owner, _ string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.registered = append(m.registered, connectionID+"/"+owner)
+	return nil
+}
+
+func (m *mockICAKeeper) GetOpenActiveChannel(_ sdk.Context, connectionID, portID string) (string, bool) {
+	channel, ok := m.channels[connectionID+"/"+portID]
+	return channel, ok
+}
+
+func (m *mockICAKeeper) SendTx(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, data icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	m.sent = append(m.sent, data)
+	return uint64(len(m.sent)), nil
+}
+
+func TestKeeperICA(t *testing.T) {
+	kpr, ctx, willchainApp := setupAppKeeper(t)
+	icaKeeper := &mockICAKeeper{channels: map[string]string{}}
+	keeper.SetICAControllerKeeper(kpr, icaKeeper)
+	authModule := will.NewICAAuthModule(*kpr)
+	creatorAddr := sdk.AccAddress("ica-creator_________")
+	setupWithFundedAccount(t, willchainApp, ctx, kpr, creatorAddr, sdk.NewCoins(sdk.NewInt64Coin("uwill", 100)))
+	owner := keeper.ICAOwner(creatorAddr.String())
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: "cosmos1ica", ToAddress: "cosmos1heir", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))})
+	require.NoError(t, err)
+	remote := &codectypes.Any{TypeUrl: "/osmosis.lockup.MsgBeginUnlockingAll", Value: []byte{0x0a, 0x01, 0x61}}
+	encode := func(msgs ...*codectypes.Any) [][]byte {
+		var bzs [][]byte
+		for _, msg := range msgs {
+			bz, err := msg.Marshal()
+			require.NoError(t, err)
+			bzs = append(bzs, bz)
+		}
+		return bzs
+	}
+	ica := func(id, connection string, msgs [][]byte) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id:            id,
+			ComponentType: &types.ExecutionComponent_Ica{Ica: &types.ICAComponent{ConnectionId: connection, Msgs: msgs, Memo: "estate"}},
+		}
+	}
+	createMsg := &types.MsgCreateWillRequest{
+		Creator:     creatorAddr.String(),
+		Name:        "remote estate",
+		Beneficiary: creatorAddr.String(),
+		Height:      2,
+	}
+	for name, component := range map[string]*types.ExecutionComponent{
+		"bad connection":   ica("invalid", "not a connection!", encode(send)),
+		"no messages":      ica("invalid", "connection-0", nil),
+		"not an Any":       ica("invalid", "connection-0", [][]byte{{0xff}}),
+		"without type URL": ica("invalid", "connection-0", encode(&codectypes.Any{Value: []byte{0x01}})),
+	} {
+		createMsg.Components = []*types.ExecutionComponent{component}
+		_, err := kpr.CreateWill(ctx, createMsg)
+		require.ErrorIs(t, err, types.ErrInvalid, name)
+	}
+
+	// the interchain account is registered once per connection when the will is created
+	createMsg.Components = []*types.ExecutionComponent{
+		ica("unbond", "connection-0", encode(send, remote)),
+		ica("vote", "connection-0", encode(send)),
+		ica("elsewhere", "connection-1", encode(send)),
+	}
+	estate, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"connection-0/" + owner, "connection-1/" + owner}, icaKeeper.registered)
+
+	// an account with an open channel is reused
+	icaKeeper.channels["connection-0/"+portID] = "channel-4"
+	createMsg.Name, createMsg.Height = "reused", 3
+	createMsg.Components = []*types.ExecutionComponent{ica("again", "connection-0", encode(send))}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	assert.Len(t, icaKeeper.registered, 2)
+
+	// a failed registration fails the creation
+	icaKeeper.err = icatypes.ErrActiveChannelAlreadySet
+	createMsg.Name = "unregistered"
+	createMsg.Components = []*types.ExecutionComponent{ica("again", "connection-2", encode(send))}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.ErrorIs(t, err, icatypes.ErrActiveChannelAlreadySet)
+
+	// the messages are sent as one transaction of the interchain account when the will fires
+	icaKeeper.err = nil
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	require.Len(t, icaKeeper.sent, 2)
+	sent := icaKeeper.sent[0]
+	assert.Equal(t, icatypes.EXECUTE_TX, sent.Type)
+	assert.Equal(t, "estate", sent.Memo)
+	var tx icatypes.CosmosTx
+	require.NoError(t, tx.Unmarshal(sent.Data))
+	require.Len(t, tx.Messages, 2)
+	for i, msg := range []*codectypes.Any{send, remote} {
+		assert.Equal(t, msg.TypeUrl, tx.Messages[i].TypeUrl)
+		assert.Equal(t, msg.Value, tx.Messages[i].Value)
+	}
+
+	querier := keeper.NewGrpcQuerier(kpr)
+	receipts := func() []string {
+		res, err := querier.WillExecutionReceipts(ctx, &types.QueryWillExecutionReceiptsRequest{WillId: estate.ID})
+		require.NoError(t, err)
+		var statuses []string
+		for _, receipt := range res.Receipts {
+			statuses = append(statuses, receipt.Status)
+		}
+		return statuses
+	}
+	// the account on the other connection has no open channel yet, so it is registered again
+	assert.Equal(t, []string{types.ComponentStatusPending, types.ComponentStatusPending, types.ComponentStatusFailed}, receipts())
+	assert.Equal(t, []string{"connection-0/" + owner, "connection-1/" + owner, "connection-1/" + owner}, icaKeeper.registered)
+
+	// the acknowledgement of the transaction settles its component
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourcePort: portID, SourceChannel: "channel-4", Sequence: sequence}
+	}
+	ack := channeltypes.NewResultAcknowledgement([]byte{0x01})
+	require.NoError(t, authModule.OnAcknowledgementPacket(ctx, packet(1), ack.Acknowledgement(), nil))
+	require.NoError(t, authModule.OnTimeoutPacket(ctx, packet(2), nil))
+	assert.Equal(t, []string{types.ComponentStatusExecuted, types.ComponentStatusFailed, types.ComponentStatusFailed}, receipts())
+	stored, err := kpr.GetWillByID(ctx, estate.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.ComponentStatusExecuted, stored.Components[0].Status)
+	assert.Empty(t, keeper.ExportGenesis(ctx, kpr).PendingPackets)
+
+	// acknowledgements the module cannot settle are left to the controller
+	require.NoError(t, authModule.OnAcknowledgementPacket(ctx, packet(9), []byte("not an acknowledgement"), nil))
+	require.NoError(t, authModule.OnTimeoutPacket(ctx, packet(9), nil))
+
+	// the timeout closed the ordered channel, the account is registered again when the next will
+	// needing it fires
+	delete(icaKeeper.channels, "connection-0/"+portID)
+	registered := len(icaKeeper.registered)
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	assert.Len(t, icaKeeper.sent, 2)
+	assert.Contains(t, icaKeeper.registered[registered:], "connection-0/"+owner)
+
+	// an update moving a component to another connection registers the account there
+	createMsg.Name, createMsg.Height = "moved", 12
+	createMsg.Components = []*types.ExecutionComponent{ica("moved", "connection-1", encode(send))}
+	moved, err := kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	_, err = kpr.UpdateWill(ctx, &types.MsgUpdateWillRequest{
+		Creator:    creatorAddr.String(),
+		Id:         moved.ID,
+		Components: []*types.ExecutionComponent{ica("moved", "connection-3", encode(send))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "connection-3/"+owner, icaKeeper.registered[len(icaKeeper.registered)-1])
+
+	// an atomic will drops what its components did when one fails, not the new registration
+	createMsg.Name, createMsg.Height = "atomic", 11
+	createMsg.ExecutionMode = types.ExecutionMode_EXECUTION_MODE_ATOMIC
+	createMsg.Components = []*types.ExecutionComponent{ica("first", "connection-4", encode(send)), ica("second", "connection-4", encode(send))}
+	_, err = kpr.CreateWill(ctx, createMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, kpr.BeginBlocker(ctx))
+	var reopened []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "will_ica_registered" {
+			for _, attr := range event.Attributes {
+				if attr.Key == "connection_id" {
+					reopened = append(reopened, attr.Value)
+				}
+			}
+		}
+	}
+	assert.Equal(t, []string{"connection-4"}, reopened)
+}
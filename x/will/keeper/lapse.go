@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// validateClaimWindow checks the claim window and fallback output of a claim component
+func validateClaimWindow(claim *types.ClaimComponent) error {
+	if claim.LapseHeight != 0 || claim.LapseTime != nil {
+		return errors.Wrap(types.ErrInvalid, "the lapse height and time of a claim window are set when the will fires")
+	}
+	if claim.Window == nil {
+		if claim.Fallback != nil {
+			return errors.Wrap(types.ErrInvalid, "a fallback output needs a claim window")
+		}
+		return nil
+	}
+	if claim.Window.Blocks < 0 || claim.Window.Duration < 0 {
+		return errors.Wrap(types.ErrInvalid, "claim window must not be negative")
+	}
+	if (claim.Window.Blocks == 0) == (claim.Window.Duration == 0) {
+		return errors.Wrap(types.ErrInvalid, "claim window must be measured in either blocks or time")
+	}
+	if claim.Fallback == nil || claim.Fallback.OutputType == nil {
+		return errors.Wrap(types.ErrInvalid, "a claim window needs a fallback output")
+	}
+	return nil
+}
+
+// openClaimWindow sets when the claim window of a component that starts to accept claims
+// closes, and queues the component to lapse then
+func (k Keeper) openClaimWindow(ctx sdk.Context, willID string, component *types.ExecutionComponent) error {
+	claim := component.GetClaim()
+	if claim == nil || claim.Window == nil {
+		return nil
+	}
+	if claim.Window.Blocks > 0 {
+		claim.LapseHeight = ctx.BlockHeight() + claim.Window.Blocks
+	} else {
+		lapseTime := ctx.BlockTime().Add(claim.Window.Duration)
+		claim.LapseTime = &lapseTime
+	}
+	return k.queueLapse(ctx, willID, component)
+}
+
+// queueLapse adds a claim component with an open claim window to the queue it lapses from
+func (k Keeper) queueLapse(ctx context.Context, willID string, component *types.ExecutionComponent) error {
+	claim := component.GetClaim()
+	switch {
+	case claim == nil:
+		return nil
+	case claim.LapseHeight > 0:
+		return k.lapsesByHeight.Set(ctx, collections.Join3(claim.LapseHeight, willID, component.Id))
+	case claim.LapseTime != nil:
+		return k.lapsesByTime.Set(ctx, collections.Join3(*claim.LapseTime, willID, component.Id))
+	}
+	return nil
+}
+
+// dequeueLapse removes a claim component from the queue it lapses from once it is claimed
+func (k Keeper) dequeueLapse(ctx context.Context, willID string, component *types.ExecutionComponent) error {
+	claim := component.GetClaim()
+	switch {
+	case claim == nil:
+		return nil
+	case claim.LapseHeight > 0:
+		return k.lapsesByHeight.Remove(ctx, collections.Join3(claim.LapseHeight, willID, component.Id))
+	case claim.LapseTime != nil:
+		return k.lapsesByTime.Remove(ctx, collections.Join3(*claim.LapseTime, willID, component.Id))
+	}
+	return nil
+}
+
+// lapseClaims lapses the claim components whose claim window closed by the current block
+func (k Keeper) lapseClaims(ctx sdk.Context) error {
+	type lapse struct{ willID, componentID string }
+	var lapses []lapse
+	var byHeight []collections.Triple[int64, string, string]
+	if err := k.lapsesByHeight.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](ctx.BlockHeight()), func(key collections.Triple[int64, string, string]) (bool, error) {
+		byHeight = append(byHeight, key)
+		lapses = append(lapses, lapse{key.K2(), key.K3()})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	var byTime []collections.Triple[time.Time, string, string]
+	if err := k.lapsesByTime.Walk(ctx, collections.NewPrefixUntilTripleRange[time.Time, string, string](ctx.BlockTime()), func(key collections.Triple[time.Time, string, string]) (bool, error) {
+		byTime = append(byTime, key)
+		lapses = append(lapses, lapse{key.K2(), key.K3()})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, key := range byHeight {
+		if err := k.lapsesByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, key := range byTime {
+		if err := k.lapsesByTime.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, l := range lapses {
+		if err := k.lapseClaim(ctx, l.willID, l.componentID); err != nil {
+			ctx.Logger().Error("will component lapse failed", "will_id", l.willID, "component_id", l.componentID, "err", err)
+		}
+	}
+	return nil
+}
+
+// lapseClaim moves an unclaimed claim component to lapsed and runs its fallback output. When the
+// fallback cannot be paid, its escrow is returned to the creator.
+func (k Keeper) lapseClaim(ctx sdk.Context, willID, componentID string) error {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil {
+		return err
+	}
+	for _, component := range will.Components {
+		if component.Id != componentID {
+			continue
+		}
+		claim := component.GetClaim()
+		if claim == nil || component.Status != types.ComponentStatusActive {
+			return nil
+		}
+		component.Status = types.ComponentStatusLapsed
+		if err := k.clearApprovals(ctx, will.ID, component.Id); err != nil {
+			return err
+		}
+		if err := k.setWill(ctx, will); err != nil {
+			return err
+		}
+
+		// a failed fallback leaves no partial effects
+		fallback := *component
+		fallback.OutputType = claim.Fallback
+		fallbackCtx, write := ctx.CacheContext()
+		ran := true
+		if err := k.OutputHandler(fallbackCtx, &fallback, *will); err != nil {
+			ctx.Logger().Error("will fallback output failed", "will_id", will.ID, "component_id", component.Id, "err", err)
+			ran = false
+		} else {
+			write()
+		}
+		// the lapsed component pays nothing more, what it escrowed beyond its fallback or instead
+		// of a failed one goes back to the creator
+		k.refundSurplus(ctx, will.ID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("will_component_lapsed",
+				sdk.NewAttribute("will_id", will.ID),
+				sdk.NewAttribute("component_id", component.Id),
+				sdk.NewAttribute("fallback_executed", strconv.FormatBool(ran)),
+			),
+		)
+		return nil
+	}
+	return nil
+}
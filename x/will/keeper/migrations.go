@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/exported"
+	v2 "github.com/CosmWasm/wasmd/x/will/migrations/v2"
+	v3 "github.com/CosmWasm/wasmd/x/will/migrations/v3"
+	v4 "github.com/CosmWasm/wasmd/x/will/migrations/v4"
+	v5 "github.com/CosmWasm/wasmd/x/will/migrations/v5"
+	v6 "github.com/CosmWasm/wasmd/x/will/migrations/v6"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 migrates the x/will module state from the consensus
+// version 1 to version 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.legacySubspace, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the x/will module state from the consensus
+// version 2 to version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.wills, m.keeper.escrows)
+}
+
+// Migrate3to4 migrates the x/will module state from the consensus
+// version 3 to version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.wills)
+}
+
+// Migrate4to5 migrates the x/will module state from the consensus
+// version 4 to version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.wills, m.keeper.params)
+}
+
+// Migrate5to6 migrates the x/will module state from the consensus
+// version 5 to version 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService)
+}
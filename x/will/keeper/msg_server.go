@@ -41,9 +41,9 @@ func (m msgServer) CreateWill(
 			Name:        will.Name,
 			Beneficiary: will.Beneficiary,
 			Height:      will.Height,
+			TriggerTime: will.TriggerTime,
 		}, nil
 	}
-
 }
 
 func (m msgServer) Claim(ctx context.Context, msg *types.MsgClaimRequest) (*types.MsgClaimResponse, error) {
@@ -62,10 +62,108 @@ func (m msgServer) CheckIn(
 	ctx context.Context,
 	msg *types.MsgCheckInRequest,
 ) (*types.MsgCheckInResponse, error) {
-	return &types.MsgCheckInResponse{}, nil
+	will, err := m.keeper.CheckIn(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon checking in to will")
+	}
+	return &types.MsgCheckInResponse{
+		Status:      true,
+		Height:      will.Height,
+		TriggerTime: will.TriggerTime,
+	}, nil
+}
+
+func (m msgServer) FundWill(
+	ctx context.Context,
+	msg *types.MsgFundWillRequest,
+) (*types.MsgFundWillResponse, error) {
+	escrow, err := m.keeper.FundWill(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon funding will")
+	}
+	return &types.MsgFundWillResponse{
+		Escrow: escrow.Coins,
+	}, nil
+}
+
+func (m msgServer) UpdateWill(
+	ctx context.Context,
+	msg *types.MsgUpdateWillRequest,
+) (*types.MsgUpdateWillResponse, error) {
+	will, err := m.keeper.UpdateWill(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon updating will")
+	}
+	return &types.MsgUpdateWillResponse{
+		Id:          will.ID,
+		Beneficiary: will.Beneficiary,
+		Height:      will.Height,
+		TriggerTime: will.TriggerTime,
+	}, nil
+}
+
+func (m msgServer) CancelWill(
+	ctx context.Context,
+	msg *types.MsgCancelWillRequest,
+) (*types.MsgCancelWillResponse, error) {
+	refund, err := m.keeper.CancelWill(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon cancelling will")
+	}
+	return &types.MsgCancelWillResponse{
+		Refund: refund,
+	}, nil
+}
+
+func (m msgServer) WithdrawVested(
+	ctx context.Context,
+	msg *types.MsgWithdrawVestedRequest,
+) (*types.MsgWithdrawVestedResponse, error) {
+	amount, err := m.keeper.WithdrawVested(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon withdrawing vested coins")
+	}
+	return &types.MsgWithdrawVestedResponse{
+		Amount: amount,
+	}, nil
+}
+
+func (m msgServer) ExecuteWill(
+	ctx context.Context,
+	msg *types.MsgExecuteWillRequest,
+) (*types.MsgExecuteWillResponse, error) {
+	bounty, err := m.keeper.ExecuteWill(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon executing will")
+	}
+	return &types.MsgExecuteWillResponse{
+		Bounty: bounty,
+	}, nil
+}
+
+func (m msgServer) SendWillPacket(
+	ctx context.Context,
+	msg *types.MsgSendWillPacketRequest,
+) (*types.MsgSendWillPacketResponse, error) {
+	sequence, err := m.keeper.SendWillPacket(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "upon sending will packet")
+	}
+	return &types.MsgSendWillPacketResponse{
+		Sequence: sequence,
+	}, nil
 }
 
 // UpdateParams updates the module parameters
 func (m msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
+		return nil, errors.Wrap(err, "upon updating params")
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
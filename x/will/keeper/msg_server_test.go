@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
@@ -38,6 +40,60 @@ func (mk *MockKeeper) Claim(ctx context.Context, msg *types.MsgClaimRequest) err
 	return args.Error(0)
 }
 
+// CheckIn mocks the CheckIn method in the IKeeper interface
+func (m *MockKeeper) CheckIn(ctx context.Context, msg *types.MsgCheckInRequest) (*types.Will, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(*types.Will), args.Error(1)
+}
+
+// FundWill mocks the FundWill method in the IKeeper interface
+func (m *MockKeeper) FundWill(ctx context.Context, msg *types.MsgFundWillRequest) (types.WillEscrow, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(types.WillEscrow), args.Error(1)
+}
+
+// UpdateWill mocks the UpdateWill method in the IKeeper interface
+func (m *MockKeeper) UpdateWill(ctx context.Context, msg *types.MsgUpdateWillRequest) (*types.Will, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(*types.Will), args.Error(1)
+}
+
+// CancelWill mocks the CancelWill method in the IKeeper interface
+func (m *MockKeeper) CancelWill(ctx context.Context, msg *types.MsgCancelWillRequest) (sdk.Coins, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(sdk.Coins), args.Error(1)
+}
+
+// WithdrawVested mocks the WithdrawVested method in the IKeeper interface
+func (m *MockKeeper) WithdrawVested(ctx context.Context, msg *types.MsgWithdrawVestedRequest) (sdk.Coins, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(sdk.Coins), args.Error(1)
+}
+
+// ExecuteWill mocks the ExecuteWill method in the IKeeper interface
+func (m *MockKeeper) ExecuteWill(ctx context.Context, msg *types.MsgExecuteWillRequest) (sdk.Coins, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(sdk.Coins), args.Error(1)
+}
+
+// SendWillPacket mocks the SendWillPacket method in the IKeeper interface
+func (m *MockKeeper) SendWillPacket(ctx context.Context, msg *types.MsgSendWillPacketRequest) (uint64, error) {
+	args := m.Called(ctx, msg)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+// GetAuthority mocks the GetAuthority method in the IKeeper interface
+func (m *MockKeeper) GetAuthority() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// SetParams mocks the SetParams method in the IKeeper interface
+func (m *MockKeeper) SetParams(ctx context.Context, ps types.Params) error {
+	args := m.Called(ctx, ps)
+	return args.Error(0)
+}
+
 func TestCreateWill(t *testing.T) {
 	// Context for the tests
 	ctx := context.TODO()
@@ -86,3 +142,20 @@ func TestCreateWill(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateParams(t *testing.T) {
+	ctx := context.TODO()
+	mockKeeper := new(MockKeeper)
+	msgServer := NewMsgServerImpl(mockKeeper)
+
+	mockKeeper.On("GetAuthority").Return("gov-authority")
+	mockKeeper.On("SetParams", mock.Anything, types.DefaultParams()).Return(nil).Once()
+
+	// only the authority can update params
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "someone-else", Params: types.DefaultParams()})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "gov-authority", Params: types.DefaultParams()})
+	require.NoError(t, err)
+	mockKeeper.AssertExpectations(t)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+type optsFn func(*Keeper)
+
+func (f optsFn) apply(keeper *Keeper) {
+	f(keeper)
+}
+
+// Option is an optional constructor parameter of the will keeper
+type Option interface {
+	apply(*Keeper)
+}
+
+// WithClaimSchemes is an optional constructor parameter to register claim schemes with the keeper.
+// A scheme replaces any scheme registered before it under the same name.
+func WithClaimSchemes(schemes ...ClaimScheme) Option {
+	return optsFn(func(k *Keeper) {
+		for _, scheme := range schemes {
+			k.claimSchemes[scheme.Name()] = scheme
+		}
+	})
+}
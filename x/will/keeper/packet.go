@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// handleWillPacket runs a will packet received on a channel of the will port and returns the
+// result of its acknowledgement
+func (k Keeper) handleWillPacket(ctx sdk.Context, channelID string, data types.WillPacketData) ([]byte, error) {
+	switch packet := data.Packet.(type) {
+	case *types.WillPacketData_CheckIn:
+		return types.ResultAcknowledgement, k.remoteCheckIn(ctx, channelID, data.Sender, packet.CheckIn)
+	case *types.WillPacketData_Claim:
+		return types.ResultAcknowledgement, k.remoteClaim(ctx, channelID, data.Sender, packet.Claim)
+	case *types.WillPacketData_StatusQuery:
+		return k.willStatusAck(ctx, packet.StatusQuery)
+	case *types.WillPacketData_Triggered:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("will_remote_triggered",
+				sdk.NewAttribute("channel", channelID),
+				sdk.NewAttribute("sender", data.Sender),
+				sdk.NewAttribute("will_id", packet.Triggered.WillId),
+				sdk.NewAttribute("height", strconv.FormatInt(packet.Triggered.Height, 10)),
+			),
+		)
+		return types.ResultAcknowledgement, nil
+	default:
+		return nil, errors.Wrapf(types.ErrInvalid, "unknown will packet %T", data.Packet)
+	}
+}
+
+// remoteCheckIn checks in on a will for its creator, who sent the packet from another chain
+// over a channel the will trusts
+func (k Keeper) remoteCheckIn(ctx sdk.Context, channelID, sender string, packet *types.RemoteCheckInPacket) error {
+	will, err := k.GetWillByID(ctx, packet.WillId)
+	if err != nil {
+		return err
+	}
+	if err := remoteChannel(will, channelID); err != nil {
+		return errors.Wrapf(err, "check-in on will %s", will.ID)
+	}
+	if err := sameAccount(sender, will.Creator); err != nil {
+		return errors.Wrapf(err, "check-in on will %s", will.ID)
+	}
+	_, err = k.CheckIn(ctx, &types.MsgCheckInRequest{Id: will.ID, Creator: will.Creator})
+	return err
+}
+
+// remoteClaim submits a claim for the claimer, who sent the packet from another chain over a
+// channel the will trusts
+func (k Keeper) remoteClaim(ctx sdk.Context, channelID, sender string, packet *types.RemoteClaimPacket) error {
+	will, err := k.GetWillByID(ctx, packet.Claim.WillId)
+	if err != nil {
+		return err
+	}
+	if err := remoteChannel(will, channelID); err != nil {
+		return errors.Wrapf(err, "claim on will %s", will.ID)
+	}
+	if err := sameAccount(sender, packet.Claim.Claimer); err != nil {
+		return errors.Wrapf(err, "claim on will %s", packet.Claim.WillId)
+	}
+	return k.Claim(ctx, packet.Claim)
+}
+
+// willStatusAck answers a status query with the status of a will and of its components
+func (k Keeper) willStatusAck(ctx sdk.Context, packet *types.WillStatusQueryPacket) ([]byte, error) {
+	will, err := k.GetWillByID(ctx, packet.WillId)
+	if err != nil {
+		return nil, err
+	}
+	ack := types.WillStatusAck{WillId: will.ID, Status: will.Status}
+	for _, component := range will.Components {
+		status := component.Status
+		if status == "" {
+			// components created without a status have not run yet
+			status = types.ComponentStatusInactive
+		}
+		ack.Components = append(ack.Components, types.WillComponentStatus{Id: component.Id, Status: status})
+	}
+	return k.cdc.MarshalJSON(&ack)
+}
+
+/*
+@name SendWillPacket
+@desc sends a check-in, claim or status query to the will module of another chain over a
+channel of the will port. The packet acts for the sender, the other chain only accepts a
+check-in or claim from a channel the will it acts on lists as one of its remote channels.
+@param msg MsgSendWillPacketRequest signed by the sender
+*/
+func (k Keeper) SendWillPacket(ctx context.Context, msg *types.MsgSendWillPacketRequest) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return 0, errors.Wrapf(sdkerrors.ErrInvalidAddress, "sender %s: %s", msg.Sender, err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.Channel); err != nil {
+		return 0, errors.Wrapf(types.ErrInvalid, "will packet channel: %s", err)
+	}
+	data, err := types.DecodeWillPacketData(msg.Data)
+	if err != nil {
+		return 0, err
+	}
+	if data.GetTriggered() != nil {
+		return 0, errors.Wrap(types.ErrInvalid, "will triggered packets are only sent by the wills that fire")
+	}
+	data.Sender = msg.Sender
+	bz, err := data.GetBytes()
+	if err != nil {
+		return 0, err
+	}
+	sequence, err := k.sendPacket(sdkCtx, msg.Channel, bz)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("will_packet_sent",
+			sdk.NewAttribute("sender", msg.Sender),
+			sdk.NewAttribute("channel", msg.Channel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+		),
+	)
+	return sequence, nil
+}
+
+// emitStatusAck emits the answer to a status query the other chain acknowledged, so clients
+// follow the will they asked about from its events
+func (k Keeper) emitStatusAck(ctx sdk.Context, packet types.IBCPacket, ack channeltypes.Acknowledgement) {
+	data, err := types.DecodeWillPacketData(packet.Data)
+	if err != nil || data.GetStatusQuery() == nil || !ack.Success() {
+		return
+	}
+	var status types.WillStatusAck
+	if err := k.cdc.UnmarshalJSON(ack.GetResult(), &status); err != nil {
+		ctx.Logger().Error("will status acknowledgement cannot be decoded", "channel", packet.Src.ChannelID, "sequence", packet.Sequence, "err", err)
+		return
+	}
+	components := make([]string, len(status.Components))
+	for i, component := range status.Components {
+		components[i] = component.Id + "=" + component.Status
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_status_ack",
+			sdk.NewAttribute("channel", packet.Src.ChannelID),
+			sdk.NewAttribute("sequence", strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute("will_id", status.WillId),
+			sdk.NewAttribute("status", status.Status),
+			sdk.NewAttribute("components", strings.Join(components, ",")),
+		),
+	)
+}
+
+// remoteChannel checks that the creator of a will trusts the channel a packet acting on it came
+// in on. The sender of a packet is set by the chain on the other end, so it only identifies an
+// account when that chain is one the creator chose.
+func remoteChannel(will *types.Will, channelID string) error {
+	if !slices.Contains(will.RemoteChannels, channelID) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "channel %s is not a remote channel of the will", channelID)
+	}
+	return nil
+}
+
+// sameAccount checks that the sender of a packet, an address of another chain, has the same bytes
+// as a local address. They do when both chains derive addresses from the same key the same way.
+func sameAccount(sender, local string) error {
+	_, senderBz, err := bech32.DecodeAndConvert(sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "packet sender %q: %s", sender, err)
+	}
+	localAddr, err := sdk.AccAddressFromBech32(local)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "%s: %s", local, err)
+	}
+	if !bytes.Equal(senderBz, localAddr) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "packet sender %s is not %s", sender, local)
+	}
+	return nil
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
@@ -66,21 +71,182 @@ func queryListWills(ctx context.Context, keeper *Keeper, req *types.QueryListWil
 	}, nil
 }
 
+// ListWills returns the wills created by an address
 func (q queryServer) ListWills(ctx context.Context, req *types.QueryListWillsRequest) (*types.QueryListWillsResponse, error) {
-	fmt.Println("LISTING WILLS, address:")
-	fmt.Println(req.Address)
-	wills, err := q.keeper.ListWillsByAddress(ctx, req.Address)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	wills, pageRes, err := query.CollectionPaginate(ctx, q.keeper.wills.Indexes.Creator, req.Pagination,
+		q.willByIndexKey(ctx),
+		query.WithCollectionPaginationPairPrefix[string, string](req.Address),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryListWillsResponse{Wills: wills, Pagination: pageRes}, nil
+}
+
+// AllWills returns the wills of all accounts, optionally filtered by status and trigger height range
+func (q queryServer) AllWills(ctx context.Context, req *types.QueryAllWillsRequest) (*types.QueryAllWillsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 || (req.MaxHeight != 0 && req.MaxHeight < req.MinHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.MinHeight, req.MaxHeight)
+	}
+	inHeightRange := func(height int64) bool {
+		return height >= req.MinHeight && (req.MaxHeight == 0 || height <= req.MaxHeight)
+	}
+
+	var (
+		wills   []types.Will
+		pageRes *query.PageResponse
+		err     error
+	)
+	switch {
+	case req.Status != "":
+		switch req.Status {
+		case types.WillStatusLive, types.WillStatusQueued, types.WillStatusExpired, types.WillStatusCancelled:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown status %q", req.Status)
+		}
+		wills, pageRes, err = query.CollectionFilteredPaginate(ctx, q.keeper.wills.Indexes.Status, req.Pagination,
+			func(key collections.Pair[string, string], _ collections.NoValue) (bool, error) {
+				if req.MinHeight == 0 && req.MaxHeight == 0 {
+					return true, nil
+				}
+				will, err := q.keeper.wills.Get(ctx, key.K2())
+				if err != nil {
+					return false, err
+				}
+				return inHeightRange(will.Height), nil
+			},
+			q.willByIndexKey(ctx),
+			query.WithCollectionPaginationPairPrefix[string, string](req.Status),
+		)
+	case req.MinHeight != 0 || req.MaxHeight != 0:
+		wills, pageRes, err = query.CollectionFilteredPaginate(ctx, q.keeper.wills.Indexes.Height, req.Pagination,
+			func(key collections.Pair[int64, string], _ collections.NoValue) (bool, error) {
+				return inHeightRange(key.K1()), nil
+			},
+			func(key collections.Pair[int64, string], _ collections.NoValue) (types.Will, error) {
+				return q.keeper.wills.Get(ctx, key.K2())
+			},
+		)
+	default:
+		wills, pageRes, err = query.CollectionPaginate(ctx, q.keeper.wills, req.Pagination,
+			func(_ string, will types.Will) (types.Will, error) {
+				return will, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllWillsResponse{Wills: wills, Pagination: pageRes}, nil
+}
+
+// willByIndexKey returns a pagination transform that loads the will an address or status index key points at
+func (q queryServer) willByIndexKey(ctx context.Context) func(key collections.Pair[string, string], _ collections.NoValue) (types.Will, error) {
+	return func(key collections.Pair[string, string], _ collections.NoValue) (types.Will, error) {
+		return q.keeper.wills.Get(ctx, key.K2())
+	}
+}
+
+// WillEscrow returns the assets held in escrow for a will
+func (q queryServer) WillEscrow(ctx context.Context, req *types.QueryWillEscrowRequest) (*types.QueryWillEscrowResponse, error) {
+	if _, err := q.keeper.GetWillByID(ctx, req.WillId); err != nil {
+		return nil, err
+	}
+	escrow, err := q.keeper.GetEscrow(ctx, req.WillId)
 	if err != nil {
 		return nil, err
 	}
+	return &types.QueryWillEscrowResponse{Escrow: escrow}, nil
+}
 
-	// Prepare the slice of Will for the protobuf response
-	willsProto := make([]types.Will, len(wills))
-	for i, will := range wills {
-		willsProto[i] = *will // Dereference the pointer to match the protobuf expectation
+// Params returns the module parameters
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(ctx)}, nil
+}
+
+// WillsByBeneficiary returns the wills naming an address as beneficiary
+func (q queryServer) WillsByBeneficiary(ctx context.Context, req *types.QueryWillsByBeneficiaryRequest) (*types.QueryWillsByBeneficiaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	wills, pageRes, err := query.CollectionPaginate(ctx, q.keeper.wills.Indexes.Beneficiary, req.Pagination,
+		q.willByIndexKey(ctx),
+		query.WithCollectionPaginationPairPrefix[string, string](req.Address),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryWillsByBeneficiaryResponse{Wills: wills, Pagination: pageRes}, nil
+}
 
-	return &types.QueryListWillsResponse{
-		Wills: willsProto, // This now matches the protobuf definition
-	}, nil
+// ClaimableComponents returns the active claim components an address may claim
+func (q queryServer) ClaimableComponents(ctx context.Context, req *types.QueryClaimableComponentsRequest) (*types.QueryClaimableComponentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	components, pageRes, err := q.keeper.ClaimableComponents(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryClaimableComponentsResponse{Components: components, Pagination: pageRes}, nil
+}
+
+// VestingStatus returns the vested and remaining coins of a vesting component
+func (q queryServer) VestingStatus(ctx context.Context, req *types.QueryVestingStatusRequest) (*types.QueryVestingStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	will, component, err := q.keeper.vestingComponent(ctx, req.WillId, req.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+	vesting := component.GetVesting()
+	res := &types.QueryVestingStatusResponse{
+		Vested:       sdk.NewCoins(),
+		Withdrawn:    vesting.Withdrawn,
+		Withdrawable: sdk.NewCoins(),
+		Remaining:    vesting.Amount,
+	}
+	// the schedule starts when the will fires
+	if will.Status != types.WillStatusExpired {
+		return res, nil
+	}
+	res.Vested = VestedCoins(vesting, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	res.Withdrawable = res.Vested.Sub(vesting.Withdrawn...)
+	res.Remaining = vesting.Amount.Sub(res.Vested...)
+	res.StartHeight = vesting.StartHeight
+	res.EndHeight = VestingEndHeight(vesting)
+	return res, nil
+}
+
+// WillExecutionReceipts returns what each component of a will did when the will fired
+func (q queryServer) WillExecutionReceipts(ctx context.Context, req *types.QueryWillExecutionReceiptsRequest) (*types.QueryWillExecutionReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := q.keeper.GetWillByID(ctx, req.WillId); err != nil {
+		return nil, err
+	}
+	receipts, pageRes, err := query.CollectionPaginate(ctx, q.keeper.receipts, req.Pagination,
+		func(_ collections.Pair[string, uint32], receipt types.ExecutionReceipt) (types.ExecutionReceipt, error) {
+			return receipt, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint32](req.WillId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryWillExecutionReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
 }
@@ -6,34 +6,42 @@ import (
 
 	// capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	"cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
 
+// OnAckPacket settles the will component that sent a packet once the receiving chain
+// acknowledged it, and emits the answer to a status query. An acknowledgement that is not a
+// standard one fails the component.
 func (k Keeper) OnAckPacket(
 	ctx sdk.Context,
 	// contractAddr sdk.AccAddress,
 	msg types.IBCPacketAckMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-ack-packet")
-	fmt.Println("INSIDE OnAckPacket")
-	// panic(1)
-	return nil
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(msg.Acknowledgement.Data, &ack); err != nil {
+		return k.SettlePacket(ctx, msg.OriginalPacket.Src.ChannelID, msg.OriginalPacket.Sequence, false, "invalid acknowledgement")
+	}
+	k.emitStatusAck(ctx, msg.OriginalPacket, ack)
+	return k.SettlePacket(ctx, msg.OriginalPacket.Src.ChannelID, msg.OriginalPacket.Sequence, ack.Success(), ack.GetError())
 }
 
+// OnCloseChannel fails the will components whose packets are in flight on a channel that closed
 func (k Keeper) OnCloseChannel(
 	ctx sdk.Context,
 	// contractAddr sdk.AccAddress,
 	msg types.IBCChannelCloseMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-close-channel")
-	fmt.Println("INSIDE OnCloseChannel")
-	// panic(1)
-	return nil
+	return k.CloseChannel(ctx, msg.GetChannel().Endpoint.ChannelID)
 }
 
 func (k Keeper) OnConnectChannel(
@@ -42,8 +50,9 @@ func (k Keeper) OnConnectChannel(
 	msg types.IBCChannelConnectMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-connect-channel")
-	fmt.Println("INSIDE OnConnectChannel")
-	// panic(1)
+	if msg.OpenAck != nil && msg.OpenAck.CounterpartyVersion != types.Version {
+		return errors.Wrapf(channeltypes.ErrInvalidChannelVersion, "counterparty version %s, expected %s", msg.OpenAck.CounterpartyVersion, types.Version)
+	}
 	return nil
 }
 
@@ -53,10 +62,14 @@ func (k Keeper) OnOpenChannel(
 	msg types.IBCChannelOpenMsg,
 ) (string, error) {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-open-channel")
-	fmt.Println("INSIDE OnOpenChannel")
-
-	// panic(1)
-	return "will-version-1", nil
+	// both ends of a channel speak the same version of the will packet protocol
+	if version, ok := msg.GetCounterVersion(); ok && version != types.Version {
+		return "", errors.Wrapf(channeltypes.ErrInvalidChannelVersion, "counterparty version %s, expected %s", version, types.Version)
+	}
+	if version := msg.GetChannel().Version; version != "" && version != types.Version {
+		return "", errors.Wrapf(channeltypes.ErrInvalidChannelVersion, "version %s, expected %s", version, types.Version)
+	}
+	return types.Version, nil
 }
 
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
@@ -75,10 +88,9 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
-// OnRecvPacket calls the contract to process the incoming IBC packet. The contract fully owns the data processing and
-// returns the acknowledgement data for the chain level. This allows custom applications and protocols on top
-// of IBC. Although it is recommended to use the standard acknowledgement envelope defined in
-// https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#acknowledgement-envelope
+// OnRecvPacket handles a will packet received over the will port and returns the result
+// acknowledgement of the packet. An error is acknowledged as an error acknowledgement, and the
+// state changes of the packet are reverted.
 //
 // For more information see: https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#packet-flow--handling
 func (k Keeper) OnRecvPacket(
@@ -87,22 +99,25 @@ func (k Keeper) OnRecvPacket(
 	msg types.IBCPacketReceiveMsg,
 ) (ibcexported.Acknowledgement, error) {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-recv-packet")
-	fmt.Println("INSIDE OnRecvPacket:")
-	fmt.Println(msg)
-	// panic(1)
-	return nil, nil
+	data, err := types.DecodeWillPacketData(msg.Packet.Data)
+	if err != nil {
+		return nil, err
+	}
+	result, err := k.handleWillPacket(ctx, msg.Packet.Dest.ChannelID, data)
+	if err != nil {
+		return nil, err
+	}
+	return channeltypes.NewResultAcknowledgement(result), nil
 }
 
-// OnTimeoutPacket calls the contract to let it know the packet was never received on the destination chain within
-// the timeout boundaries.
-// The contract should handle this on the application level and undo the original operation
+// OnTimeoutPacket sends a packet that was never received on the destination chain within the
+// timeout boundaries again, or fails the will component that sent it, according to the retry
+// policy of the component
 func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	// contractAddr sdk.AccAddress,
 	msg types.IBCPacketTimeoutMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "will", "contract", "ibc-timeout-packet")
-	fmt.Println("INSIDE OnTimeoutPacket")
-	// panic(1)
-	return nil
+	return k.TimeoutPacket(ctx, msg.Packet.Src.ChannelID, msg.Packet.Sequence)
 }
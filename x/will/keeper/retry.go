@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// maxPacketRetries bounds how often the packet of a component is sent again, the retries are
+// sent by the chain at the start of a block
+const maxPacketRetries = 10
+
+// validateRetryPolicy checks the retry policy of a component that sends an IBC packet
+func validateRetryPolicy(retry *types.RetryPolicy) error {
+	if retry == nil {
+		return nil
+	}
+	if retry.MaxRetries > maxPacketRetries {
+		return errors.Wrapf(types.ErrInvalid, "a packet is sent again at most %d times", maxPacketRetries)
+	}
+	if retry.BackoffBlocks < 0 {
+		return errors.Wrap(types.ErrInvalid, "retry backoff must not be negative")
+	}
+	return nil
+}
+
+// retryPolicy returns the retry policy of the component of a will that sends IBC packets, if
+// it has one
+func retryPolicy(will *types.Will, componentID string) *types.RetryPolicy {
+	for _, component := range will.Components {
+		if component.Id != componentID {
+			continue
+		}
+		switch c := component.ComponentType.(type) {
+		case *types.ExecutionComponent_IbcMsg:
+			return c.IbcMsg.Retry
+		case *types.ExecutionComponent_IbcSend:
+			return c.IbcSend.Retry
+		}
+	}
+	return nil
+}
+
+// ibcSend returns the IBC send of a will component, nil for components of other types
+func ibcSend(will *types.Will, componentID string) *types.IBCSendComponent {
+	for _, component := range will.Components {
+		if component.Id == componentID {
+			return component.GetIbcSend()
+		}
+	}
+	return nil
+}
+
+// TimeoutPacket handles an IBC packet a will component sent that timed out. While the retry
+// policy of the component has retries left the packet is sent again after its backoff, then the
+// component fails. The coins of a transfer that timed out are refunded to the creator of the
+// will, and go back to its escrow right away when the transfer is sent again, so the creator
+// cannot spend them before the retry. Packets no will component sent are ignored.
+func (k Keeper) TimeoutPacket(ctx sdk.Context, channelID string, sequence uint64) error {
+	pending, found, err := k.takePendingPacket(ctx, channelID, sequence)
+	if err != nil || !found {
+		return err
+	}
+	will, err := k.GetWillByID(ctx, pending.WillId)
+	if err != nil {
+		return err
+	}
+	retry := retryPolicy(will, pending.ComponentId)
+	if retry == nil || pending.Attempt >= retry.MaxRetries {
+		return k.settlePacket(ctx, pending, types.ComponentStatusFailed, "packet timed out")
+	}
+	if send := ibcSend(will, pending.ComponentId); send != nil {
+		// the transfer module refunded the transfer to the creator before this callback runs
+		if _, err := k.escrowCoins(ctx, will.ID, will.Creator, sdk.NewCoins(*send.Amount)); err != nil {
+			return k.settlePacket(ctx, pending, types.ComponentStatusFailed, "packet timed out, its refund cannot be escrowed again: "+err.Error())
+		}
+	}
+	next := types.PacketRetry{
+		WillId:      pending.WillId,
+		ComponentId: pending.ComponentId,
+		Height:      ctx.BlockHeight() + max(retry.BackoffBlocks, 1),
+		Attempt:     pending.Attempt + 1,
+	}
+	if err := k.packetRetries.Set(ctx, collections.Join3(next.Height, next.WillId, next.ComponentId), next); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_packet_retry_scheduled",
+			sdk.NewAttribute("will_id", next.WillId),
+			sdk.NewAttribute("component_id", next.ComponentId),
+			sdk.NewAttribute("channel", channelID),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute("height", strconv.FormatInt(next.Height, 10)),
+			sdk.NewAttribute("attempt", strconv.FormatUint(uint64(next.Attempt), 10)),
+		),
+	)
+	return nil
+}
+
+// CloseChannel fails the components whose packets are in flight on a channel that closed. A
+// closed channel cannot carry their packets again, so they are not retried.
+func (k Keeper) CloseChannel(ctx sdk.Context, channelID string) error {
+	var packets []types.PendingPacket
+	if err := k.pendingPackets.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](channelID), func(_ collections.Pair[string, uint64], packet types.PendingPacket) (bool, error) {
+		packets = append(packets, packet)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, packet := range packets {
+		if err := k.pendingPackets.Remove(ctx, collections.Join(packet.Channel, packet.Sequence)); err != nil {
+			return err
+		}
+		if err := k.settlePacket(ctx, packet, types.ComponentStatusFailed, "channel closed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryPackets sends again the packets whose retry is due. A packet that cannot be sent fails
+// its component.
+func (k Keeper) retryPackets(ctx sdk.Context) error {
+	var due []types.PacketRetry
+	if err := k.packetRetries.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](ctx.BlockHeight()), func(_ collections.Triple[int64, string, string], retry types.PacketRetry) (bool, error) {
+		due = append(due, retry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, retry := range due {
+		if err := k.packetRetries.Remove(ctx, collections.Join3(retry.Height, retry.WillId, retry.ComponentId)); err != nil {
+			return err
+		}
+		err := k.resendPacket(ctx, retry)
+		if err == nil {
+			continue
+		}
+		ctx.Logger().Error("sending will component packet again failed", "will_id", retry.WillId, "component_id", retry.ComponentId, "attempt", retry.Attempt, "err", err)
+		if err := k.settleComponent(ctx, retry.WillId, retry.ComponentId, types.ComponentStatusFailed, err.Error()); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("will_packet_retry_failed",
+				sdk.NewAttribute("will_id", retry.WillId),
+				sdk.NewAttribute("component_id", retry.ComponentId),
+				sdk.NewAttribute("attempt", strconv.FormatUint(uint64(retry.Attempt), 10)),
+				sdk.NewAttribute("error", err.Error()),
+			),
+		)
+	}
+	return nil
+}
+
+// resendPacket sends the packet of a component again. Either the packet is sent or nothing
+// happens.
+func (k Keeper) resendPacket(ctx sdk.Context, retry types.PacketRetry) error {
+	cacheCtx, write := ctx.CacheContext()
+	will, err := k.GetWillByID(cacheCtx, retry.WillId)
+	if err != nil {
+		return err
+	}
+	for _, component := range will.Components {
+		if component.Id != retry.ComponentId {
+			continue
+		}
+		switch component.ComponentType.(type) {
+		case *types.ExecutionComponent_IbcMsg:
+			err = k.sendWillPacket(cacheCtx, component, *will, retry.Attempt)
+		case *types.ExecutionComponent_IbcSend:
+			// the refund of the transfer that timed out waited in escrow
+			err = k.executeIBCSend(cacheCtx, component, *will, retry.Attempt)
+		default:
+			err = errors.Wrapf(types.ErrInvalid, "component %s does not send packets", component.Id)
+		}
+		if err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+	return errors.Wrapf(types.ErrComponentNotFound, "component %s of will %s", retry.ComponentId, retry.WillId)
+}
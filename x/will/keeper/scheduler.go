@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// scheduleDueWills moves the live wills due at the current block to the end of the execution
+// queue: the ones whose trigger height or time passed and the ones whose condition is met. At
+// most MaxWillsPerHeight wills of each kind are scheduled in a block, the others stay due for
+// the next blocks.
+func (k Keeper) scheduleDueWills(ctx sdk.Context) error {
+	heightIDs, err := k.liveWillIDsHeightDue(ctx, ctx.BlockHeight())
+	if err != nil {
+		return errors.Wrapf(err, "fetching wills due at block height %d", ctx.BlockHeight())
+	}
+	timeIDs, err := k.liveWillIDsDue(ctx, ctx.BlockTime())
+	if err != nil {
+		return errors.Wrapf(err, "fetching wills due at block time %s", ctx.BlockTime())
+	}
+	// wills with a condition trigger fire once a contract query compares true
+	metIDs, err := k.checkConditions(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "checking will conditions at block height %d", ctx.BlockHeight())
+	}
+	for _, ids := range [][]string{heightIDs, timeIDs, metIDs} {
+		for _, id := range ids {
+			if err := k.enqueueWill(ctx, id); err != nil {
+				return errors.Wrapf(err, "queueing will %s", id)
+			}
+		}
+	}
+	return nil
+}
+
+// enqueueWill marks a live will as fired and adds it to the end of the execution queue
+func (k Keeper) enqueueWill(ctx sdk.Context, willID string) error {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil {
+		return err
+	}
+	will.Status = types.WillStatusQueued
+	if err := k.setWill(ctx, will); err != nil {
+		return err
+	}
+	if err := k.pushQueue(ctx, will.ID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_queued",
+			sdk.NewAttribute("will_id", will.ID),
+		),
+	)
+	return nil
+}
+
+// pushQueue adds a will to the end of the execution queue
+func (k Keeper) pushQueue(ctx context.Context, willID string) error {
+	seq, err := k.queueSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.queuePositions.Set(ctx, willID, seq); err != nil {
+		return err
+	}
+	return k.queue.Set(ctx, seq, willID)
+}
+
+// removeFromQueue removes a will from the execution queue, wherever it is in it
+func (k Keeper) removeFromQueue(ctx context.Context, willID string) error {
+	seq, err := k.queuePositions.Get(ctx, willID)
+	if err != nil {
+		return errors.Wrapf(err, "will %s is not in the execution queue", willID)
+	}
+	if err := k.queuePositions.Remove(ctx, willID); err != nil {
+		return err
+	}
+	return k.queue.Remove(ctx, seq)
+}
+
+// queueHead returns the sequence and will ID at the head of the execution queue, found is
+// false when the queue is empty
+func (k Keeper) queueHead(ctx context.Context) (seq uint64, willID string, found bool, err error) {
+	iter, err := k.queue.Iterate(ctx, nil)
+	if err != nil {
+		return 0, "", false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, "", false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, "", false, err
+	}
+	return kv.Key, kv.Value, true, nil
+}
+
+// QueuedWillIDs returns the IDs of the wills in the execution queue, in execution order
+func (k Keeper) QueuedWillIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	err := k.queue.Walk(ctx, nil, func(_ uint64, willID string) (bool, error) {
+		ids = append(ids, willID)
+		return false, nil
+	})
+	return ids, err
+}
+
+// processQueue executes the queued wills in the order they fired until their components used
+// up the MaxBlockExecutionGas of the block. A will whose components do not fit in what is left
+// of it stays at the head of the queue for the next block. The first will of a block may use
+// all of it, so a will that does not fit in a whole block still runs, and the component that
+// runs out of gas fails.
+func (k Keeper) processQueue(ctx sdk.Context) error {
+	budget := k.GetParams(ctx).MaxBlockExecutionGas
+	var used uint64
+	for used < budget {
+		seq, willID, found, err := k.queueHead(ctx)
+		if err != nil {
+			return errors.Wrap(err, "reading the execution queue")
+		}
+		if !found {
+			return nil
+		}
+		will, err := k.GetWillByID(ctx, willID)
+		if err != nil {
+			return err
+		}
+		if will.Status != types.WillStatusQueued {
+			ctx.Logger().Error("dropping will that is not queued from the execution queue", "will_id", will.ID, "status", will.Status)
+			if err := k.queue.Remove(ctx, seq); err != nil {
+				return err
+			}
+			if err := k.queuePositions.Remove(ctx, will.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// run the components in order, each one leaves a receipt of what it did
+		gasUsed, done, err := k.executeWill(ctx, will, budget-used, used > 0)
+		if err != nil {
+			return errors.Wrapf(err, "executing will %s", will.ID)
+		}
+		if !done {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent("will_carried_over",
+					sdk.NewAttribute("will_id", will.ID),
+				),
+			)
+			return nil
+		}
+		used += gasUsed
+
+		if err := k.removeFromQueue(ctx, will.ID); err != nil {
+			return err
+		}
+		if _, err := k.finishWill(ctx, will, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishWill stores a will that was executed as expired. An executor who ran the will before the
+// execution queue did is paid its bounty, the rest of the creation deposit is returned to the
+// creator as it is only held while the will waits to fire, and so is the escrow none of the
+// components can pay out anymore.
+func (k Keeper) finishWill(ctx sdk.Context, will *types.Will, executor sdk.AccAddress) (sdk.Coins, error) {
+	will.Status = types.WillStatusExpired
+	if err := k.setWill(ctx, will); err != nil {
+		return nil, errors.Wrapf(err, "storing executed will %s", will.ID)
+	}
+	bounty := sdk.NewCoins()
+	if executor != nil {
+		var err error
+		if bounty, err = k.payExecutorBounty(ctx, will, executor); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := k.refundDeposit(ctx, will); err != nil {
+		ctx.Logger().Error("will deposit refund failed", "will_id", will.ID, "err", err)
+	}
+	k.refundSurplus(ctx, will.ID)
+	return bounty, nil
+}
+
+// isDue returns true for a will that fired and waits in the execution queue, and for a live will
+// whose trigger height or time passed or whose condition is met now
+func (k Keeper) isDue(ctx sdk.Context, will *types.Will) (bool, error) {
+	switch {
+	case will.Status == types.WillStatusQueued:
+		return true, nil
+	case will.Status != types.WillStatusLive:
+		return false, nil
+	case will.Condition != nil:
+		return k.evaluateWillCondition(ctx, will)
+	case will.TriggerTime != nil:
+		return !ctx.BlockTime().Before(*will.TriggerTime), nil
+	default:
+		return will.Height <= ctx.BlockHeight(), nil
+	}
+}
+
+/*
+@name ExecuteWill
+@desc runs a will that is due in the transaction of whoever submits it, instead of waiting
+for the execution queue, and pays them a bounty out of the will's creation deposit
+@param msg MsgExecuteWillRequest signed by the executor, who may be anyone
+*/
+func (k Keeper) ExecuteWill(ctx context.Context, msg *types.MsgExecuteWillRequest) (sdk.Coins, error) {
+	executor, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "executor %s: %s", msg.Executor, err)
+	}
+	will, err := k.GetWillByID(ctx, msg.WillId)
+	if err != nil {
+		return nil, err
+	}
+	return k.executeDueWill(sdk.UnwrapSDKContext(ctx), will, executor)
+}
+
+// executeDueWill runs a due will in a transaction, whose gas pays for the components, and pays
+// the executor its bounty. Running out of gas fails the transaction rather than a component, so
+// an executor cannot make components fail by offering too little gas.
+func (k Keeper) executeDueWill(ctx sdk.Context, will *types.Will, executor sdk.AccAddress) (sdk.Coins, error) {
+	due, err := k.isDue(ctx, will)
+	if err != nil {
+		return nil, errors.Wrapf(err, "will %s", will.ID)
+	}
+	if !due {
+		return nil, errors.Wrapf(types.ErrWillNotDue, "will %s has status %s", will.ID, will.Status)
+	}
+	if will.Status == types.WillStatusQueued {
+		if err := k.removeFromQueue(ctx, will.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	gasUsed, done, err := k.executeWill(ctx, will, ctx.GasMeter().GasRemaining(), true)
+	ctx.GasMeter().ConsumeGas(gasUsed, "will components")
+	if err != nil {
+		return nil, errors.Wrapf(err, "executing will %s", will.ID)
+	}
+	if !done {
+		return nil, errors.Wrapf(sdkerrors.ErrOutOfGas, "not enough gas left to execute will %s", will.ID)
+	}
+	bounty, err := k.finishWill(ctx, will, executor)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_executor_paid",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("executor", executor.String()),
+			sdk.NewAttribute("bounty", bounty.String()),
+		),
+	)
+	return bounty, nil
+}
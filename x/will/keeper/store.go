@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// anyClaimant is the claimant index key of claim components with public access,
+// it is not a valid address so it cannot collide with a real claimant
+const anyClaimant = "*"
+
+// WillIndexes are the secondary indexes of the wills collection
+type WillIndexes struct {
+	Creator       *KeySetIndex[string]
+	Beneficiary   *KeySetIndex[string]
+	Claimant      *KeySetIndex[string]
+	Height        *KeySetIndex[int64]
+	Status        *KeySetIndex[string]
+	TriggerTime   *KeySetIndex[time.Time]
+	Condition     *KeySetIndex[int64]
+	TriggerHeight *KeySetIndex[int64]
+}
+
+// IndexesList returns all the will indexes
+func (i WillIndexes) IndexesList() []collections.Index[string, types.Will] {
+	return []collections.Index[string, types.Will]{i.Creator, i.Beneficiary, i.Claimant, i.Height, i.Status, i.TriggerTime, i.Condition, i.TriggerHeight}
+}
+
+// NewWillIndexes registers the will indexes with the schema builder
+func NewWillIndexes(sb *collections.SchemaBuilder) WillIndexes {
+	return WillIndexes{
+		Creator: NewKeySetIndex(sb, types.WillsByCreatorPrefix, "wills_by_creator", collections.StringKey,
+			func(will types.Will) []string { return []string{will.Creator} }),
+		Beneficiary: NewKeySetIndex(sb, types.WillsByBeneficiaryPrefix, "wills_by_beneficiary", collections.StringKey,
+			func(will types.Will) []string { return []string{will.Beneficiary} }),
+		Claimant: NewKeySetIndex(sb, types.WillsByClaimantPrefix, "wills_by_claimant", collections.StringKey, claimants),
+		Height:   NewKeySetIndex(sb, types.WillsByHeightPrefix, "wills_by_height", collections.Int64Key, triggerHeights),
+		Status: NewKeySetIndex(sb, types.WillsByStatusPrefix, "wills_by_status", collections.StringKey,
+			func(will types.Will) []string { return []string{will.Status} }),
+		TriggerTime:   NewKeySetIndex(sb, types.WillsByTriggerTimePrefix, "wills_by_trigger_time", sdk.TimeKey, liveTriggerTimes),
+		Condition:     NewKeySetIndex(sb, types.WillsByConditionHeightPrefix, "wills_by_condition_height", collections.Int64Key, liveConditionHeights),
+		TriggerHeight: NewKeySetIndex(sb, types.WillsByTriggerHeightPrefix, "wills_by_trigger_height", collections.Int64Key, liveTriggerHeights),
+	}
+}
+
+// triggerHeights returns the trigger height of a will, wills with a trigger time or condition have none
+func triggerHeights(will types.Will) []int64 {
+	if will.TriggerTime != nil || will.Condition != nil {
+		return nil
+	}
+	return []int64{will.Height}
+}
+
+// liveTriggerHeights returns the trigger height of a live will. Only live wills are indexed, so
+// the wills due at a height are the ones indexed up to it.
+func liveTriggerHeights(will types.Will) []int64 {
+	if will.Status != types.WillStatusLive {
+		return nil
+	}
+	return triggerHeights(will)
+}
+
+// liveTriggerTimes returns the trigger time of a live will. Only live wills are indexed, so
+// the wills due at a block time are the ones indexed up to it.
+func liveTriggerTimes(will types.Will) []time.Time {
+	if will.TriggerTime == nil || will.Status != types.WillStatusLive {
+		return nil
+	}
+	return []time.Time{*will.TriggerTime}
+}
+
+// liveConditionHeights returns the height the condition of a live will is evaluated at next.
+// Only live wills are indexed, so the conditions due at a height are the ones indexed up to it.
+func liveConditionHeights(will types.Will) []int64 {
+	if will.Condition == nil || will.Status != types.WillStatusLive {
+		return nil
+	}
+	return []int64{will.Condition.NextCheckHeight}
+}
+
+// claimants returns the addresses named by the private claim components of a will,
+// and anyClaimant when it has a public one
+func claimants(will types.Will) []string {
+	var addresses []string
+	for _, component := range will.Components {
+		claim, ok := component.GetComponentType().(*types.ExecutionComponent_Claim)
+		if !ok || claim.Claim == nil {
+			continue
+		}
+		switch access := claim.Claim.Access.AccessType.(type) {
+		case *types.ClaimAccessControl_Public:
+			addresses = append(addresses, anyClaimant)
+		case *types.ClaimAccessControl_Private:
+			addresses = append(addresses, access.Private.Addresses...)
+		}
+	}
+	return addresses
+}
+
+// KeySetIndex indexes a will under every reference key getRefKeys returns for it.
+// Unlike indexes.Multi it supports raw iteration, so it can be paginated by reference key.
+type KeySetIndex[K any] struct {
+	refKeys    collections.KeySet[collections.Pair[K, string]]
+	getRefKeys func(will types.Will) []K
+}
+
+// NewKeySetIndex registers a key set index with the schema builder
+func NewKeySetIndex[K any](sb *collections.SchemaBuilder, prefix collections.Prefix, name string, refKeyCodec collcodec.KeyCodec[K], getRefKeys func(will types.Will) []K) *KeySetIndex[K] {
+	return &KeySetIndex[K]{
+		refKeys:    collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(refKeyCodec, collections.StringKey)),
+		getRefKeys: getRefKeys,
+	}
+}
+
+// Reference indexes a will under its reference keys, dropping the references of its previous version
+func (i *KeySetIndex[K]) Reference(ctx context.Context, pk string, newValue types.Will, lazyOldValue func() (types.Will, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case !errors.IsOf(err, collections.ErrNotFound):
+		return err
+	}
+	for _, refKey := range i.getRefKeys(newValue) {
+		if err := i.refKeys.Set(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unreference drops the references of a will
+func (i *KeySetIndex[K]) Unreference(ctx context.Context, pk string, getValue func() (types.Will, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *KeySetIndex[K]) unreference(ctx context.Context, pk string, value types.Will) error {
+	for _, refKey := range i.getRefKeys(value) {
+		if err := i.refKeys.Remove(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WillIDs returns the IDs of the wills indexed under a reference key
+func (i *KeySetIndex[K]) WillIDs(ctx context.Context, refKey K) ([]string, error) {
+	iter, err := i.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[K, string](refKey))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(keys))
+	for j, key := range keys {
+		ids[j] = key.K2()
+	}
+	return ids, nil
+}
+
+// WillIDsUntil returns the IDs of at most limit wills indexed under a reference key up to and
+// including refKey, in reference key order
+func (i *KeySetIndex[K]) WillIDsUntil(ctx context.Context, refKey K, limit uint32) ([]string, error) {
+	var ids []string
+	err := i.refKeys.Walk(ctx, collections.NewPrefixUntilPairRange[K, string](refKey), func(key collections.Pair[K, string]) (bool, error) {
+		ids = append(ids, key.K2())
+		return uint32(len(ids)) >= limit, nil
+	})
+	return ids, err
+}
+
+// IterateRaw iterates over the raw (reference key, will ID) keys of the index
+func (i *KeySetIndex[K]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[K, string], collections.NoValue], error) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+// KeyCodec returns the key codec of the index
+func (i *KeySetIndex[K]) KeyCodec() collcodec.KeyCodec[collections.Pair[K, string]] {
+	return i.refKeys.KeyCodec()
+}
+
+// NewWillsMap builds the wills collection with its secondary indexes
+func NewWillsMap(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) *collections.IndexedMap[string, types.Will, WillIndexes] {
+	return collections.NewIndexedMap(sb, types.WillsPrefix, "wills", collections.StringKey, codec.CollValue[types.Will](cdc), NewWillIndexes(sb))
+}
+
+// NewEscrowsMap builds the will escrows collection
+func NewEscrowsMap(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) collections.Map[string, types.WillEscrow] {
+	return collections.NewMap(sb, types.EscrowsPrefix, "escrows", collections.StringKey, codec.CollValue[types.WillEscrow](cdc))
+}
+
+// NewNullifiersSet builds the set of used claim proofs, keyed by claim scheme and nullifier
+func NewNullifiersSet(sb *collections.SchemaBuilder) collections.KeySet[collections.Pair[string, []byte]] {
+	return collections.NewKeySet(sb, types.NullifiersPrefix, "nullifiers", collections.PairKeyCodec(collections.StringKey, collections.BytesKey))
+}
+
+// NewApprovalsSet builds the set of guardian approvals, keyed by will ID, component ID, then
+// claimer and guardian index
+func NewApprovalsSet(sb *collections.SchemaBuilder) collections.KeySet[collections.Triple[string, string, collections.Pair[string, uint32]]] {
+	return collections.NewKeySet(sb, types.ApprovalsPrefix, "approvals", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.PairKeyCodec(collections.StringKey, collections.Uint32Key)))
+}
+
+// NewReceiptsMap builds the component execution receipts collection, keyed by will ID and component index
+func NewReceiptsMap(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) collections.Map[collections.Pair[string, uint32], types.ExecutionReceipt] {
+	return collections.NewMap(sb, types.ReceiptsPrefix, "receipts", collections.PairKeyCodec(collections.StringKey, collections.Uint32Key), codec.CollValue[types.ExecutionReceipt](cdc))
+}
+
+// NewExecutionQueueMap builds the queue of wills that fired and wait to be executed, keyed by sequence
+func NewExecutionQueueMap(sb *collections.SchemaBuilder) collections.Map[uint64, string] {
+	return collections.NewMap(sb, types.ExecutionQueuePrefix, "execution_queue", collections.Uint64Key, collections.StringValue)
+}
+
+// NewExecutionQueueSequence builds the sequence that orders the execution queue
+func NewExecutionQueueSequence(sb *collections.SchemaBuilder) collections.Sequence {
+	return collections.NewSequence(sb, types.ExecutionQueueSequencePrefix, "execution_queue_sequence")
+}
+
+// NewQueuePositionsMap builds the sequences of the queued wills in the execution queue, keyed by will ID
+func NewQueuePositionsMap(sb *collections.SchemaBuilder) collections.Map[string, uint64] {
+	return collections.NewMap(sb, types.QueuePositionsPrefix, "queue_positions", collections.StringKey, collections.Uint64Value)
+}
+
+// NewPendingPacketsMap builds the map of the IBC packets sent by will components that are not
+// acknowledged yet, keyed by source channel and sequence
+func NewPendingPacketsMap(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) collections.Map[collections.Pair[string, uint64], types.PendingPacket] {
+	return collections.NewMap(sb, types.PendingPacketsPrefix, "pending_packets", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PendingPacket](cdc))
+}
+
+// NewPacketRetriesMap builds the queue of the packets that timed out and are sent again at a block
+// height, keyed by block height, will ID and component ID
+func NewPacketRetriesMap(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) collections.Map[collections.Triple[int64, string, string], types.PacketRetry] {
+	return collections.NewMap(sb, types.PacketRetriesPrefix, "packet_retries", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.PacketRetry](cdc))
+}
+
+// NewLapsesByHeightSet builds the queue of claim windows closing at a block height
+func NewLapsesByHeightSet(sb *collections.SchemaBuilder) collections.KeySet[collections.Triple[int64, string, string]] {
+	return collections.NewKeySet(sb, types.LapsesByHeightPrefix, "lapses_by_height", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey))
+}
+
+// NewLapsesByTimeSet builds the queue of claim windows closing at a block time
+func NewLapsesByTimeSet(sb *collections.SchemaBuilder) collections.KeySet[collections.Triple[time.Time, string, string]] {
+	return collections.NewKeySet(sb, types.LapsesByTimePrefix, "lapses_by_time", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.StringKey))
+}
+
+// setWill stores a will under its ID, keeping the secondary indexes in sync
+func (k Keeper) setWill(ctx context.Context, will *types.Will) error {
+	return k.wills.Set(ctx, will.ID, *will)
+}
+
+// IterateWills calls cb for every stored will, in will ID order, until cb returns true
+func (k Keeper) IterateWills(ctx context.Context, cb func(will *types.Will) (stop bool)) error {
+	return k.wills.Walk(ctx, nil, func(_ string, will types.Will) (bool, error) {
+		return cb(&will), nil
+	})
+}
+
+// willsByIndex loads the wills an index holds under a reference key
+func willsByIndex[K any](ctx context.Context, k Keeper, index *KeySetIndex[K], refKey K) ([]*types.Will, error) {
+	ids, err := index.WillIDs(ctx, refKey)
+	if err != nil {
+		return nil, err
+	}
+	wills := make([]*types.Will, 0, len(ids))
+	for _, id := range ids {
+		will, err := k.wills.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		wills = append(wills, &will)
+	}
+	return wills, nil
+}
+
+// liveWillIDsHeightDue returns the IDs of the live wills whose trigger height is at or before the
+// given height, at most MaxWillsPerHeight of them, earliest first
+func (k Keeper) liveWillIDsHeightDue(ctx context.Context, height int64) ([]string, error) {
+	return k.wills.Indexes.TriggerHeight.WillIDsUntil(ctx, height, k.GetParams(ctx).MaxWillsPerHeight)
+}
+
+// liveWillIDsDue returns the IDs of the live wills whose trigger time is at or before the given
+// block time, at most MaxWillsPerHeight of them, earliest first
+func (k Keeper) liveWillIDsDue(ctx context.Context, blockTime time.Time) ([]string, error) {
+	return k.wills.Indexes.TriggerTime.WillIDsUntil(ctx, blockTime, k.GetParams(ctx).MaxWillsPerHeight)
+}
+
+// liveWillIDsConditionDue returns the IDs of the live wills whose condition is evaluated at or
+// before the given height, at most MaxWillsPerHeight of them, longest waiting first
+func (k Keeper) liveWillIDsConditionDue(ctx context.Context, height int64) ([]string, error) {
+	return k.wills.Indexes.Condition.WillIDsUntil(ctx, height, k.GetParams(ctx).MaxWillsPerHeight)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"go.dedis.ch/kyber/v3"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/schnorr"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+const (
+	// GasThresholdSchnorrVerify is charged to verify a threshold Schnorr claim
+	GasThresholdSchnorrVerify uint64 = 1_000
+	// GasThresholdSchnorrPerSigner is charged for each guardian key aggregated into the verifying key
+	GasThresholdSchnorrPerSigner uint64 = 500
+	// MaxGuardians bounds the number of guardians of a threshold Schnorr component
+	MaxGuardians = 32
+)
+
+// ThresholdClaimScheme is a claim scheme whose claims carry the approvals of some of the
+// guardians of a component. The keeper records the approvals of every verified claim by
+// claimer and claims the component for a claimer once Threshold distinct guardians approved
+// its claims, so guardians may approve together in one claim or one by one across several
+// claims. Approvals given to different claimers do not add up.
+type ThresholdClaimScheme interface {
+	ClaimScheme
+	// Threshold returns the number of distinct guardians that must approve the component
+	Threshold(component *types.ClaimComponent) uint32
+	// Approvers returns the guardians whose approval a verified claim carries
+	Approvers(component *types.ClaimComponent, msg *types.MsgClaimRequest) ([]uint32, error)
+}
+
+// approve records the approvals of a verified claim and returns the number of distinct
+// guardians that approved the claims of its claimer so far
+func (k Keeper) approve(ctx context.Context, scheme ThresholdClaimScheme, component *types.ClaimComponent, msg *types.MsgClaimRequest) (uint32, error) {
+	approvers, err := scheme.Approvers(component, msg)
+	if err != nil {
+		return 0, err
+	}
+	for _, guardian := range approvers {
+		if err := k.approvals.Set(ctx, collections.Join3(msg.WillId, msg.ComponentId, collections.Join(msg.Claimer, guardian))); err != nil {
+			return 0, err
+		}
+	}
+	var approved uint32
+	claimerApprovals := new(collections.Range[collections.Triple[string, string, collections.Pair[string, uint32]]]).
+		Prefix(collections.Join3(msg.WillId, msg.ComponentId, collections.PairPrefix[string, uint32](msg.Claimer)))
+	err = k.approvals.Walk(ctx, claimerApprovals, func(collections.Triple[string, string, collections.Pair[string, uint32]]) (bool, error) {
+		approved++
+		return false, nil
+	})
+	return approved, err
+}
+
+// clearApprovals removes the approvals collected for a component, those of every claimer,
+// once it is claimed
+func (k Keeper) clearApprovals(ctx context.Context, willID, componentID string) error {
+	return k.approvals.Clear(ctx, collections.NewSuperPrefixedTripleRange[string, string, collections.Pair[string, uint32]](willID, componentID))
+}
+
+// thresholdSchnorrClaimScheme lets a threshold of guardians approve a claim. A claim names
+// the approving guardians and carries their MuSig aggregate signature over
+// types.ThresholdClaimDigest, verified against the aggregate of their keys weighted by the
+// key aggregation coefficients of all guardian keys. Every accepted signature is nullified.
+type thresholdSchnorrClaimScheme struct{}
+
+func (thresholdSchnorrClaimScheme) Name() string { return types.ClaimSchemeThresholdSchnorr }
+
+func (thresholdSchnorrClaimScheme) ValidateComponent(component *types.ClaimComponent) error {
+	stored := component.GetThresholdSchnorr()
+	if stored == nil {
+		return errors.Wrap(types.ErrInvalid, "guardian public keys not found in the component")
+	}
+	if len(stored.PublicKeys) == 0 || len(stored.PublicKeys) > MaxGuardians {
+		return errors.Wrapf(types.ErrInvalid, "threshold schnorr component needs 1 to %d guardians, got %d", MaxGuardians, len(stored.PublicKeys))
+	}
+	if stored.Threshold == 0 || int(stored.Threshold) > len(stored.PublicKeys) {
+		return errors.Wrapf(types.ErrInvalid, "threshold %d out of range for %d guardians", stored.Threshold, len(stored.PublicKeys))
+	}
+	if _, err := guardianKeys(stored); err != nil {
+		return errors.Wrap(types.ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func (thresholdSchnorrClaimScheme) GasCost(_ *types.ClaimComponent, msg *types.MsgClaimRequest) uint64 {
+	var signers int
+	if claim := msg.GetThresholdSchnorrClaim(); claim != nil {
+		signers = len(claim.Signers)
+	}
+	return GasThresholdSchnorrVerify + uint64(signers)*GasThresholdSchnorrPerSigner
+}
+
+func (thresholdSchnorrClaimScheme) VerifyClaim(ctx context.Context, component *types.ClaimComponent, msg *types.MsgClaimRequest) error {
+	claim := msg.GetThresholdSchnorrClaim()
+	if claim == nil {
+		return errors.Wrapf(types.ErrInvalid, "expected a threshold schnorr claim, got %T", msg.ClaimType)
+	}
+	keys, err := guardianKeys(component.GetThresholdSchnorr())
+	if err != nil {
+		return errors.Wrap(types.ErrInvalid, err.Error())
+	}
+	aggregate, err := schnorr.AggregateKeys(keys, claim.Signers)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "guardians: %s", err)
+	}
+	signature, err := schnorr.UnmarshalSignature(claim.Signature)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidProof, "schnorr signature: %s", err)
+	}
+	digest := types.ThresholdClaimDigest(sdk.UnwrapSDKContext(ctx).ChainID(), msg.WillId, msg.ComponentId, msg.Claimer)
+	if !schnorr.VerifyMessage(digest, signature, aggregate) {
+		return errors.Wrap(types.ErrInvalidProof, "aggregate signature verification failed")
+	}
+	return nil
+}
+
+// Nullifier is the aggregate signature, its encoding is canonical
+func (thresholdSchnorrClaimScheme) Nullifier(_ *types.ClaimComponent, msg *types.MsgClaimRequest) ([]byte, error) {
+	claim := msg.GetThresholdSchnorrClaim()
+	if claim == nil {
+		return nil, errors.Wrapf(types.ErrInvalid, "expected a threshold schnorr claim, got %T", msg.ClaimType)
+	}
+	return claim.Signature, nil
+}
+
+func (thresholdSchnorrClaimScheme) Threshold(component *types.ClaimComponent) uint32 {
+	return component.GetThresholdSchnorr().Threshold
+}
+
+func (thresholdSchnorrClaimScheme) Approvers(_ *types.ClaimComponent, msg *types.MsgClaimRequest) ([]uint32, error) {
+	claim := msg.GetThresholdSchnorrClaim()
+	if claim == nil {
+		return nil, errors.Wrapf(types.ErrInvalid, "expected a threshold schnorr claim, got %T", msg.ClaimType)
+	}
+	return claim.Signers, nil
+}
+
+// guardianKeys decodes the guardian public keys of a component, which must be distinct
+func guardianKeys(stored *types.ThresholdSchnorr) ([]kyber.Point, error) {
+	keys := make([]kyber.Point, len(stored.PublicKeys))
+	seen := make(map[string]struct{}, len(stored.PublicKeys))
+	for i, bz := range stored.PublicKeys {
+		key, err := schnorr.UnmarshalPublicKey(bz)
+		if err != nil {
+			return nil, fmt.Errorf("guardian %d public key: %w", i, err)
+		}
+		if _, ok := seen[string(bz)]; ok {
+			return nil, fmt.Errorf("guardian %d public key is a duplicate", i)
+		}
+		seen[string(bz)] = struct{}{}
+		keys[i] = key
+	}
+	return keys, nil
+}
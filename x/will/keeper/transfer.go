@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// validateIBCSend checks the receiver, channel, amount and memo of an IBC send component
+func validateIBCSend(send *types.IBCSendComponent) error {
+	if strings.TrimSpace(send.Address) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "empty IBC send receiver")
+	}
+	if err := host.ChannelIdentifierValidator(send.Channel); err != nil {
+		return errors.Wrapf(types.ErrInvalid, "IBC send channel: %s", err)
+	}
+	if send.PortId != "" {
+		if err := host.PortIdentifierValidator(send.PortId); err != nil {
+			return errors.Wrapf(types.ErrInvalid, "IBC send port: %s", err)
+		}
+	}
+	if send.Amount == nil || !send.Amount.IsValid() || send.Amount.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "IBC send amount %s", send.Amount)
+	}
+	if send.Denom != "" && send.Denom != send.Amount.Denom {
+		return errors.Wrapf(types.ErrInvalid, "IBC send denom %s does not match the amount %s", send.Denom, send.Amount)
+	}
+	if len(send.Memo) > ibctransfertypes.MaximumMemoLength {
+		return errors.Wrapf(types.ErrInvalid, "IBC send memo must not exceed %d bytes", ibctransfertypes.MaximumMemoLength)
+	}
+	return validateRetryPolicy(send.Retry)
+}
+
+// ExecuteIBCSend sends the coins of an IBC send component over ICS-20. The component is pending
+// until the transfer is acknowledged.
+func (k Keeper) ExecuteIBCSend(ctx sdk.Context, component *types.ExecutionComponent, will types.Will) error {
+	return k.executeIBCSend(ctx, component, will, 0)
+}
+
+// executeIBCSend sends the transfer of an IBC send component, attempt counts the times it was
+// sent again after a timeout
+func (k Keeper) executeIBCSend(ctx sdk.Context, component *types.ExecutionComponent, will types.Will, attempt uint32) error {
+	send := component.GetIbcSend()
+	if send == nil {
+		return errors.Wrapf(types.ErrInvalid, "component %s is not an IBC send", component.Id)
+	}
+	port := send.PortId
+	if port == "" {
+		port = ibctransfertypes.PortID
+	}
+	msg := ibctransfertypes.NewMsgTransfer(port, send.Channel, *send.Amount, "", send.Address, clienttypes.ZeroHeight(), 0, send.Memo)
+	return k.sendTransfer(ctx, will, component.Id, types.ComponentStatusExecuted, attempt, msg)
+}
+
+// sendOutputTransfer sends the ICS-20 transfer of an IBC send output. The component is pending
+// until the transfer is acknowledged, then it is back to the status it had, claimed or lapsed.
+// Either the transfer is sent and the component pending or nothing happens.
+func (k Keeper) sendOutputTransfer(ctx sdk.Context, component *types.ExecutionComponent, will types.Will, output *types.OutputIBCSend) error {
+	if output.Amount == nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "component %s IBC send output has no amount", component.Id)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	stored, err := k.GetWillByID(cacheCtx, will.ID)
+	if err != nil {
+		return err
+	}
+	for _, c := range stored.Components {
+		if c.Id != component.Id {
+			continue
+		}
+		msg := ibctransfertypes.NewMsgTransfer(ibctransfertypes.PortID, output.Channel, *output.Amount, "", output.Address, clienttypes.ZeroHeight(), 0, output.Memo)
+		if err := k.sendTransfer(cacheCtx, *stored, c.Id, c.Status, 0, msg); err != nil {
+			return err
+		}
+		c.Status = types.ComponentStatusPending
+		if err := k.setWill(cacheCtx, stored); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+	return errors.Wrapf(types.ErrComponentNotFound, "component %s of will %s", component.Id, will.ID)
+}
+
+// sendTransfer releases the coins of an ICS-20 transfer out of a will's escrow to its creator and
+// sends them from the creator's account, so a transfer that fails or times out refunds the
+// creator. The packet is pending until it is acknowledged, then the component that sent it moves
+// to settledStatus.
+func (k Keeper) sendTransfer(ctx sdk.Context, will types.Will, componentID, settledStatus string, attempt uint32, msg *ibctransfertypes.MsgTransfer) error {
+	creator, err := sdk.AccAddressFromBech32(will.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "creator %s: %s", will.Creator, err)
+	}
+	msg.Sender = will.Creator
+	msg.TimeoutTimestamp = uint64(ctx.BlockTime().Add(k.GetParams(ctx).IBCTimeout).UnixNano())
+	if err := msg.ValidateBasic(); err != nil {
+		return errors.Wrapf(err, "component %s transfer", componentID)
+	}
+	if err := k.releaseEscrow(ctx, will.ID, creator, sdk.NewCoins(msg.Token)); err != nil {
+		return err
+	}
+	res, err := k.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return errors.Wrapf(err, "sending %s over channel %s", msg.Token, msg.SourceChannel)
+	}
+	if err := k.pendingPackets.Set(ctx, collections.Join(msg.SourceChannel, res.Sequence), types.PendingPacket{
+		Channel:       msg.SourceChannel,
+		Sequence:      res.Sequence,
+		WillId:        will.ID,
+		ComponentId:   componentID,
+		SettledStatus: settledStatus,
+		Attempt:       attempt,
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_ibc_transfer_sent",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("component_id", componentID),
+			sdk.NewAttribute("channel", msg.SourceChannel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute("receiver", msg.Receiver),
+			sdk.NewAttribute("amount", msg.Token.String()),
+		),
+	)
+	return nil
+}
+
+// SettlePacket settles the component that sent an IBC packet once the packet is acknowledged. A
+// packet that succeeded moves the component to the status it settles to, one the receiving chain
+// acknowledged with an error moves it to failed. Packets no will component sent are ignored.
+func (k Keeper) SettlePacket(ctx sdk.Context, channelID string, sequence uint64, success bool, reason string) error {
+	pending, found, err := k.takePendingPacket(ctx, channelID, sequence)
+	if err != nil || !found {
+		return err
+	}
+	status := pending.SettledStatus
+	if !success {
+		status = types.ComponentStatusFailed
+	}
+	return k.settlePacket(ctx, pending, status, reason)
+}
+
+// takePendingPacket removes the packet a will component sent on a channel from the pending
+// packets, found is false for packets no will component sent
+func (k Keeper) takePendingPacket(ctx sdk.Context, channelID string, sequence uint64) (pending types.PendingPacket, found bool, err error) {
+	key := collections.Join(channelID, sequence)
+	pending, err = k.pendingPackets.Get(ctx, key)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return pending, false, nil
+	}
+	if err != nil {
+		return pending, false, err
+	}
+	return pending, true, k.pendingPackets.Remove(ctx, key)
+}
+
+// settlePacket moves the component that sent a packet to its final status
+func (k Keeper) settlePacket(ctx sdk.Context, pending types.PendingPacket, status, reason string) error {
+	if err := k.settleComponent(ctx, pending.WillId, pending.ComponentId, status, reason); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("will_packet_settled",
+			sdk.NewAttribute("will_id", pending.WillId),
+			sdk.NewAttribute("component_id", pending.ComponentId),
+			sdk.NewAttribute("channel", pending.Channel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(pending.Sequence, 10)),
+			sdk.NewAttribute("success", strconv.FormatBool(status != types.ComponentStatusFailed)),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+	return nil
+}
+
+// settleComponent moves a component whose packet is pending to status, together with its
+// receipt. The reason is recorded on the receipt of a component that failed.
+func (k Keeper) settleComponent(ctx sdk.Context, willID, componentID, status, reason string) error {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil {
+		return err
+	}
+	for i, component := range will.Components {
+		if component.Id != componentID {
+			continue
+		}
+		component.Status = status
+		if err := k.setWill(ctx, will); err != nil {
+			return err
+		}
+		// what a failed component would have paid out goes back to the creator
+		k.refundSurplus(ctx, will.ID)
+		// components that sent the packet when the will fired have a receipt to settle too
+		receiptKey := collections.Join(will.ID, uint32(i))
+		receipt, err := k.receipts.Get(ctx, receiptKey)
+		switch {
+		case errors.IsOf(err, collections.ErrNotFound):
+		case err != nil:
+			return err
+		case receipt.Status == types.ComponentStatusPending:
+			receipt.Status = status
+			if status == types.ComponentStatusFailed {
+				receipt.Error = reason
+			}
+			return k.receipts.Set(ctx, receiptKey, receipt)
+		}
+		return nil
+	}
+	return errors.Wrapf(types.ErrComponentNotFound, "component %s of will %s", componentID, willID)
+}
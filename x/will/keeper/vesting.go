@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MaxVestingPeriods bounds the number of tranches of a periodic vesting schedule
+const MaxVestingPeriods = 64
+
+// validateVesting checks the beneficiary, amount and schedule of a vesting component
+func validateVesting(vesting *types.VestingComponent) error {
+	if _, err := sdk.AccAddressFromBech32(vesting.To); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "vesting beneficiary %s: %s", vesting.To, err)
+	}
+	if !vesting.Amount.IsValid() || vesting.Amount.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "vesting amount %s", vesting.Amount)
+	}
+	if vesting.StartHeight != 0 || !vesting.Withdrawn.IsZero() {
+		return errors.Wrap(types.ErrInvalid, "the start height and withdrawn coins of a vesting component are set by the chain")
+	}
+	switch schedule := vesting.Schedule.(type) {
+	case *types.VestingComponent_Linear:
+		if schedule.Linear.Blocks <= 0 {
+			return errors.Wrap(types.ErrInvalid, "linear vesting blocks must be positive")
+		}
+	case *types.VestingComponent_Cliff:
+		if schedule.Cliff.CliffBlocks <= 0 || schedule.Cliff.CliffBlocks > schedule.Cliff.Blocks {
+			return errors.Wrapf(types.ErrInvalid, "cliff of %d blocks out of range for %d vesting blocks", schedule.Cliff.CliffBlocks, schedule.Cliff.Blocks)
+		}
+	case *types.VestingComponent_Periodic:
+		periods := schedule.Periodic.Periods
+		if len(periods) == 0 || len(periods) > MaxVestingPeriods {
+			return errors.Wrapf(types.ErrInvalid, "periodic vesting needs 1 to %d periods, got %d", MaxVestingPeriods, len(periods))
+		}
+		var blocks int64
+		total := sdk.NewCoins()
+		for i, period := range periods {
+			if period.Blocks <= 0 || period.Blocks > math.MaxInt64-blocks {
+				return errors.Wrapf(types.ErrInvalid, "vesting period %d blocks %d out of range", i, period.Blocks)
+			}
+			if !period.Amount.IsValid() || period.Amount.IsZero() {
+				return errors.Wrapf(sdkerrors.ErrInvalidCoins, "vesting period %d amount %s", i, period.Amount)
+			}
+			blocks += period.Blocks
+			total = total.Add(period.Amount...)
+		}
+		if !total.Equal(vesting.Amount) {
+			return errors.Wrapf(types.ErrInvalid, "vesting periods add up to %s, not the vesting amount %s", total, vesting.Amount)
+		}
+	default:
+		return errors.Wrap(types.ErrInvalid, "vesting needs a linear, cliff or periodic schedule")
+	}
+	return nil
+}
+
+// VestedCoins returns the coins of a started vesting component that vested by height,
+// including the ones already withdrawn
+func VestedCoins(vesting *types.VestingComponent, height int64) sdk.Coins {
+	elapsed := height - vesting.StartHeight
+	switch schedule := vesting.Schedule.(type) {
+	case *types.VestingComponent_Linear:
+		return linearVested(vesting.Amount, elapsed, schedule.Linear.Blocks)
+	case *types.VestingComponent_Cliff:
+		if elapsed < schedule.Cliff.CliffBlocks {
+			return sdk.NewCoins()
+		}
+		return linearVested(vesting.Amount, elapsed, schedule.Cliff.Blocks)
+	case *types.VestingComponent_Periodic:
+		vested := sdk.NewCoins()
+		for _, period := range schedule.Periodic.Periods {
+			if elapsed < period.Blocks {
+				break
+			}
+			elapsed -= period.Blocks
+			vested = vested.Add(period.Amount...)
+		}
+		return vested
+	}
+	return sdk.NewCoins()
+}
+
+// VestingEndHeight returns the block height everything of a started vesting component vested by
+func VestingEndHeight(vesting *types.VestingComponent) int64 {
+	switch schedule := vesting.Schedule.(type) {
+	case *types.VestingComponent_Linear:
+		return vesting.StartHeight + schedule.Linear.Blocks
+	case *types.VestingComponent_Cliff:
+		return vesting.StartHeight + schedule.Cliff.Blocks
+	case *types.VestingComponent_Periodic:
+		end := vesting.StartHeight
+		for _, period := range schedule.Periodic.Periods {
+			end += period.Blocks
+		}
+		return end
+	}
+	return vesting.StartHeight
+}
+
+// linearVested returns the part of amount that vested after elapsed of blocks, rounded down
+func linearVested(amount sdk.Coins, elapsed, blocks int64) sdk.Coins {
+	if elapsed >= blocks {
+		return amount
+	}
+	vested := sdk.NewCoins()
+	if elapsed <= 0 {
+		return vested
+	}
+	for _, coin := range amount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdkmath.NewInt(elapsed)).Quo(sdkmath.NewInt(blocks))))
+	}
+	return vested
+}
+
+// vestingComponent returns a will and its vesting component with the given id
+func (k Keeper) vestingComponent(ctx context.Context, willID, componentID string) (*types.Will, *types.ExecutionComponent, error) {
+	will, err := k.GetWillByID(ctx, willID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, component := range will.Components {
+		if component.Id != componentID {
+			continue
+		}
+		if component.GetVesting() == nil {
+			return nil, nil, errors.Wrapf(types.ErrInvalid, "component %s of will %s is not a vesting component", componentID, willID)
+		}
+		return will, component, nil
+	}
+	return nil, nil, errors.Wrapf(types.ErrComponentNotFound, "component %s of will %s", componentID, willID)
+}
+
+// startVesting starts the schedule of a vesting component when its will fires
+func startVesting(ctx sdk.Context, component *types.ExecutionComponent) {
+	component.GetVesting().StartHeight = ctx.BlockHeight()
+	component.Status = types.ComponentStatusVesting
+}
+
+/*
+@name WithdrawVested
+@desc pays the coins of a vesting component that vested and were not withdrawn yet out of
+escrow to its beneficiary, the component is executed once everything is withdrawn
+@param msg MsgWithdrawVestedRequest signed by the beneficiary of the component
+*/
+func (k Keeper) WithdrawVested(ctx context.Context, msg *types.MsgWithdrawVestedRequest) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	will, component, err := k.vestingComponent(ctx, msg.WillId, msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+	vesting := component.GetVesting()
+	if vesting.To != msg.Beneficiary {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the beneficiary of component %s can withdraw", component.Id)
+	}
+	if will.Status != types.WillStatusExpired {
+		return nil, errors.Wrapf(types.ErrInvalid, "will %s has not fired", will.ID)
+	}
+	if component.Status != types.ComponentStatusVesting {
+		return nil, errors.Wrapf(types.ErrInvalid, "component %s is %s, not vesting", component.Id, component.Status)
+	}
+	withdrawable, hasNeg := VestedCoins(vesting, sdkCtx.BlockHeight()).SafeSub(vesting.Withdrawn...)
+	if hasNeg || withdrawable.IsZero() {
+		return nil, errors.Wrapf(types.ErrInvalid, "nothing vested to withdraw from component %s", component.Id)
+	}
+	to, err := sdk.AccAddressFromBech32(vesting.To)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "vesting beneficiary %s: %s", vesting.To, err)
+	}
+	if err := k.releaseEscrow(ctx, will.ID, to, withdrawable); err != nil {
+		return nil, err
+	}
+	vesting.Withdrawn = vesting.Withdrawn.Add(withdrawable...)
+	if vesting.Withdrawn.Equal(vesting.Amount) {
+		component.Status = types.ComponentStatusExecuted
+	}
+	if err := k.setWill(ctx, will); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent("will_vesting_withdrawn",
+			sdk.NewAttribute("will_id", will.ID),
+			sdk.NewAttribute("component_id", component.Id),
+			sdk.NewAttribute("to", vesting.To),
+			sdk.NewAttribute("amount", withdrawable.String()),
+		),
+	)
+	return withdrawable, nil
+}
@@ -0,0 +1,53 @@
+/*
+NOTE: Usage of x/params to manage parameters is deprecated in favor of x/gov
+controlled execution of MsgUpdateParams messages. These types remains solely
+for migration purposes and will be removed in a future release.
+*/
+package v2
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+var (
+	ParamStoreKeyMaxWillsPerHeight     = []byte("MaxWillsPerHeight")
+	ParamStoreKeyMaxComponentsPerWill  = []byte("MaxComponentsPerWill")
+	ParamStoreKeyMinTriggerHorizon     = []byte("MinTriggerHorizon")
+	ParamStoreKeyMaxTriggerHorizon     = []byte("MaxTriggerHorizon")
+	ParamStoreKeyCreationDeposit       = []byte("CreationDeposit")
+	ParamStoreKeyEnabledComponentTypes = []byte("EnabledComponentTypes")
+)
+
+// Params wraps the will params with the legacy x/params accessors
+type Params struct {
+	types.Params
+}
+
+// Deprecated: Type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxWillsPerHeight, &p.MaxWillsPerHeight, validateType[uint32]),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxComponentsPerWill, &p.MaxComponentsPerWill, validateType[uint32]),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinTriggerHorizon, &p.MinTriggerHorizon, validateType[int64]),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxTriggerHorizon, &p.MaxTriggerHorizon, validateType[int64]),
+		paramtypes.NewParamSetPair(ParamStoreKeyCreationDeposit, &p.CreationDeposit, validateType[sdk.Coins]),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnabledComponentTypes, &p.EnabledComponentTypes, validateType[[]string]),
+	}
+}
+
+func validateType[T any](i interface{}) error {
+	if _, ok := i.(T); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
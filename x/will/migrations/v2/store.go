@@ -0,0 +1,30 @@
+package v2
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/exported"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MigrateStore migrates the x/will module state from the consensus version 1 to
+// version 2. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params module and stores them directly into the x/will
+// module state. Parameters missing from the legacy subspace take their default.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	currParams := Params{Params: types.DefaultParams()}
+	legacySubspace.GetParamSetIfExists(ctx, &currParams)
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+	bz, err := cdc.Marshal(&currParams.Params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set([]byte(types.ParamsKey), bz)
+}
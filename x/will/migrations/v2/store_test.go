@@ -0,0 +1,92 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CosmWasm/wasmd/x/will"
+	v2 "github.com/CosmWasm/wasmd/x/will/migrations/v2"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig(will.AppModuleBasic{})
+	cdc := cfg.Codec
+	var (
+		willStoreKey    = storetypes.NewKVStoreKey(types.StoreKey)
+		paramsStoreKey  = storetypes.NewKVStoreKey(paramstypes.StoreKey)
+		paramsTStoreKey = storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	)
+	specs := map[string]struct {
+		src *v2.Params
+		exp types.Params
+	}{
+		"empty legacy subspace": {
+			exp: types.DefaultParams(),
+		},
+		"legacy params": {
+			src: &v2.Params{Params: types.Params{
+				MaxWillsPerHeight:     3,
+				MaxComponentsPerWill:  4,
+				MinTriggerHorizon:     5,
+				MaxTriggerHorizon:     600,
+				CreationDeposit:       sdk.NewCoins(sdk.NewInt64Coin("uwill", 7)),
+				EnabledComponentTypes: []string{types.ComponentTypeTransfer},
+			}},
+			exp: types.Params{
+				MaxWillsPerHeight:     3,
+				MaxComponentsPerWill:  4,
+				MinTriggerHorizon:     5,
+				MaxTriggerHorizon:     600,
+				CreationDeposit:       sdk.NewCoins(sdk.NewInt64Coin("uwill", 7)),
+				EnabledComponentTypes: []string{types.ComponentTypeTransfer},
+				// not legacy params, they keep their defaults
+				MaxConditionGas:       types.DefaultMaxConditionGas,
+				MaxBlockExecutionGas:  types.DefaultMaxBlockExecutionGas,
+				ExecutorBountyPercent: types.DefaultExecutorBountyPercent,
+				IBCTimeout:            types.DefaultIBCTimeout,
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			paramsKeeper := paramskeeper.NewKeeper(cdc, cfg.Amino, paramsStoreKey, paramsTStoreKey)
+			ctx := testutil.DefaultContextWithKeys(
+				map[string]*storetypes.KVStoreKey{
+					paramstypes.StoreKey: paramsStoreKey,
+					types.StoreKey:       willStoreKey,
+				},
+				map[string]*storetypes.TransientStoreKey{
+					paramstypes.TStoreKey: paramsTStoreKey,
+				},
+				nil,
+			)
+
+			// register legacy parameters
+			subspace := paramsKeeper.Subspace(types.ModuleName)
+			subspace.WithKeyTable(v2.ParamKeyTable())
+			if spec.src != nil {
+				subspace.SetParamSet(ctx, spec.src)
+			}
+
+			// when
+			require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(willStoreKey), subspace, cdc))
+
+			var res types.Params
+			bz := ctx.KVStore(willStoreKey).Get([]byte(types.ParamsKey))
+			require.NoError(t, cdc.Unmarshal(bz, &res))
+			assert.Equal(t, spec.exp, res)
+		})
+	}
+}
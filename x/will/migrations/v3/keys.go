@@ -0,0 +1,21 @@
+package v3
+
+import "strings"
+
+var (
+	// LegacyWillPrefix is the prefix wills, and the creator and height WillIds
+	// lists, were stored under in consensus version 2
+	LegacyWillPrefix = []byte{0x01}
+	// LegacyEscrowPrefix is the prefix will escrows were stored under in consensus version 2
+	LegacyEscrowPrefix = []byte{0x03}
+)
+
+// LegacyWillKey returns the consensus version 2 store key of a will, creator or height entry
+func LegacyWillKey(id string) []byte {
+	return append(append([]byte{}, LegacyWillPrefix...), []byte(strings.ToLower(id))...)
+}
+
+// LegacyEscrowKey returns the consensus version 2 store key of a will escrow
+func LegacyEscrowKey(willID string) []byte {
+	return append(append([]byte{}, LegacyEscrowPrefix...), []byte(strings.ToLower(willID))...)
+}
@@ -0,0 +1,85 @@
+package v3
+
+import (
+	"bytes"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MigrateStore migrates the x/will module state from the consensus version 2 to
+// version 3. Specifically, it moves the wills and escrows out of the raw prefixed
+// store into collections and drops the WillIds lists of the creator and height
+// indexes, which the wills collection now maintains itself.
+func MigrateStore[I collections.Indexes[string, types.Will]](
+	ctx sdk.Context,
+	storeService corestoretypes.KVStoreService,
+	cdc codec.BinaryCodec,
+	wills *collections.IndexedMap[string, types.Will, I],
+	escrows collections.Map[string, types.WillEscrow],
+) error {
+	store := storeService.OpenKVStore(ctx)
+
+	var (
+		legacyKeys    [][]byte
+		legacyWills   []types.Will
+		legacyEscrows []types.WillEscrow
+	)
+	willIter, err := store.Iterator(LegacyWillPrefix, storetypes.PrefixEndBytes(LegacyWillPrefix))
+	if err != nil {
+		return err
+	}
+	for ; willIter.Valid(); willIter.Next() {
+		legacyKeys = append(legacyKeys, willIter.Key())
+		// the creator and height WillIds lists share the prefix, a will is stored under its own ID
+		var will types.Will
+		if err := cdc.Unmarshal(willIter.Value(), &will); err != nil || will.ID == "" {
+			continue
+		}
+		if bytes.Equal(willIter.Key(), LegacyWillKey(will.ID)) {
+			legacyWills = append(legacyWills, will)
+		}
+	}
+	if err := willIter.Close(); err != nil {
+		return err
+	}
+
+	escrowIter, err := store.Iterator(LegacyEscrowPrefix, storetypes.PrefixEndBytes(LegacyEscrowPrefix))
+	if err != nil {
+		return err
+	}
+	for ; escrowIter.Valid(); escrowIter.Next() {
+		legacyKeys = append(legacyKeys, escrowIter.Key())
+		var escrow types.WillEscrow
+		if err := cdc.Unmarshal(escrowIter.Value(), &escrow); err != nil {
+			return err
+		}
+		legacyEscrows = append(legacyEscrows, escrow)
+	}
+	if err := escrowIter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range legacyKeys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	for _, will := range legacyWills {
+		if err := wills.Set(ctx, will.ID, will); err != nil {
+			return err
+		}
+	}
+	for _, escrow := range legacyEscrows {
+		if err := escrows.Set(ctx, escrow.WillId, escrow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmWasm/wasmd/x/will"
+	"github.com/CosmWasm/wasmd/x/will/keeper"
+	v3 "github.com/CosmWasm/wasmd/x/will/migrations/v3"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(will.AppModuleBasic{}).Codec
+	willStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(willStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(willStoreKey)
+	store := ctx.KVStore(willStoreKey)
+
+	creator := sdk.AccAddress("migrate-creator_____").String()
+	beneficiary := sdk.AccAddress("migrate-beneficiary_").String()
+	live := types.Will{ID: "did:will:aa", Creator: creator, Beneficiary: beneficiary, Height: 10, Status: types.WillStatusLive}
+	expired := types.Will{ID: "did:will:bb", Creator: creator, Beneficiary: beneficiary, Height: 5, Status: types.WillStatusExpired}
+	escrow := types.WillEscrow{WillId: live.ID, Coins: sdk.NewCoins(sdk.NewInt64Coin("uwill", 3))}
+
+	// consensus version 2 layout: wills and the WillIds lists share one prefix
+	store.Set(v3.LegacyWillKey(live.ID), cdc.MustMarshal(&live))
+	store.Set(v3.LegacyWillKey(expired.ID), cdc.MustMarshal(&expired))
+	store.Set(v3.LegacyWillKey(creator), cdc.MustMarshal(&types.WillIds{Ids: []string{live.ID, expired.ID}}))
+	store.Set(v3.LegacyWillKey("10"), cdc.MustMarshal(&types.WillIds{Ids: []string{live.ID}}))
+	store.Set(v3.LegacyEscrowKey(escrow.WillId), cdc.MustMarshal(&escrow))
+	store.Set(types.PortKey, []byte(types.ModuleName))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	wills := keeper.NewWillsMap(sb, cdc)
+	escrows := keeper.NewEscrowsMap(sb, cdc)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc, wills, escrows))
+
+	// then the legacy entries are gone
+	for _, prefix := range [][]byte{v3.LegacyWillPrefix, v3.LegacyEscrowPrefix} {
+		iter := storetypes.KVStorePrefixIterator(store, prefix)
+		assert.False(t, iter.Valid())
+		require.NoError(t, iter.Close())
+	}
+	assert.Equal(t, []byte(types.ModuleName), store.Get(types.PortKey))
+
+	// and the wills are indexed
+	gotLive, err := wills.Get(ctx, live.ID)
+	require.NoError(t, err)
+	assert.Equal(t, live, gotLive)
+
+	ids, err := wills.Indexes.Creator.WillIDs(ctx, creator)
+	require.NoError(t, err)
+	assert.Equal(t, []string{live.ID, expired.ID}, ids)
+
+	ids, err = wills.Indexes.Height.WillIDs(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{live.ID}, ids)
+
+	gotEscrow, err := escrows.Get(ctx, live.ID)
+	require.NoError(t, err)
+	assert.Equal(t, escrow, gotEscrow)
+}
@@ -0,0 +1,52 @@
+package v4
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MigrateStore migrates the x/will module state from the consensus version 3 to
+// version 4. Specifically, it decodes the public keys of Schnorr claim components,
+// which were stored as hex text, into the raw 32 byte keys claims now verify against.
+func MigrateStore[I collections.Indexes[string, types.Will]](
+	ctx sdk.Context,
+	wills *collections.IndexedMap[string, types.Will, I],
+) error {
+	var migrated []types.Will
+	err := wills.Walk(ctx, nil, func(_ string, will types.Will) (bool, error) {
+		if decodeSchnorrKeys(&will) {
+			migrated = append(migrated, will)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, will := range migrated {
+		if err := wills.Set(ctx, will.ID, will); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeSchnorrKeys decodes the hex text public keys of a will's Schnorr claim
+// components in place. Keys that are not hex text of 32 bytes are left as they are.
+func decodeSchnorrKeys(will *types.Will) (changed bool) {
+	for _, component := range will.Components {
+		stored := component.GetClaim().GetSchnorr()
+		if stored == nil || len(stored.PublicKey) != 2*32 {
+			continue
+		}
+		if key, err := hex.DecodeString(string(stored.PublicKey)); err == nil {
+			stored.PublicKey = key
+			changed = true
+		}
+	}
+	return changed
+}
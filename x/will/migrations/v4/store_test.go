@@ -0,0 +1,68 @@
+package v4_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmWasm/wasmd/x/will"
+	"github.com/CosmWasm/wasmd/x/will/keeper"
+	v4 "github.com/CosmWasm/wasmd/x/will/migrations/v4"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(will.AppModuleBasic{}).Codec
+	willStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(willStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(willStoreKey))
+	wills := keeper.NewWillsMap(sb, cdc)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	schnorrComponent := func(id string, publicKey []byte) *types.ExecutionComponent {
+		return &types.ExecutionComponent{
+			Id: id,
+			ComponentType: &types.ExecutionComponent_Claim{Claim: &types.ClaimComponent{
+				SchemeType: &types.ClaimComponent_Schnorr{Schnorr: &types.SchnorrSignature{PublicKey: publicKey}},
+			}},
+		}
+	}
+	creator := sdk.AccAddress("migrate-creator_____").String()
+	hexKeys := types.Will{ID: "did:will:aa", Creator: creator, Height: 10, Status: types.WillStatusLive, Components: []*types.ExecutionComponent{
+		schnorrComponent("hex", []byte(hex.EncodeToString(key))),
+		schnorrComponent("raw", key),
+		{Id: "transfer", ComponentType: &types.ExecutionComponent_Transfer{Transfer: &types.TransferComponent{To: creator}}},
+	}}
+	untouched := types.Will{ID: "did:will:bb", Creator: creator, Height: 10, Status: types.WillStatusLive, Components: []*types.ExecutionComponent{
+		schnorrComponent("not hex", []byte("zz23456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")),
+	}}
+	require.NoError(t, wills.Set(ctx, hexKeys.ID, hexKeys))
+	require.NoError(t, wills.Set(ctx, untouched.ID, untouched))
+
+	// when
+	require.NoError(t, v4.MigrateStore(ctx, wills))
+
+	// then the hex text keys are decoded
+	got, err := wills.Get(ctx, hexKeys.ID)
+	require.NoError(t, err)
+	assert.Equal(t, key, got.Components[0].GetClaim().GetSchnorr().PublicKey)
+	assert.Equal(t, key, got.Components[1].GetClaim().GetSchnorr().PublicKey)
+	assert.Equal(t, hexKeys.Components[2], got.Components[2])
+
+	got, err = wills.Get(ctx, untouched.ID)
+	require.NoError(t, err)
+	assert.Equal(t, untouched, got)
+}
@@ -0,0 +1,45 @@
+package v5
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MigrateStore migrates the x/will module state from the consensus version 4 to
+// version 5. Specifically, it indexes the live wills that trigger at a height by
+// their trigger height, which the execution queue schedules them by, and sets the
+// block execution gas, executor bounty and IBC timeout params to their defaults.
+func MigrateStore[I collections.Indexes[string, types.Will]](
+	ctx sdk.Context,
+	wills *collections.IndexedMap[string, types.Will, I],
+	params collections.Item[types.Params],
+) error {
+	var live []types.Will
+	err := wills.Walk(ctx, nil, func(_ string, will types.Will) (bool, error) {
+		if will.Status == types.WillStatusLive && will.TriggerTime == nil && will.Condition == nil {
+			live = append(live, will)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	// storing a will again rebuilds its index entries
+	for _, will := range live {
+		if err := wills.Set(ctx, will.ID, will); err != nil {
+			return err
+		}
+	}
+
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	p.MaxBlockExecutionGas = types.DefaultMaxBlockExecutionGas
+	p.ExecutorBountyPercent = types.DefaultExecutorBountyPercent
+	p.IBCTimeout = types.DefaultIBCTimeout
+	return params.Set(ctx, p)
+}
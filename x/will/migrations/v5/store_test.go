@@ -0,0 +1,85 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmWasm/wasmd/x/will"
+	"github.com/CosmWasm/wasmd/x/will/keeper"
+	v5 "github.com/CosmWasm/wasmd/x/will/migrations/v5"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(will.AppModuleBasic{}).Codec
+	willStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(willStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(willStoreKey))
+	wills := keeper.NewWillsMap(sb, cdc)
+	params := collections.NewItem(sb, collections.NewPrefix(types.ParamsKey), "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	legacyParams := types.DefaultParams()
+	legacyParams.MaxBlockExecutionGas = 0
+	legacyParams.ExecutorBountyPercent = 0
+	legacyParams.IBCTimeout = 0
+	require.NoError(t, params.Set(ctx, legacyParams))
+
+	creator := sdk.AccAddress("migrate-creator_____").String()
+	triggerTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := []types.Will{
+		{ID: "did:will:aa", Creator: creator, Height: 10, Status: types.WillStatusLive},
+		{ID: "did:will:bb", Creator: creator, Height: 5, Status: types.WillStatusExpired},
+		{ID: "did:will:cc", Creator: creator, TriggerTime: &triggerTime, Status: types.WillStatusLive},
+	}
+	// the wills were stored before the trigger height index existed
+	for _, w := range stored {
+		require.NoError(t, wills.Set(ctx, w.ID, w))
+	}
+	store := runtime.NewKVStoreService(willStoreKey).OpenKVStore(ctx)
+	iter, err := store.Iterator(types.WillsByTriggerHeightPrefix, storetypes.PrefixEndBytes(types.WillsByTriggerHeightPrefix))
+	require.NoError(t, err)
+	var indexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+	}
+	require.NoError(t, iter.Close())
+	for _, key := range indexKeys {
+		require.NoError(t, store.Delete(key))
+	}
+	ids, err := wills.Indexes.TriggerHeight.WillIDsUntil(ctx, 100, 10)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	// when
+	require.NoError(t, v5.MigrateStore(ctx, wills, params))
+
+	// then only the live will triggering at a height is indexed
+	ids, err = wills.Indexes.TriggerHeight.WillIDsUntil(ctx, 100, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"did:will:aa"}, ids)
+	for _, w := range stored {
+		got, err := wills.Get(ctx, w.ID)
+		require.NoError(t, err)
+		assert.Equal(t, w, got)
+	}
+
+	// and the new params get their defaults
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultParams(), got)
+}
@@ -0,0 +1,35 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+// MigrateStore migrates the x/will module state from the consensus version 5 to
+// version 6. Specifically, it drops the guardian approvals recorded before they
+// were keyed by the claimer whose claim they approved. They cannot be told apart
+// by claimer, so the guardians approve the pending claims again.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService) error {
+	store := storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.ApprovalsPrefix, storetypes.PrefixEndBytes(types.ApprovalsPrefix))
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	"github.com/CosmWasm/wasmd/x/will/keeper"
+	v6 "github.com/CosmWasm/wasmd/x/will/migrations/v6"
+	"github.com/CosmWasm/wasmd/x/will/types"
+)
+
+func TestMigrate(t *testing.T) {
+	willStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(willStoreKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(willStoreKey)
+
+	// approvals keyed by will ID, component ID and guardian index, without the claimer
+	legacy := collections.NewSchemaBuilder(storeService)
+	legacyApprovals := collections.NewKeySet(legacy, types.ApprovalsPrefix, "approvals", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint32Key))
+	nullifiers := keeper.NewNullifiersSet(legacy)
+	_, err := legacy.Build()
+	require.NoError(t, err)
+	require.NoError(t, legacyApprovals.Set(ctx, collections.Join3("did:will:aa", "guardians", uint32(0))))
+	require.NoError(t, legacyApprovals.Set(ctx, collections.Join3("did:will:bb", "guardians", uint32(2))))
+	require.NoError(t, nullifiers.Set(ctx, collections.Join(types.ClaimSchemeThresholdSchnorr, []byte("signature"))))
+
+	// when
+	require.NoError(t, v6.MigrateStore(ctx, storeService))
+
+	// then the approvals are dropped and the nullifiers of their signatures kept
+	sb := collections.NewSchemaBuilder(storeService)
+	approvals := keeper.NewApprovalsSet(sb)
+	_, err = sb.Build()
+	require.NoError(t, err)
+	iter, err := approvals.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	used, err := nullifiers.Has(ctx, collections.Join(types.ClaimSchemeThresholdSchnorr, []byte("signature")))
+	require.NoError(t, err)
+	assert.True(t, used)
+}
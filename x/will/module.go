@@ -24,6 +24,7 @@ import (
 	// "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/simulation"
 	"github.com/CosmWasm/wasmd/x/will/client/cli"
+	"github.com/CosmWasm/wasmd/x/will/exported"
 	"github.com/CosmWasm/wasmd/x/will/keeper"
 	"github.com/CosmWasm/wasmd/x/will/types"
 )
@@ -39,21 +40,31 @@ type AppModule struct {
 	AppModuleBasic
 	cdc    codec.Codec
 	keeper *keeper.Keeper
+	// legacySubspace is used solely for migration of x/params managed parameters
+	legacySubspace exported.Subspace
 }
 
 func NewAppModule(
 	cdc codec.Codec,
 	keeper *keeper.Keeper,
 	logger log.Logger,
+	ss exported.Subspace,
 ) AppModule {
 	Main()
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		cdc:            cdc,
 		keeper:         keeper,
+		legacySubspace: ss,
 	}
 }
 
+// ConsensusVersion is a sequence number for state-breaking change of the
+// module. It should be incremented on each consensus-breaking change
+// introduced by the module. To avoid wrong/empty versions, the initial version
+// should be set to 1.
+func (AppModule) ConsensusVersion() uint64 { return 6 }
+
 // Name returns the wasm module's name.
 func (AppModuleBasic) Name() string {
 	return types.ModuleName
@@ -86,12 +97,27 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewGrpcQuerier(am.keeper))
 
-	// for migrations!
-	// m := keeper.NewMigrator(*am.keeper, am.legacySubspace)
-	// err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
-	// if err != nil {
-	// 	panic(err)
-	// }
+	m := keeper.NewMigrator(*am.keeper, am.legacySubspace)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -226,10 +252,7 @@ func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
 // DefaultGenesis returns default genesis state as raw bytes for the delay module.
 func (amb AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	fmt.Println("INSIDE WILL DEFAULT GENESIS")
-	return cdc.MustMarshalJSON(&types.GenesisState{
-		Params: types.DefaultParams(),
-		PortId: amb.Name(),
-	})
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation for the delay module.
@@ -238,7 +261,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal %s genesis state", types.ModuleName)
 	}
-	return nil
+	return genState.Validate()
 }
 
 // InitGenesis performs genesis initialization for the delay module.
@@ -247,7 +270,9 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 	cdc.MustUnmarshalJSON(gs, &genState)
 
 	// keeper.InitGenesis(ctx, cdc, gs)
-	keeper.InitGenesis(ctx, am.keeper, genState)
+	if _, err := keeper.InitGenesis(ctx, am.keeper, genState); err != nil {
+		panic(err)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
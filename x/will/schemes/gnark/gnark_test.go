@@ -0,0 +1,68 @@
+//go:build gnark
+
+package gnark
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	willgroth16 "github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16/groth16testing"
+)
+
+// TestGnarkProofsVerifyOnChain builds keys and proofs with gnark and verifies them with the
+// will module's groth16 package. It only runs with the gnark build tag.
+func TestGnarkProofsVerifyOnChain(t *testing.T) {
+	var circuit Circuit
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit)
+	require.NoError(t, err)
+	pk, vk, err := groth16.Setup(ccs)
+	require.NoError(t, err)
+
+	secret := big.NewInt(0xdeadf00d)
+	claimer := "wasm1claimer"
+	assignment := Circuit{
+		Secret:  secret,
+		Hash:    groth16testing.MiMC(secret),
+		Claimer: willgroth16.ClaimerInput(claimer),
+	}
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	require.NoError(t, err)
+	publicWitness, err := witness.Public()
+	require.NoError(t, err)
+	// proving fails unless the hash of the will module matches gnark's MiMC
+	proof, err := groth16.Prove(ccs, pk, witness)
+	require.NoError(t, err)
+	require.NoError(t, groth16.Verify(proof, vk, publicWitness))
+
+	var vkBz, proofBz bytes.Buffer
+	_, err = vk.WriteRawTo(&vkBz)
+	require.NoError(t, err)
+	_, err = proof.WriteRawTo(&proofBz)
+	require.NoError(t, err)
+	publicBz, err := publicWitness.MarshalBinary()
+	require.NoError(t, err)
+
+	onChainVK, err := willgroth16.UnmarshalVerifyingKey(vkBz.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 2, onChainVK.NumPublicInputs())
+	assert.Equal(t, 2, willgroth16.DeclaredPublicInputs(vkBz.Bytes()))
+	onChainProof, err := willgroth16.UnmarshalProof(proofBz.Bytes())
+	require.NoError(t, err)
+	inputs, err := willgroth16.UnmarshalPublicInputs(publicBz)
+	require.NoError(t, err)
+	assert.Equal(t, []*big.Int{groth16testing.MiMC(secret), willgroth16.ClaimerInput(claimer)}, inputs)
+
+	require.NoError(t, willgroth16.Verify(onChainVK, onChainProof, inputs))
+	// the proof is bound to its claimer and its hash
+	require.ErrorIs(t, willgroth16.Verify(onChainVK, onChainProof, []*big.Int{inputs[0], willgroth16.ClaimerInput("wasm1other")}), willgroth16.ErrInvalidProof)
+	require.ErrorIs(t, willgroth16.Verify(onChainVK, onChainProof, []*big.Int{groth16testing.MiMC(big.NewInt(1)), inputs[1]}), willgroth16.ErrInvalidProof)
+}
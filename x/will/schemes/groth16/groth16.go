@@ -0,0 +1,321 @@
+// Package groth16 verifies Groth16 zk-SNARK proofs over the BN254 curve, the
+// proving system gnark uses for GnarkZkSnark claim components.
+//
+// Points use the uncompressed big endian encoding shared by gnark's RawBytes and
+// the EIP-197 precompiles: a G1 point is X|Y (64 bytes), a G2 point is
+// X.A1|X.A0|Y.A1|Y.A0 (128 bytes) and the point at infinity is all zeros.
+//
+// A verifying key is [α]1 [β]1 [β]2 [γ]2 [δ]1 [δ]2 followed by a uint32 count
+// and that many [K]1 points, the order gnark's VerifyingKey.WriteRawTo uses. A
+// proof is [A]1 [B]2 [C]1, the order of gnark's Proof.WriteRawTo. Circuits that
+// use gnark commitments are not supported, so any trailer gnark writes after
+// these fields to describe commitments must be all zeros.
+//
+// Public inputs are either concatenated 32 byte big endian field elements or a
+// gnark public witness as written by MarshalBinary. The last public input of a
+// GnarkZkSnark circuit is the ClaimerInput of the address claiming with the
+// proof, so a proof seen by others cannot be claimed with.
+package groth16
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bn256 "github.com/umbracle/go-eth-bn256"
+)
+
+const (
+	// G1Size is the length of an encoded G1 point
+	G1Size = 64
+	// G2Size is the length of an encoded G2 point
+	G2Size = 128
+	// FrSize is the length of an encoded scalar field element
+	FrSize = 32
+	// ProofSize is the length of an encoded proof
+	ProofSize = 2*G1Size + G2Size
+	// NumPairings is the number of pairings a verification computes
+	NumPairings = 4
+
+	// gnark public witness header: uint32 public count, uint32 secret count, uint32 vector length
+	witnessHeaderSize = 12
+
+	// ClaimerDomain separates the public inputs derived from a claimer address
+	ClaimerDomain = "w3ll/will/gnark/claimer/v1"
+)
+
+var (
+	// ErrMalformed is returned for keys, proofs and inputs that cannot be decoded
+	ErrMalformed = errors.New("groth16: malformed encoding")
+	// ErrInvalidProof is returned when a well formed proof does not verify
+	ErrInvalidProof = errors.New("groth16: invalid proof")
+)
+
+// Order is the order of the BN254 groups and of the scalar field public inputs live in
+var Order = new(big.Int).Set(bn256.Order)
+
+// VerifyingKey is a Groth16 verifying key. Beta1 and Delta1 are not needed to
+// verify but are kept so keys written by gnark round trip.
+type VerifyingKey struct {
+	Alpha1 *bn256.G1
+	Beta1  *bn256.G1
+	Beta2  *bn256.G2
+	Gamma2 *bn256.G2
+	Delta1 *bn256.G1
+	Delta2 *bn256.G2
+	// K holds one point for the constant one wire followed by one per public input
+	K []*bn256.G1
+}
+
+// NumPublicInputs returns the number of public inputs a proof for the key takes
+func (vk *VerifyingKey) NumPublicInputs() int {
+	return len(vk.K) - 1
+}
+
+// FreeInputs returns the indices of the public inputs whose K point is the point at
+// infinity. Such an input drops out of the verification, any value of it verifies.
+func (vk *VerifyingKey) FreeInputs() []int {
+	var free []int
+	for i, k := range vk.K[1:] {
+		if allZero(k.Marshal()) {
+			free = append(free, i)
+		}
+	}
+	return free
+}
+
+// DeclaredPublicInputs reads the number of public inputs an encoded verifying key
+// declares without decoding its points, or 0 if the encoding is too short.
+func DeclaredPublicInputs(bz []byte) int {
+	const offset = 3*G1Size + 3*G2Size
+	if len(bz) < offset+4 {
+		return 0
+	}
+	n := int64(binary.BigEndian.Uint32(bz[offset:])) - 1
+	if n < 0 || n*G1Size > int64(len(bz)-offset-4) {
+		return 0
+	}
+	return int(n)
+}
+
+// Proof is a Groth16 proof
+type Proof struct {
+	A *bn256.G1
+	B *bn256.G2
+	C *bn256.G1
+}
+
+// Marshal encodes the verifying key
+func (vk *VerifyingKey) Marshal() []byte {
+	bz := make([]byte, 0, 3*G1Size+3*G2Size+4+len(vk.K)*G1Size)
+	bz = append(bz, vk.Alpha1.Marshal()...)
+	bz = append(bz, vk.Beta1.Marshal()...)
+	bz = append(bz, marshalG2(vk.Beta2)...)
+	bz = append(bz, marshalG2(vk.Gamma2)...)
+	bz = append(bz, vk.Delta1.Marshal()...)
+	bz = append(bz, marshalG2(vk.Delta2)...)
+	bz = binary.BigEndian.AppendUint32(bz, uint32(len(vk.K)))
+	for _, k := range vk.K {
+		bz = append(bz, k.Marshal()...)
+	}
+	return bz
+}
+
+// UnmarshalVerifyingKey decodes a verifying key and checks every point is on its curve and in the prime order subgroup
+func UnmarshalVerifyingKey(bz []byte) (*VerifyingKey, error) {
+	var vk VerifyingKey
+	d := decoder{bz: bz}
+	vk.Alpha1 = d.g1()
+	vk.Beta1 = d.g1()
+	vk.Beta2 = d.g2()
+	vk.Gamma2 = d.g2()
+	vk.Delta1 = d.g1()
+	vk.Delta2 = d.g2()
+	n := d.uint32()
+	if d.err == nil && uint64(n)*G1Size > uint64(len(d.bz)) {
+		return nil, fmt.Errorf("%w: verifying key declares %d K points, %d bytes are left", ErrMalformed, n, len(d.bz))
+	}
+	if d.err == nil && n == 0 {
+		return nil, fmt.Errorf("%w: verifying key has no K points", ErrMalformed)
+	}
+	for i := uint32(0); i < n && d.err == nil; i++ {
+		vk.K = append(vk.K, d.g1())
+	}
+	if err := d.finish("verifying key"); err != nil {
+		return nil, err
+	}
+	return &vk, nil
+}
+
+// Marshal encodes the proof
+func (p *Proof) Marshal() []byte {
+	bz := make([]byte, 0, ProofSize)
+	bz = append(bz, p.A.Marshal()...)
+	bz = append(bz, marshalG2(p.B)...)
+	return append(bz, p.C.Marshal()...)
+}
+
+// UnmarshalProof decodes a proof and checks every point is on its curve and in the prime order subgroup
+func UnmarshalProof(bz []byte) (*Proof, error) {
+	d := decoder{bz: bz}
+	p := Proof{A: d.g1(), B: d.g2(), C: d.g1()}
+	if err := d.finish("proof"); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ClaimerInput is the public input that binds a proof to the claimer's address: its
+// domain separated SHA-256 hash reduced modulo the field order.
+func ClaimerInput(claimer string) *big.Int {
+	digest := sha256.Sum256(append([]byte(ClaimerDomain), claimer...))
+	return new(big.Int).Mod(new(big.Int).SetBytes(digest[:]), Order)
+}
+
+// MarshalPublicInputs encodes public inputs as concatenated 32 byte big endian field elements
+func MarshalPublicInputs(inputs []*big.Int) []byte {
+	bz := make([]byte, len(inputs)*FrSize)
+	for i, input := range inputs {
+		input.FillBytes(bz[i*FrSize : (i+1)*FrSize])
+	}
+	return bz
+}
+
+// UnmarshalPublicInputs decodes public inputs given either as concatenated field
+// elements or as a gnark public witness, and checks each is a canonical field element.
+func UnmarshalPublicInputs(bz []byte) ([]*big.Int, error) {
+	if len(bz)%FrSize == witnessHeaderSize {
+		n := uint32(len(bz) / FrSize)
+		nbPublic := binary.BigEndian.Uint32(bz[0:4])
+		nbSecret := binary.BigEndian.Uint32(bz[4:8])
+		length := binary.BigEndian.Uint32(bz[8:12])
+		if nbPublic != n || nbSecret != 0 || length != n {
+			return nil, fmt.Errorf("%w: public witness header (%d public, %d secret, %d values) does not match its %d values", ErrMalformed, nbPublic, nbSecret, length, n)
+		}
+		bz = bz[witnessHeaderSize:]
+	}
+	if len(bz)%FrSize != 0 {
+		return nil, fmt.Errorf("%w: public inputs of %d bytes are not a multiple of %d", ErrMalformed, len(bz), FrSize)
+	}
+	inputs := make([]*big.Int, 0, len(bz)/FrSize)
+	for i := 0; i < len(bz); i += FrSize {
+		input := new(big.Int).SetBytes(bz[i : i+FrSize])
+		if input.Cmp(Order) >= 0 {
+			return nil, fmt.Errorf("%w: public input %d is not reduced modulo the field order", ErrMalformed, i/FrSize)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// Verify checks e(A, B) = e(α, β)·e(Σ inputs·K, γ)·e(C, δ)
+func Verify(vk *VerifyingKey, proof *Proof, inputs []*big.Int) error {
+	if len(inputs) != vk.NumPublicInputs() {
+		return fmt.Errorf("%w: got %d public inputs, the verifying key takes %d", ErrMalformed, len(inputs), vk.NumPublicInputs())
+	}
+	vkX := new(bn256.G1).Set(vk.K[0])
+	for i, input := range inputs {
+		if input.Sign() < 0 || input.Cmp(Order) >= 0 {
+			return fmt.Errorf("%w: public input %d is not a field element", ErrMalformed, i)
+		}
+		vkX.Add(vkX, new(bn256.G1).ScalarMult(vk.K[i+1], input))
+	}
+	negA := new(bn256.G1).Neg(proof.A)
+	if !bn256.PairingCheck(
+		[]*bn256.G1{negA, vk.Alpha1, vkX, proof.C},
+		[]*bn256.G2{proof.B, vk.Beta2, vk.Gamma2, vk.Delta2},
+	) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// decoder reads points off a byte slice and remembers the first error
+type decoder struct {
+	bz  []byte
+	err error
+}
+
+func (d *decoder) g1() *bn256.G1 {
+	p := new(bn256.G1)
+	if d.err != nil {
+		return p
+	}
+	if len(d.bz) < G1Size {
+		d.err = fmt.Errorf("%w: truncated G1 point", ErrMalformed)
+		return p
+	}
+	if _, err := p.Unmarshal(d.bz[:G1Size]); err != nil {
+		d.err = fmt.Errorf("%w: %s", ErrMalformed, err)
+		return p
+	}
+	d.bz = d.bz[G1Size:]
+	return p
+}
+
+func (d *decoder) g2() *bn256.G2 {
+	p := new(bn256.G2)
+	if d.err != nil {
+		return p
+	}
+	if len(d.bz) < G2Size {
+		d.err = fmt.Errorf("%w: truncated G2 point", ErrMalformed)
+		return p
+	}
+	if _, err := p.Unmarshal(d.bz[:G2Size]); err != nil {
+		d.err = fmt.Errorf("%w: %s", ErrMalformed, err)
+		return p
+	}
+	// the twist has a cofactor, so points on it are not necessarily in G2
+	if !allZero(marshalG2(new(bn256.G2).ScalarMult(p, Order))) {
+		d.err = fmt.Errorf("%w: G2 point is not in the prime order subgroup", ErrMalformed)
+		return p
+	}
+	d.bz = d.bz[G2Size:]
+	return p
+}
+
+func (d *decoder) uint32() uint32 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.bz) < 4 {
+		d.err = fmt.Errorf("%w: truncated length", ErrMalformed)
+		return 0
+	}
+	n := binary.BigEndian.Uint32(d.bz)
+	d.bz = d.bz[4:]
+	return n
+}
+
+// finish returns the first decoding error, or an error if a non zero trailer is left
+func (d *decoder) finish(what string) error {
+	if d.err != nil {
+		return fmt.Errorf("%s: %w", what, d.err)
+	}
+	if !allZero(d.bz) {
+		return fmt.Errorf("%s: %w: %d trailing bytes describe gnark commitments, which are not supported", what, ErrMalformed, len(d.bz))
+	}
+	return nil
+}
+
+// marshalG2 encodes a G2 point, padding the point at infinity to its full size
+func marshalG2(p *bn256.G2) []byte {
+	bz := p.Marshal()
+	if len(bz) != G2Size {
+		return make([]byte, G2Size)
+	}
+	return bz
+}
+
+// allZero reports whether every byte is zero, which encodes the point at infinity
+func allZero(bz []byte) bool {
+	for _, b := range bz {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
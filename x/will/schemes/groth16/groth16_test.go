@@ -0,0 +1,82 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bn256 "github.com/umbracle/go-eth-bn256"
+)
+
+func TestFreeInputs(t *testing.T) {
+	g1 := func(x int64) *bn256.G1 { return new(bn256.G1).ScalarBaseMult(big.NewInt(x)) }
+	vk := &VerifyingKey{K: []*bn256.G1{g1(1), g1(2), g1(3)}}
+	assert.Empty(t, vk.FreeInputs())
+
+	// a zero K point drops its input from the verification equation
+	vk.K[2] = g1(0)
+	assert.Equal(t, []int{1}, vk.FreeInputs())
+	vk.K[1] = g1(0)
+	assert.Equal(t, []int{0, 1}, vk.FreeInputs())
+
+	// the constant one wire is not an input
+	vk = &VerifyingKey{K: []*bn256.G1{g1(0), g1(2)}}
+	assert.Empty(t, vk.FreeInputs())
+}
+
+func TestUnmarshalMalformed(t *testing.T) {
+	offCurve := make([]byte, G1Size)
+	offCurve[G1Size-1] = 1
+	notReduced := make([]byte, FrSize)
+	Order.FillBytes(notReduced)
+
+	specs := map[string]func() error{
+		"empty proof": func() error {
+			_, err := UnmarshalProof(nil)
+			return err
+		},
+		"truncated proof": func() error {
+			_, err := UnmarshalProof(make([]byte, ProofSize-1))
+			return err
+		},
+		"point off the curve": func() error {
+			_, err := UnmarshalProof(append(offCurve, make([]byte, ProofSize-G1Size)...))
+			return err
+		},
+		"non zero trailer": func() error {
+			_, err := UnmarshalProof(append(make([]byte, ProofSize), 1))
+			return err
+		},
+		"verifying key without K": func() error {
+			_, err := UnmarshalVerifyingKey(make([]byte, 3*G1Size+3*G2Size+4))
+			return err
+		},
+		"verifying key declaring too many K": func() error {
+			bz := binary.BigEndian.AppendUint32(make([]byte, 3*G1Size+3*G2Size), 1<<31)
+			_, err := UnmarshalVerifyingKey(bz)
+			return err
+		},
+		"public inputs not a multiple of 32 bytes": func() error {
+			_, err := UnmarshalPublicInputs(make([]byte, FrSize+1))
+			return err
+		},
+		"public input not reduced": func() error {
+			_, err := UnmarshalPublicInputs(notReduced)
+			return err
+		},
+		"public witness with secret values": func() error {
+			bz := binary.BigEndian.AppendUint32(nil, 1)
+			bz = binary.BigEndian.AppendUint32(bz, 1)
+			bz = binary.BigEndian.AppendUint32(bz, 1)
+			_, err := UnmarshalPublicInputs(append(bz, make([]byte, FrSize)...))
+			return err
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, spec(), ErrMalformed)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package groth16testing
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+)
+
+func TestMiMC(t *testing.T) {
+	// test vectors of gnark-crypto's ecc/bn254/fr/mimc
+	in, _ := new(big.Int).SetString("105afe02a0f7648bee1669b05bf7ae69a37dbb6c86ebbee325dffe97ac1f8e64", 16)
+	out, _ := new(big.Int).SetString("263b9e754e6c611d646e65b16c48f51ab7bc0abedfae9c6ea04e2814ed28daf4", 16)
+	assert.Equal(t, out, MiMC(in))
+
+	in1, _ := new(big.Int).SetString("208f0b283064057cf912b65eaa51e2cb2b85fdbe2fd0b2841f4bca59321ef1bf", 16)
+	in2, _ := new(big.Int).SetString("226bee7671296d05c998a5b5b4b1d25f478696d5997ba4f4be1a682c56a69e11", 16)
+	out, _ = new(big.Int).SetString("1476ada1433d73817a69e45c84c5d452ad858f2dfdb1f7e4da203d3c4fd42222", 16)
+	assert.Equal(t, out, MiMC(in1, in2))
+
+	// the hash of the secret of gnark's MiMC playground circuit
+	out, _ = new(big.Int).SetString("1037254799353855871006189384309576393135431139055333626960622147300727796413", 10)
+	assert.Equal(t, out, MiMC(big.NewInt(0xdeadf00d)))
+}
+
+func TestProveAndVerifyMiMC(t *testing.T) {
+	cs := MiMCCircuit()
+	pk, vk, err := Setup(cs, nil)
+	require.NoError(t, err)
+
+	secret := big.NewInt(0xdeadf00d)
+	claimer := "cosmos1claimer"
+	witness, err := MiMCWitness(secret, claimer)
+	require.NoError(t, err)
+	proof, err := Prove(cs, pk, witness, nil)
+	require.NoError(t, err)
+
+	// everything round trips through its encoding
+	vk, err = groth16.UnmarshalVerifyingKey(vk.Marshal())
+	require.NoError(t, err)
+	proof, err = groth16.UnmarshalProof(proof.Marshal())
+	require.NoError(t, err)
+	inputs, err := groth16.UnmarshalPublicInputs(groth16.MarshalPublicInputs(cs.PublicInputs(witness)))
+	require.NoError(t, err)
+	assert.Equal(t, []*big.Int{MiMC(secret), groth16.ClaimerInput(claimer)}, inputs)
+
+	require.NoError(t, groth16.Verify(vk, proof, inputs))
+
+	t.Run("gnark public witness", func(t *testing.T) {
+		bz := binary.BigEndian.AppendUint32(nil, 2)
+		bz = binary.BigEndian.AppendUint32(bz, 0)
+		bz = binary.BigEndian.AppendUint32(bz, 2)
+		bz = append(bz, groth16.MarshalPublicInputs(inputs)...)
+		got, err := groth16.UnmarshalPublicInputs(bz)
+		require.NoError(t, err)
+		assert.Equal(t, inputs, got)
+	})
+	t.Run("other hash", func(t *testing.T) {
+		require.ErrorIs(t, groth16.Verify(vk, proof, []*big.Int{MiMC(big.NewInt(1)), groth16.ClaimerInput(claimer)}), groth16.ErrInvalidProof)
+	})
+	t.Run("other claimer", func(t *testing.T) {
+		require.ErrorIs(t, groth16.Verify(vk, proof, []*big.Int{MiMC(secret), groth16.ClaimerInput("cosmos1other")}), groth16.ErrInvalidProof)
+	})
+	t.Run("second proof", func(t *testing.T) {
+		other, err := Prove(cs, pk, witness, nil)
+		require.NoError(t, err)
+		require.NoError(t, groth16.Verify(vk, other, inputs))
+	})
+	t.Run("tampered proof", func(t *testing.T) {
+		tampered := *proof
+		tampered.A, tampered.C = proof.C, proof.A
+		require.ErrorIs(t, groth16.Verify(vk, &tampered, inputs), groth16.ErrInvalidProof)
+	})
+	t.Run("wrong number of inputs", func(t *testing.T) {
+		require.ErrorIs(t, groth16.Verify(vk, proof, nil), groth16.ErrMalformed)
+	})
+	t.Run("invalid witness", func(t *testing.T) {
+		witness := append([]*big.Int{}, witness...)
+		witness[mimcWireHash] = big.NewInt(1)
+		_, err := Prove(cs, pk, witness, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+package groth16testing
+
+import (
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+)
+
+// MiMCRounds is the number of x^5 rounds of MiMC over the BN254 scalar field
+const MiMCRounds = 110
+
+// mimcConstants are the round constants gnark derives by iterating keccak256 over "seed"
+var mimcConstants = func() []*big.Int {
+	constants := make([]*big.Int, MiMCRounds)
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte("seed"))
+	rnd := h.Sum(nil)
+	for i := range constants {
+		h.Reset()
+		h.Write(rnd)
+		rnd = h.Sum(nil)
+		constants[i] = new(big.Int).Mod(new(big.Int).SetBytes(rnd), groth16.Order)
+	}
+	return constants
+}()
+
+// MiMC hashes field elements with MiMC-BN254 in Miyaguchi-Preneel mode, as
+// gnark's std/hash/mimc and gnark-crypto's bn254 mimc do.
+func MiMC(elems ...*big.Int) *big.Int {
+	h := new(big.Int)
+	for _, m := range elems {
+		r := mimcEncrypt(new(big.Int).Mod(m, groth16.Order), h)
+		h = frAdd(frAdd(h, r), m)
+	}
+	return h
+}
+
+func mimcEncrypt(m, key *big.Int) *big.Int {
+	for _, c := range mimcConstants {
+		t := frAdd(frAdd(m, key), c)
+		m = frMul(frMul(frMul(t, t), frMul(t, t)), t)
+	}
+	return frAdd(m, key)
+}
+
+// MiMC pre-image circuit wires: the constant one, the public hash, the public
+// claimer, the secret, the claimer squared and three wires per round holding t²,
+// t⁴ and t⁵ for t = m + cᵢ.
+const (
+	mimcWireHash          = 1
+	mimcWireClaimer       = 2
+	mimcWireSecret        = 3
+	mimcWireClaimerSquare = 4
+	mimcWireRounds        = 5
+	mimcWires             = mimcWireRounds + 3*MiMCRounds
+)
+
+// MiMCCircuit returns the reference circuit of GnarkZkSnark claims: knowledge of
+// a secret whose MiMC hash is the first public input, bound to the claimer whose
+// ClaimerInput is the second.
+func MiMCCircuit() *ConstraintSystem {
+	one := big.NewInt(1)
+	cs := &ConstraintSystem{NbPublic: 2, NbWires: mimcWires}
+	// an input no constraint uses has no effect on the verification, so the claimer is squared
+	claimer := LinearCombination{{Wire: mimcWireClaimer, Coeff: one}}
+	cs.Constraints = append(cs.Constraints, Constraint{A: claimer, B: claimer, C: LinearCombination{{Wire: mimcWireClaimerSquare, Coeff: one}}})
+	m := LinearCombination{{Wire: mimcWireSecret, Coeff: one}}
+	for i, c := range mimcConstants {
+		square, fourth, fifth := mimcWireRounds+3*i, mimcWireRounds+1+3*i, mimcWireRounds+2+3*i
+		t := append(LinearCombination{{Wire: 0, Coeff: c}}, m...)
+		cs.Constraints = append(cs.Constraints,
+			Constraint{A: t, B: t, C: LinearCombination{{Wire: square, Coeff: one}}},
+			Constraint{A: LinearCombination{{Wire: square, Coeff: one}}, B: LinearCombination{{Wire: square, Coeff: one}}, C: LinearCombination{{Wire: fourth, Coeff: one}}},
+			Constraint{A: LinearCombination{{Wire: fourth, Coeff: one}}, B: t, C: LinearCombination{{Wire: fifth, Coeff: one}}},
+		)
+		m = LinearCombination{{Wire: fifth, Coeff: one}}
+	}
+	// hash = encrypt(secret) + secret, the key of a single block being zero
+	cs.Constraints = append(cs.Constraints, Constraint{
+		A: append(m, Term{Wire: mimcWireSecret, Coeff: one}),
+		B: LinearCombination{{Wire: 0, Coeff: one}},
+		C: LinearCombination{{Wire: mimcWireHash, Coeff: one}},
+	})
+	return cs
+}
+
+// MiMCWitness returns the full witness of the MiMC circuit for a secret proven by a claimer
+func MiMCWitness(secret *big.Int, claimer string) ([]*big.Int, error) {
+	if secret.Sign() < 0 || secret.Cmp(groth16.Order) >= 0 {
+		return nil, fmt.Errorf("%w: secret is not a field element", groth16.ErrMalformed)
+	}
+	witness := make([]*big.Int, mimcWires)
+	witness[0] = big.NewInt(1)
+	witness[mimcWireHash] = MiMC(secret)
+	witness[mimcWireClaimer] = groth16.ClaimerInput(claimer)
+	witness[mimcWireSecret] = new(big.Int).Set(secret)
+	witness[mimcWireClaimerSquare] = frMul(witness[mimcWireClaimer], witness[mimcWireClaimer])
+	m := secret
+	for i, c := range mimcConstants {
+		square, fourth, fifth := mimcWireRounds+3*i, mimcWireRounds+1+3*i, mimcWireRounds+2+3*i
+		t := frAdd(m, c)
+		witness[square] = frMul(t, t)
+		witness[fourth] = frMul(witness[square], witness[square])
+		witness[fifth] = frMul(witness[fourth], t)
+		m = witness[fifth]
+	}
+	return witness, nil
+}
@@ -0,0 +1,346 @@
+// Package groth16testing sets up and proves small rank-1 constraint systems, such as
+// the reference MiMC circuit of GnarkZkSnark claims, for tests of the groth16 verifier.
+// It is not meant for production keys or proofs, which are built with gnark.
+package groth16testing
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	bn256 "github.com/umbracle/go-eth-bn256"
+
+	"github.com/CosmWasm/wasmd/x/will/schemes/groth16"
+)
+
+// Term is a coefficient times a wire of the witness
+type Term struct {
+	Wire  int
+	Coeff *big.Int
+}
+
+// LinearCombination is a sum of terms
+type LinearCombination []Term
+
+// Constraint asserts A·w × B·w = C·w for the witness w
+type Constraint struct {
+	A, B, C LinearCombination
+}
+
+// ConstraintSystem is a rank-1 constraint system. Wire 0 is the constant one,
+// wires 1 to NbPublic are the public inputs and the remaining wires are secret.
+type ConstraintSystem struct {
+	NbPublic    int
+	NbWires     int
+	Constraints []Constraint
+}
+
+// ProvingKey is a Groth16 proving key for one constraint system
+type ProvingKey struct {
+	Alpha1 *bn256.G1
+	Beta1  *bn256.G1
+	Delta1 *bn256.G1
+	Beta2  *bn256.G2
+	Delta2 *bn256.G2
+	// A1, B1 and B2 hold the QAP polynomials of every wire evaluated at τ
+	A1 []*bn256.G1
+	B1 []*bn256.G1
+	B2 []*bn256.G2
+	// L1 holds (β·u(τ) + α·v(τ) + w(τ)) / δ for every secret wire
+	L1 []*bn256.G1
+	// H1 holds τ^i·Z(τ) / δ for the coefficients of the quotient polynomial
+	H1 []*bn256.G1
+}
+
+// frGenerator generates the multiplicative group of the scalar field
+var frGenerator = big.NewInt(5)
+
+// Setup runs the Groth16 setup for a constraint system. Whoever knows the
+// randomness read from rnd can forge proofs, so it must be discarded afterwards.
+func Setup(cs *ConstraintSystem, rnd io.Reader) (*ProvingKey, *groth16.VerifyingKey, error) {
+	if err := cs.validate(); err != nil {
+		return nil, nil, err
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	var toxic [5]*big.Int
+	for i := range toxic {
+		x, err := randomScalar(rnd)
+		if err != nil {
+			return nil, nil, err
+		}
+		toxic[i] = x
+	}
+	tau, alpha, beta, gamma, delta := toxic[0], toxic[1], toxic[2], toxic[3], toxic[4]
+
+	n := domainSize(len(cs.Constraints))
+	omega := rootOfUnity(n)
+	// Z(τ) = τ^n - 1 and the Lagrange basis L_j(τ) = ω^j·Z(τ) / (n·(τ - ω^j))
+	zTau := frSub(new(big.Int).Exp(tau, big.NewInt(int64(n)), groth16.Order), big.NewInt(1))
+	nInv := frInv(big.NewInt(int64(n)))
+	lagrange := make([]*big.Int, len(cs.Constraints))
+	omegaJ := big.NewInt(1)
+	for j := range lagrange {
+		l := frMul(frMul(omegaJ, zTau), nInv)
+		lagrange[j] = frMul(l, frInv(frSub(tau, omegaJ)))
+		omegaJ = frMul(omegaJ, omega)
+	}
+	u := make([]*big.Int, cs.NbWires)
+	v := make([]*big.Int, cs.NbWires)
+	w := make([]*big.Int, cs.NbWires)
+	for i := range u {
+		u[i], v[i], w[i] = new(big.Int), new(big.Int), new(big.Int)
+	}
+	for j, c := range cs.Constraints {
+		for _, t := range c.A {
+			u[t.Wire] = frAdd(u[t.Wire], frMul(t.Coeff, lagrange[j]))
+		}
+		for _, t := range c.B {
+			v[t.Wire] = frAdd(v[t.Wire], frMul(t.Coeff, lagrange[j]))
+		}
+		for _, t := range c.C {
+			w[t.Wire] = frAdd(w[t.Wire], frMul(t.Coeff, lagrange[j]))
+		}
+	}
+
+	pk := &ProvingKey{
+		Alpha1: g1(alpha),
+		Beta1:  g1(beta),
+		Delta1: g1(delta),
+		Beta2:  g2(beta),
+		Delta2: g2(delta),
+	}
+	vk := &groth16.VerifyingKey{
+		Alpha1: pk.Alpha1,
+		Beta1:  pk.Beta1,
+		Beta2:  pk.Beta2,
+		Gamma2: g2(gamma),
+		Delta1: pk.Delta1,
+		Delta2: pk.Delta2,
+	}
+	gammaInv, deltaInv := frInv(gamma), frInv(delta)
+	for i := 0; i < cs.NbWires; i++ {
+		pk.A1 = append(pk.A1, g1(u[i]))
+		pk.B1 = append(pk.B1, g1(v[i]))
+		pk.B2 = append(pk.B2, g2(v[i]))
+		// β·u(τ) + α·v(τ) + w(τ)
+		k := frAdd(frAdd(frMul(beta, u[i]), frMul(alpha, v[i])), w[i])
+		if i <= cs.NbPublic {
+			vk.K = append(vk.K, g1(frMul(k, gammaInv)))
+		} else {
+			pk.L1 = append(pk.L1, g1(frMul(k, deltaInv)))
+		}
+	}
+	hBase := frMul(zTau, deltaInv)
+	for i := 0; i < n-1; i++ {
+		pk.H1 = append(pk.H1, g1(hBase))
+		hBase = frMul(hBase, tau)
+	}
+	return pk, vk, nil
+}
+
+// Prove builds a proof for a full witness, the constant one wire included
+func Prove(cs *ConstraintSystem, pk *ProvingKey, witness []*big.Int, rnd io.Reader) (*groth16.Proof, error) {
+	if err := cs.validate(); err != nil {
+		return nil, err
+	}
+	if len(witness) != cs.NbWires || len(pk.A1) != cs.NbWires || len(pk.L1) != cs.NbWires-cs.NbPublic-1 {
+		return nil, errors.New("groth16: witness and proving key do not match the constraint system")
+	}
+	if witness[0].Cmp(big.NewInt(1)) != 0 {
+		return nil, errors.New("groth16: witness wire 0 must be one")
+	}
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	n := domainSize(len(cs.Constraints))
+	if len(pk.H1) != n-1 {
+		return nil, errors.New("groth16: proving key does not match the constraint system")
+	}
+
+	// evaluate the constraints over the domain and check the witness satisfies them
+	a, b, c := make([]*big.Int, n), make([]*big.Int, n), make([]*big.Int, n)
+	for j := range a {
+		if j >= len(cs.Constraints) {
+			a[j], b[j], c[j] = new(big.Int), new(big.Int), new(big.Int)
+			continue
+		}
+		a[j], b[j], c[j] = eval(cs.Constraints[j].A, witness), eval(cs.Constraints[j].B, witness), eval(cs.Constraints[j].C, witness)
+		if frMul(a[j], b[j]).Cmp(c[j]) != 0 {
+			return nil, fmt.Errorf("groth16: constraint %d is not satisfied", j)
+		}
+	}
+	h := quotient(a, b, c)
+
+	r, err := randomScalar(rnd)
+	if err != nil {
+		return nil, err
+	}
+	s, err := randomScalar(rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	proofA := new(bn256.G1).Set(pk.Alpha1)
+	proofB := new(bn256.G2).Set(pk.Beta2)
+	b1 := new(bn256.G1).Set(pk.Beta1)
+	proofC := new(bn256.G1).ScalarBaseMult(new(big.Int))
+	for i, x := range witness {
+		if x.Sign() == 0 {
+			continue
+		}
+		proofA.Add(proofA, new(bn256.G1).ScalarMult(pk.A1[i], x))
+		proofB.Add(proofB, new(bn256.G2).ScalarMult(pk.B2[i], x))
+		b1.Add(b1, new(bn256.G1).ScalarMult(pk.B1[i], x))
+		if i > cs.NbPublic {
+			proofC.Add(proofC, new(bn256.G1).ScalarMult(pk.L1[i-cs.NbPublic-1], x))
+		}
+	}
+	proofA.Add(proofA, new(bn256.G1).ScalarMult(pk.Delta1, r))
+	proofB.Add(proofB, new(bn256.G2).ScalarMult(pk.Delta2, s))
+	b1.Add(b1, new(bn256.G1).ScalarMult(pk.Delta1, s))
+	for i, x := range h {
+		if x.Sign() != 0 {
+			proofC.Add(proofC, new(bn256.G1).ScalarMult(pk.H1[i], x))
+		}
+	}
+	// C += s·A + r·B - r·s·δ
+	proofC.Add(proofC, new(bn256.G1).ScalarMult(proofA, s))
+	proofC.Add(proofC, new(bn256.G1).ScalarMult(b1, r))
+	proofC.Add(proofC, new(bn256.G1).ScalarMult(pk.Delta1, frSub(new(big.Int), frMul(r, s))))
+	return &groth16.Proof{A: proofA, B: proofB, C: proofC}, nil
+}
+
+// PublicInputs returns the public part of a full witness
+func (cs *ConstraintSystem) PublicInputs(witness []*big.Int) []*big.Int {
+	return witness[1 : cs.NbPublic+1]
+}
+
+func (cs *ConstraintSystem) validate() error {
+	if cs.NbPublic < 0 || cs.NbWires < cs.NbPublic+1 {
+		return fmt.Errorf("groth16: %d wires cannot hold the one wire and %d public inputs", cs.NbWires, cs.NbPublic)
+	}
+	if len(cs.Constraints) == 0 {
+		return errors.New("groth16: no constraints")
+	}
+	for j, c := range cs.Constraints {
+		for _, lc := range []LinearCombination{c.A, c.B, c.C} {
+			for _, t := range lc {
+				if t.Wire < 0 || t.Wire >= cs.NbWires {
+					return fmt.Errorf("groth16: constraint %d references wire %d of %d", j, t.Wire, cs.NbWires)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// quotient returns the coefficients of h = (a·b - c) / Z for the polynomials
+// taking the values a, b and c on the domain of size len(a).
+func quotient(a, b, c []*big.Int) []*big.Int {
+	n := len(a)
+	omega := rootOfUnity(n)
+	omegaInv := frInv(omega)
+	nInv := frInv(big.NewInt(int64(n)))
+
+	// move to coefficients, then evaluate on the coset g·ω^j where Z does not vanish
+	cosetA, cosetB, cosetC := make([]*big.Int, n), make([]*big.Int, n), make([]*big.Int, n)
+	for _, p := range []struct{ evals, coset []*big.Int }{{a, cosetA}, {b, cosetB}, {c, cosetC}} {
+		coeffs := fft(p.evals, omegaInv)
+		shift := big.NewInt(1)
+		for i := range coeffs {
+			coeffs[i] = frMul(frMul(coeffs[i], nInv), shift)
+			shift = frMul(shift, frGenerator)
+		}
+		copy(p.coset, fft(coeffs, omega))
+	}
+	// Z(g·ω^j) = g^n - 1 for every j
+	zInv := frInv(frSub(new(big.Int).Exp(frGenerator, big.NewInt(int64(n)), groth16.Order), big.NewInt(1)))
+	h := make([]*big.Int, n)
+	for j := range h {
+		h[j] = frMul(frSub(frMul(cosetA[j], cosetB[j]), cosetC[j]), zInv)
+	}
+	h = fft(h, omegaInv)
+	genInv := frInv(frGenerator)
+	shift := big.NewInt(1)
+	for i := range h {
+		h[i] = frMul(frMul(h[i], nInv), shift)
+		shift = frMul(shift, genInv)
+	}
+	// h has degree n-2
+	return h[:n-1]
+}
+
+// fft evaluates the polynomial with the given coefficients at the powers of omega
+func fft(coeffs []*big.Int, omega *big.Int) []*big.Int {
+	n := len(coeffs)
+	if n == 1 {
+		return []*big.Int{new(big.Int).Set(coeffs[0])}
+	}
+	even, odd := make([]*big.Int, n/2), make([]*big.Int, n/2)
+	for i := 0; i < n/2; i++ {
+		even[i], odd[i] = coeffs[2*i], coeffs[2*i+1]
+	}
+	omega2 := frMul(omega, omega)
+	evenEvals, oddEvals := fft(even, omega2), fft(odd, omega2)
+	evals := make([]*big.Int, n)
+	w := big.NewInt(1)
+	for i := 0; i < n/2; i++ {
+		t := frMul(w, oddEvals[i])
+		evals[i] = frAdd(evenEvals[i], t)
+		evals[i+n/2] = frSub(evenEvals[i], t)
+		w = frMul(w, omega)
+	}
+	return evals
+}
+
+// domainSize returns the smallest power of two that holds the constraints
+func domainSize(constraints int) int {
+	n := 2
+	for n < constraints {
+		n <<= 1
+	}
+	return n
+}
+
+// rootOfUnity returns a primitive n-th root of unity for a power of two n
+func rootOfUnity(n int) *big.Int {
+	e := new(big.Int).Sub(groth16.Order, big.NewInt(1))
+	e.Div(e, big.NewInt(int64(n)))
+	return new(big.Int).Exp(frGenerator, e, groth16.Order)
+}
+
+func eval(lc LinearCombination, witness []*big.Int) *big.Int {
+	sum := new(big.Int)
+	for _, t := range lc {
+		sum = frAdd(sum, frMul(t.Coeff, witness[t.Wire]))
+	}
+	return sum
+}
+
+func randomScalar(rnd io.Reader) (*big.Int, error) {
+	for {
+		x, err := rand.Int(rnd, groth16.Order)
+		if err != nil {
+			return nil, err
+		}
+		if x.Sign() != 0 {
+			return x, nil
+		}
+	}
+}
+
+func g1(x *big.Int) *bn256.G1 { return new(bn256.G1).ScalarBaseMult(x) }
+
+func g2(x *big.Int) *bn256.G2 { return new(bn256.G2).ScalarBaseMult(x) }
+
+func frAdd(a, b *big.Int) *big.Int { return new(big.Int).Mod(new(big.Int).Add(a, b), groth16.Order) }
+
+func frSub(a, b *big.Int) *big.Int { return new(big.Int).Mod(new(big.Int).Sub(a, b), groth16.Order) }
+
+func frMul(a, b *big.Int) *big.Int { return new(big.Int).Mod(new(big.Int).Mul(a, b), groth16.Order) }
+
+func frInv(a *big.Int) *big.Int { return new(big.Int).ModInverse(a, groth16.Order) }
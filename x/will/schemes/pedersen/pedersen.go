@@ -1,13 +1,28 @@
 package pedersen
 
 import (
+	"bytes"
+	"errors"
 	"math/big"
 
 	"github.com/bwesterb/go-ristretto"
 )
 
-// The prime order of the base point is 2^252 + 27742317777372353535851937790883648493.
-var n25519, _ = new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+// HDomain is the input the fixed secondary generator H is derived from
+const HDomain = "w3ll/will/pedersen/H/v1"
+
+// ClaimerDomain separates the values of commitments bound to a claimer
+const ClaimerDomain = "w3ll/will/pedersen/claimer/v1"
+
+// h is the fixed secondary generator, see H
+var h = func() ristretto.Point {
+	var p ristretto.Point
+	p.DeriveDalek([]byte(HDomain))
+	return p
+}()
+
+// Order is the prime order of the base point, 2^252 + 27742317777372353535851937790883648493.
+var Order, _ = new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
 
 // Commit to a value x
 // H - Random secondary point on the curve
@@ -23,13 +38,56 @@ func CommitTo(H *ristretto.Point, r, x *ristretto.Scalar) ristretto.Point {
 	return result
 }
 
-// Generate a random point on the curve
-func generateH() ristretto.Point {
-	var random ristretto.Scalar
-	var H ristretto.Point
-	random.Rand()
-	H.ScalarMultBase(&random)
-	return H
+// H returns the fixed secondary generator. It is hashed to the curve from HDomain,
+// so anyone can rederive it and nobody knows its discrete log to the base point,
+// which would let them open a commitment to any value.
+func H() ristretto.Point {
+	return h
+}
+
+// Commit commits to the value x with the blinding factor r using the fixed generator H
+func Commit(r, x *ristretto.Scalar) ristretto.Point {
+	return CommitTo(&h, r, x)
+}
+
+// BoundValue is the value a commitment bound to a claimer commits to instead of x.
+// It hashes x with the claimer's address, so an opening seen by others cannot be
+// adapted to their own address.
+func BoundValue(x *ristretto.Scalar, claimer string) ristretto.Scalar {
+	var bound ristretto.Scalar
+	bound.Derive(append(append([]byte(ClaimerDomain), x.Bytes()...), claimer...))
+	return bound
+}
+
+// Verify returns true if r and x open the commitment c, bound to the claimer if it is not empty
+func Verify(c *ristretto.Point, r, x *ristretto.Scalar, claimer string) bool {
+	if claimer != "" {
+		bound := BoundValue(x, claimer)
+		x = &bound
+	}
+	opened := Commit(r, x)
+	return opened.Equals(c)
+}
+
+// UnmarshalScalar decodes a canonical 32 byte little endian scalar
+func UnmarshalScalar(bz []byte) (ristretto.Scalar, error) {
+	var s ristretto.Scalar
+	if err := s.UnmarshalBinary(bz); err != nil {
+		return s, err
+	}
+	if !bytes.Equal(s.Bytes(), bz) {
+		return s, errors.New("non canonical scalar")
+	}
+	return s, nil
+}
+
+// UnmarshalCommitment decodes a commitment
+func UnmarshalCommitment(bz []byte) (ristretto.Point, error) {
+	var p ristretto.Point
+	if err := p.UnmarshalBinary(bz); err != nil {
+		return p, err
+	}
+	return p, nil
 }
 
 // Subtract two commitments using homomorphic encryption
@@ -49,7 +107,7 @@ func SubPrivately(H *ristretto.Point, rX, rY *ristretto.Scalar, vX, vY *big.Int)
 	var vDif big.Int
 	rDif.Sub(rY, rX)
 	vDif.Sub(vX, vY)
-	vDif.Mod(&vDif, n25519)
+	vDif.Mod(&vDif, Order)
 
 	var vScalar ristretto.Scalar
 	var rPoint ristretto.Point
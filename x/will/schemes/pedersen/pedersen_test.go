@@ -1,27 +1,29 @@
 package pedersen
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
 	"github.com/bwesterb/go-ristretto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Should commit to a sum of two values
 func TestCommitToSuccess(t *testing.T) {
 	var rX, rY, vX, vY ristretto.Scalar
 	rX.Rand()
-	H := generateH() // Secondary point on the Curve
+	H := H() // Secondary point on the Curve
 	five := big.NewInt(5)
 
 	// Transfer amount of 5 tokens
-	tC := commitTo(&H, &rX, vX.SetBigInt(five))
+	tC := CommitTo(&H, &rX, vX.SetBigInt(five))
 
 	// Alice 10 - 5 = 5
 	rY.Rand()
 	ten := big.NewInt(10)
-	aC1 := commitTo(&H, &rY, vY.SetBigInt(ten))
+	aC1 := CommitTo(&H, &rY, vY.SetBigInt(ten))
 	assert.NotEqual(t, aC1, tC, "Should not be equal")
 	var aC2 ristretto.Point
 	aC2.Sub(&aC1, &tC)
@@ -34,16 +36,16 @@ func TestCommitToSuccess(t *testing.T) {
 func TestCommitToFails(t *testing.T) {
 	var rX, rY, vX, vY ristretto.Scalar
 	rX.Rand()
-	H := generateH() // Secondary point on the Curve
+	H := H() // Secondary point on the Curve
 	five := big.NewInt(5)
 
 	// Transfer amount of 5 tokens
-	tC := commitTo(&H, &rX, vX.SetBigInt(five))
+	tC := CommitTo(&H, &rX, vX.SetBigInt(five))
 
 	// Alice 10 - 5 = 5
 	rY.Rand()
 	ten := big.NewInt(10)
-	aC1 := commitTo(&H, &rY, vY.SetBigInt(ten))
+	aC1 := CommitTo(&H, &rY, vY.SetBigInt(ten))
 	assert.NotEqual(t, aC1, tC, "They should not be equal")
 	var aC2 ristretto.Point
 	aC2.Sub(&aC1, &tC)
@@ -54,3 +56,55 @@ func TestCommitToFails(t *testing.T) {
 	checkAC2 := SubPrivately(&H, &rX, &rY, ten, five)
 	assert.False(t, checkAC2.Equals(&aC2), "Should not be equal")
 }
+
+// Pins the fixed generator and commitments, so a change to their derivation is noticed
+func TestVectors(t *testing.T) {
+	var r, x ristretto.Scalar
+	r.SetUint64(7)
+	x.SetUint64(42)
+
+	fixedH := H()
+	commitment := Commit(&r, &x)
+	bound := BoundValue(&x, "will1claimer")
+	boundCommitment := Commit(&r, &bound)
+	assert.Equal(t, "9e7e219b580e570747852718d2d016bf789673445ccbd900743a035803f35f0d", hex.EncodeToString(fixedH.Bytes()))
+	assert.Equal(t, "f817da8c5c593aeadbdb6dbf7429a41a647d2d02f7b6c9e7745e211e10add707", hex.EncodeToString(commitment.Bytes()))
+	assert.Equal(t, "94a1fddef050006c3c54d97bc2db6e3741e8afb9a9dc44991695ac2b73a13301", hex.EncodeToString(bound.Bytes()))
+	assert.Equal(t, "dc2ddb2afcca99ca13b3db854ad48fccee841686248b3d1cc8649fb8045d9f39", hex.EncodeToString(boundCommitment.Bytes()))
+}
+
+func TestVerify(t *testing.T) {
+	var r, x, other ristretto.Scalar
+	r.Rand()
+	x.Rand()
+	other.Rand()
+
+	c := Commit(&r, &x)
+	assert.True(t, Verify(&c, &r, &x, ""))
+	assert.False(t, Verify(&c, &r, &other, ""))
+	assert.False(t, Verify(&c, &other, &x, ""))
+	assert.False(t, Verify(&c, &r, &x, "will1claimer"))
+
+	// a bound commitment opens only for its claimer
+	bound := BoundValue(&x, "will1claimer")
+	c = Commit(&r, &bound)
+	assert.True(t, Verify(&c, &r, &x, "will1claimer"))
+	assert.False(t, Verify(&c, &r, &x, "will1other"))
+	assert.False(t, Verify(&c, &r, &x, ""))
+}
+
+func TestUnmarshalScalar(t *testing.T) {
+	var x ristretto.Scalar
+	x.Rand()
+	decoded, err := UnmarshalScalar(x.Bytes())
+	require.NoError(t, err)
+	assert.True(t, decoded.Equals(&x))
+
+	// the group order encodes zero and is not canonical
+	order, err := hex.DecodeString("edd3f55c1a631258d69cf7a2def9de1400000000000000000000000000000010")
+	require.NoError(t, err)
+	_, err = UnmarshalScalar(order)
+	assert.Error(t, err)
+	_, err = UnmarshalScalar(x.Bytes()[:31])
+	assert.Error(t, err)
+}
@@ -1,6 +1,9 @@
 package schnorr
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
 
 	"go.dedis.ch/kyber/v3"
@@ -54,6 +57,106 @@ func Verify(m kyber.Scalar, S Signature, y kyber.Point) bool {
 	return sG.Equal(sGv)
 }
 
+// SignatureSize is the length of an encoded signature, R followed by S
+const SignatureSize = 64
+
+// Challenge is the Fiat-Shamir challenge H(R || Y || m), which binds a signature to its
+// nonce commitment, the signer's public key and the message
+func Challenge(r, y kyber.Point, m []byte) kyber.Scalar {
+	h := curve.Hash()
+	for _, p := range []kyber.Point{r, y} {
+		if _, err := p.MarshalTo(h); err != nil {
+			panic(err)
+		}
+	}
+	h.Write(m)
+	return curve.Scalar().SetBytes(h.Sum(nil))
+}
+
+// SignMessage signs m with the private key z, committing the challenge to the nonce and public key
+func SignMessage(m []byte, z kyber.Scalar) Signature {
+	k := curve.Scalar().Pick(curve.RandomStream())
+	r := curve.Point().Mul(k, g)
+	e := Challenge(r, curve.Point().Mul(z, g), m)
+	return Signature{R: r, S: curve.Scalar().Add(k, curve.Scalar().Mul(e, z))}
+}
+
+// VerifyMessage verifies a signature made by SignMessage: s * G = R + H(R || Y || m) * Y
+func VerifyMessage(m []byte, S Signature, y kyber.Point) bool {
+	return Verify(Challenge(S.R, y, m), S, y)
+}
+
+// MarshalBinary encodes the signature as R followed by S
+func (S Signature) MarshalBinary() ([]byte, error) {
+	r, err := S.R.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	s, err := S.S.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(r, s...), nil
+}
+
+// UnmarshalSignature decodes a signature encoded by MarshalBinary. Only the canonical
+// encoding is accepted, so a signature cannot be altered into another valid one.
+func UnmarshalSignature(bz []byte) (Signature, error) {
+	if len(bz) != SignatureSize {
+		return Signature{}, errors.New("schnorr signature must be 64 bytes")
+	}
+	r, err := unmarshalPoint(bz[:32])
+	if err != nil {
+		return Signature{}, err
+	}
+	s, err := UnmarshalPrivateKey(bz[32:])
+	if err != nil {
+		return Signature{}, err
+	}
+	return Signature{R: r, S: s}, nil
+}
+
+// UnmarshalPublicKey decodes a public key, rejecting non canonical encodings and points of
+// small order, for which signatures can be forged without the private key
+func UnmarshalPublicKey(bz []byte) (kyber.Point, error) {
+	y, err := unmarshalPoint(bz)
+	if err != nil {
+		return nil, err
+	}
+	if curve.Point().Mul(curve.Scalar().SetInt64(8), y).Equal(curve.Point().Null()) {
+		return nil, errors.New("public key has small order")
+	}
+	return y, nil
+}
+
+// UnmarshalPrivateKey decodes a canonical little endian scalar
+func UnmarshalPrivateKey(bz []byte) (kyber.Scalar, error) {
+	if len(bz) != 32 {
+		return nil, errors.New("scalar must be 32 bytes")
+	}
+	s := curve.Scalar().SetBytes(bz)
+	if canonical, err := s.MarshalBinary(); err != nil || !bytes.Equal(canonical, bz) {
+		return nil, errors.New("non canonical scalar")
+	}
+	return s, nil
+}
+
+func unmarshalPoint(bz []byte) (kyber.Point, error) {
+	p := curve.Point()
+	if err := p.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	if canonical, err := p.MarshalBinary(); err != nil || !bytes.Equal(canonical, bz) {
+		return nil, errors.New("non canonical point")
+	}
+	return p, nil
+}
+
+// PublicKey returns the public key of a private key
+func PublicKey(z kyber.Scalar) kyber.Point {
+	return curve.Point().Mul(z, g)
+}
+
 // Return a new random key pair
 func RandomKeyPair() (kyber.Scalar, kyber.Point) {
 	privateKey := curve.Scalar().Pick(curve.RandomStream())
@@ -79,18 +182,91 @@ func convKey(d *big.Int) kyber.Scalar {
 }
 
 // ------------------------------------ //
-// Generate a multi-signature given 2 signatures and the corresponding public keys
-func mulSig(sigA, sigB Signature, pubKeyA, pubKeyB kyber.Point) (Signature, kyber.Point) {
-	newR := curve.Point().Add(sigA.R, sigB.R)
-	newS := curve.Scalar().Add(sigA.S, sigB.S)
-	newPubKey := curve.Point().Add(pubKeyA, pubKeyB)
-	// fmt.Println("Aggregated publicKey in aggSig: ", newPubKey)
+// MuSig aggregation. Adding the signatures and public keys of several signers is not safe
+// on its own: a signer who publishes X = x*G - sum(others) signs for the whole group alone.
+// MuSig weights every key by a coefficient hashed from the complete key list, so no key can
+// be chosen as a function of the others.
+
+// KeyAggDomain separates the key aggregation coefficients from any other hash of the keys
+const KeyAggDomain = "w3ll/schnorr/musig-keyagg/v1"
+
+// KeyAggCoefficient returns the coefficient a_i = H(H(domain || L) || X_i) of key X_i in the key list L
+func KeyAggCoefficient(keys []kyber.Point, y kyber.Point) kyber.Scalar {
+	l := curve.Hash()
+	l.Write([]byte(KeyAggDomain))
+	for _, key := range keys {
+		if _, err := key.MarshalTo(l); err != nil {
+			panic(err)
+		}
+	}
+	h := curve.Hash()
+	h.Write(l.Sum(nil))
+	if _, err := y.MarshalTo(h); err != nil {
+		panic(err)
+	}
+	return curve.Scalar().SetBytes(h.Sum(nil))
+}
+
+// AggregateKeys returns the aggregate key sum(a_i * X_i) of a set of signers, given as
+// strictly increasing indices into the key list. The coefficients are taken over the
+// whole list, so any subset of its signers has its own aggregate key.
+func AggregateKeys(keys []kyber.Point, signers []uint32) (kyber.Point, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signers")
+	}
+	aggregate := curve.Point().Null()
+	for i, signer := range signers {
+		if int(signer) >= len(keys) {
+			return nil, fmt.Errorf("signer %d out of range", signer)
+		}
+		if i > 0 && signer <= signers[i-1] {
+			return nil, errors.New("signers must be strictly increasing")
+		}
+		a := KeyAggCoefficient(keys, keys[signer])
+		aggregate.Add(aggregate, curve.Point().Mul(a, keys[signer]))
+	}
+	return aggregate, nil
+}
 
-	var sigC Signature
-	sigC = Signature{
-		R: newR,
-		S: newS,
+// NewNonce picks a signing nonce k and its commitment R = k * G. Signers should commit to
+// H(R) and collect every other signer's commitment before revealing R, otherwise a signer
+// who sees the others' nonces first can bias the aggregate nonce.
+func NewNonce() (kyber.Scalar, kyber.Point) {
+	k := curve.Scalar().Pick(curve.RandomStream())
+	return k, curve.Point().Mul(k, g)
+}
+
+// AggregateNonces returns the nonce commitment of an aggregate signature, the sum of the signers' commitments
+func AggregateNonces(rs ...kyber.Point) kyber.Point {
+	aggregate := curve.Point().Null()
+	for _, r := range rs {
+		aggregate.Add(aggregate, r)
 	}
+	return aggregate
+}
 
-	return sigC, newPubKey
+// SignPartial returns the share s_i = k_i + H(R || X || m) * a_i * z_i of signer, with private
+// key z and nonce k, in the aggregate signature of the signers over m, where R is the
+// aggregate nonce and X the aggregate key of the signers
+func SignPartial(m []byte, keys []kyber.Point, signers []uint32, signer uint32, z, k kyber.Scalar, r kyber.Point) (kyber.Scalar, error) {
+	aggregate, err := AggregateKeys(keys, signers)
+	if err != nil {
+		return nil, err
+	}
+	if int(signer) >= len(keys) || !keys[signer].Equal(PublicKey(z)) {
+		return nil, fmt.Errorf("private key does not match key %d", signer)
+	}
+	a := KeyAggCoefficient(keys, keys[signer])
+	e := Challenge(r, aggregate, m)
+	return curve.Scalar().Add(k, curve.Scalar().Mul(e, curve.Scalar().Mul(a, z))), nil
+}
+
+// AggregateSignatures combines the partial signatures of all signers over the aggregate
+// nonce R into a signature VerifyMessage accepts for their aggregate key
+func AggregateSignatures(r kyber.Point, partials ...kyber.Scalar) Signature {
+	s := curve.Scalar().Zero()
+	for _, partial := range partials {
+		s.Add(s, partial)
+	}
+	return Signature{R: r, S: s}
 }
@@ -0,0 +1,138 @@
+package schnorr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v3"
+)
+
+func TestSignMessage(t *testing.T) {
+	privateKey, publicKey := RandomKeyPair()
+	msg := []byte("claim digest")
+	signature := SignMessage(msg, privateKey)
+	assert.True(t, VerifyMessage(msg, signature, publicKey))
+	assert.False(t, VerifyMessage([]byte("other digest"), signature, publicKey))
+	_, otherKey := RandomKeyPair()
+	assert.False(t, VerifyMessage(msg, signature, otherKey))
+
+	// the signature round trips through its encoding
+	bz, err := signature.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, bz, SignatureSize)
+	decoded, err := UnmarshalSignature(bz)
+	require.NoError(t, err)
+	assert.True(t, VerifyMessage(msg, decoded, publicKey))
+
+	// s + l encodes the same scalar and is rejected
+	malleated := append([]byte{}, bz...)
+	var carry uint16
+	for i, b := range order {
+		sum := uint16(malleated[32+i]) + uint16(b) + carry
+		malleated[32+i], carry = byte(sum), sum>>8
+	}
+	require.Zero(t, carry)
+	_, err = UnmarshalSignature(malleated)
+	assert.Error(t, err)
+	_, err = UnmarshalSignature(bz[:63])
+	assert.Error(t, err)
+}
+
+func TestForgeryWithoutNonceBinding(t *testing.T) {
+	// with a challenge independent of R anyone can pick s and solve for R,
+	// the challenge of SignMessage commits to R so the forgery fails
+	_, publicKey := RandomKeyPair()
+	msg := []byte("claim digest")
+	e := Hash(string(msg))
+	s := curve.Scalar().Pick(curve.RandomStream())
+	r := curve.Point().Sub(curve.Point().Mul(s, g), curve.Point().Mul(e, publicKey))
+	forged := Signature{R: r, S: s}
+	assert.True(t, Verify(e, forged, publicKey))
+	assert.False(t, VerifyMessage(msg, forged, publicKey))
+}
+
+func TestUnmarshalPublicKey(t *testing.T) {
+	_, publicKey := RandomKeyPair()
+	bz, err := publicKey.MarshalBinary()
+	require.NoError(t, err)
+	decoded, err := UnmarshalPublicKey(bz)
+	require.NoError(t, err)
+	assert.True(t, decoded.Equal(publicKey))
+
+	identity, err := curve.Point().Null().MarshalBinary()
+	require.NoError(t, err)
+	_, err = UnmarshalPublicKey(identity)
+	assert.Error(t, err)
+	_, err = UnmarshalPublicKey(bz[:31])
+	assert.Error(t, err)
+}
+
+func TestAggregateSignature(t *testing.T) {
+	privateKeys := make([]kyber.Scalar, 3)
+	keys := make([]kyber.Point, 3)
+	for i := range keys {
+		privateKeys[i], keys[i] = RandomKeyPair()
+	}
+	msg := []byte("claim digest")
+	sign := func(signers []uint32) Signature {
+		nonces := make([]kyber.Scalar, len(signers))
+		commitments := make([]kyber.Point, len(signers))
+		for i := range signers {
+			nonces[i], commitments[i] = NewNonce()
+		}
+		r := AggregateNonces(commitments...)
+		partials := make([]kyber.Scalar, len(signers))
+		for i, signer := range signers {
+			partial, err := SignPartial(msg, keys, signers, signer, privateKeys[signer], nonces[i], r)
+			require.NoError(t, err)
+			partials[i] = partial
+		}
+		return AggregateSignatures(r, partials...)
+	}
+
+	for _, signers := range [][]uint32{{0, 2}, {0, 1, 2}, {1}} {
+		aggregate, err := AggregateKeys(keys, signers)
+		require.NoError(t, err)
+		signature := sign(signers)
+		assert.True(t, VerifyMessage(msg, signature, aggregate), "signers %v", signers)
+		assert.False(t, VerifyMessage([]byte("other digest"), signature, aggregate))
+		// the signature of a subset does not verify for another subset
+		others, err := AggregateKeys(keys, []uint32{0, 1})
+		require.NoError(t, err)
+		assert.False(t, VerifyMessage(msg, signature, others), "signers %v", signers)
+	}
+
+	_, err := AggregateKeys(keys, nil)
+	assert.Error(t, err)
+	_, err = AggregateKeys(keys, []uint32{1, 1})
+	assert.Error(t, err)
+	_, err = AggregateKeys(keys, []uint32{2, 1})
+	assert.Error(t, err)
+	_, err = AggregateKeys(keys, []uint32{3})
+	assert.Error(t, err)
+	_, err = SignPartial(msg, keys, []uint32{0, 1}, 1, privateKeys[0], privateKeys[0], keys[0])
+	assert.Error(t, err)
+}
+
+func TestRogueKey(t *testing.T) {
+	// a signer who publishes X = x*G - Y after seeing the honest key Y owns the plain sum
+	// X + Y = x*G and signs for both alone; weighted by the coefficients it does not
+	_, honest := RandomKeyPair()
+	x, _ := RandomKeyPair()
+	rogue := curve.Point().Sub(PublicKey(x), honest)
+	keys := []kyber.Point{honest, rogue}
+	msg := []byte("claim digest")
+	forged := SignMessage(msg, x)
+	assert.True(t, VerifyMessage(msg, forged, curve.Point().Add(honest, rogue)))
+
+	aggregate, err := AggregateKeys(keys, []uint32{0, 1})
+	require.NoError(t, err)
+	assert.False(t, VerifyMessage(msg, forged, aggregate))
+}
+
+// order is the little endian order of the edwards25519 base point
+var order = [32]byte{
+	0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
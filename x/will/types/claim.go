@@ -0,0 +1,82 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// claim scheme names
+const (
+	ClaimSchemePedersen = "pedersen"
+	ClaimSchemeSchnorr  = "schnorr"
+	ClaimSchemeGnark    = "gnark"
+	// ClaimSchemeThresholdSchnorr is the scheme of components approved by a threshold of guardians
+	ClaimSchemeThresholdSchnorr = "threshold-schnorr"
+)
+
+const (
+	// SchnorrClaimDomain separates Schnorr claim digests from any other message signed with the same key
+	SchnorrClaimDomain = "w3ll/will/schnorr-claim/v1"
+	// ThresholdClaimDomain separates the digests guardians approve claims with
+	ThresholdClaimDomain = "w3ll/will/threshold-claim/v1"
+)
+
+// SchnorrClaimDigest is the message a Schnorr claim signs. It binds the signature to the
+// chain, will, component and claimer, so it cannot be replayed for another claim.
+func SchnorrClaimDigest(chainID, willID, componentID, claimer string) []byte {
+	return claimDigest(SchnorrClaimDomain, chainID, willID, componentID, claimer)
+}
+
+// ThresholdClaimDigest is the message the guardians of a threshold Schnorr component sign
+// to approve a claim, bound like SchnorrClaimDigest to the chain, will, component and claimer
+func ThresholdClaimDigest(chainID, willID, componentID, claimer string) []byte {
+	return claimDigest(ThresholdClaimDomain, chainID, willID, componentID, claimer)
+}
+
+// claimDigest hashes the fields of a claim after its domain. Every field is length
+// prefixed so that no two field lists share a digest.
+func claimDigest(fields ...string) []byte {
+	h := sha256.New()
+	for _, field := range fields {
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(field))))
+		h.Write([]byte(field))
+	}
+	return h.Sum(nil)
+}
+
+// ClaimSchemeName returns the name of the scheme a claim component is proven with
+func ClaimSchemeName(claim *ClaimComponent) (string, error) {
+	switch claim.SchemeType.(type) {
+	case *ClaimComponent_Pedersen:
+		return ClaimSchemePedersen, nil
+	case *ClaimComponent_Schnorr:
+		return ClaimSchemeSchnorr, nil
+	case *ClaimComponent_Gnark:
+		return ClaimSchemeGnark, nil
+	case *ClaimComponent_ThresholdSchnorr:
+		return ClaimSchemeThresholdSchnorr, nil
+	case *ClaimComponent_Custom:
+		if claim.GetCustom().Scheme == "" {
+			return "", fmt.Errorf("custom claim scheme without a name")
+		}
+		return claim.GetCustom().Scheme, nil
+	default:
+		return "", fmt.Errorf("unsupported claim scheme: %T", claim.SchemeType)
+	}
+}
+
+// Admits returns true when the access control lets the given address claim
+func (a ClaimAccessControl) Admits(address string) bool {
+	switch acc := a.AccessType.(type) {
+	case *ClaimAccessControl_Public:
+		return true
+	case *ClaimAccessControl_Private:
+		for _, addr := range acc.Private.Addresses {
+			if addr == address {
+				return true
+			}
+		}
+	}
+	return false
+}
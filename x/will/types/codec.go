@@ -11,6 +11,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateWillRequest{},
+		&MsgFundWillRequest{},
+		&MsgUpdateWillRequest{},
+		&MsgCancelWillRequest{},
+		&MsgWithdrawVestedRequest{},
+		&MsgExecuteWillRequest{},
+		&MsgSendWillPacketRequest{},
 		// &MsgClaimRequest{},
 	)
 	// registry.RegisterMsgServiceDesc(registry, _Msg_serviceDesc) // _Msg_serviceDesc is generated by proto-gen
@@ -1,5 +1,40 @@
 package types
 
-// var (
-// 	ErrWillNotFound = sdkerrors.Register(ModuleName, 1100, "will not found")
-// )
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	// ErrWillNotFound error for a will id that is not in the store
+	ErrWillNotFound = errorsmod.Register(ModuleName, 1100, "will not found")
+
+	// ErrWillNotLive error for an operation that requires a live will
+	ErrWillNotLive = errorsmod.Register(ModuleName, 1101, "will is not live")
+
+	// ErrNoInactivityWindow error for a check-in on a will without an inactivity window
+	ErrNoInactivityWindow = errorsmod.Register(ModuleName, 1102, "will has no inactivity window")
+
+	// ErrInsufficientEscrow error for a payout larger than what the will holds in escrow
+	ErrInsufficientEscrow = errorsmod.Register(ModuleName, 1103, "insufficient will escrow")
+
+	// ErrInvalid error for content that is invalid in this context
+	ErrInvalid = errorsmod.Register(ModuleName, 1104, "invalid")
+
+	// ErrDuplicate error for content that exists
+	ErrDuplicate = errorsmod.Register(ModuleName, 1105, "duplicate")
+
+	// ErrComponentTypeDisabled error for a will component type that is not enabled in params
+	ErrComponentTypeDisabled = errorsmod.Register(ModuleName, 1106, "component type disabled")
+
+	// ErrInvalidProof error for a claim whose proof does not verify
+	ErrInvalidProof = errorsmod.Register(ModuleName, 1108, "invalid claim proof")
+
+	// ErrProofUsed error for a claim proof that was already used by an accepted claim
+	ErrProofUsed = errorsmod.Register(ModuleName, 1109, "claim proof already used")
+
+	// ErrComponentNotFound error for a component id that is not part of a will
+	ErrComponentNotFound = errorsmod.Register(ModuleName, 1110, "will component not found")
+
+	// ErrWillNotDue error for executing a will that has not fired
+	ErrWillNotDue = errorsmod.Register(ModuleName, 1111, "will is not due")
+)
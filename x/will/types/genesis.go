@@ -0,0 +1,208 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default will genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		PortId: ModuleName,
+	}
+}
+
+// Validate performs basic genesis state validation and checks that the wills and
+// escrows are consistent with each other and with the params.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "params")
+	}
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return errorsmod.Wrap(err, "port id")
+	}
+
+	wills := make(map[string]struct{}, len(gs.Wills))
+	queued := make(map[string]struct{})
+	for i, will := range gs.Wills {
+		if err := will.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "will %d", i)
+		}
+		if _, ok := wills[will.ID]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "will %s", will.ID)
+		}
+		wills[will.ID] = struct{}{}
+		if will.Status == WillStatusQueued {
+			queued[will.ID] = struct{}{}
+		}
+	}
+
+	// every queued will waits in the queue exactly once
+	for _, willID := range gs.Queue {
+		if _, ok := wills[willID]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "queued unknown will %s", willID)
+		}
+		if _, ok := queued[willID]; !ok {
+			return errorsmod.Wrapf(ErrInvalid, "will %s is in the queue twice or is not queued", willID)
+		}
+		delete(queued, willID)
+	}
+	if len(queued) > 0 {
+		return errorsmod.Wrapf(ErrInvalid, "%d queued wills are not in the queue", len(queued))
+	}
+
+	escrows := make(map[string]struct{}, len(gs.Escrows))
+	for _, escrow := range gs.Escrows {
+		if _, ok := wills[escrow.WillId]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "escrow for unknown will %s", escrow.WillId)
+		}
+		if _, ok := escrows[escrow.WillId]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "escrow for will %s", escrow.WillId)
+		}
+		escrows[escrow.WillId] = struct{}{}
+		if err := escrow.Coins.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "escrow coins of will %s", escrow.WillId)
+		}
+		if err := escrow.Deposit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "escrow deposit of will %s", escrow.WillId)
+		}
+	}
+
+	nullifiers := make(map[string]struct{}, len(gs.Nullifiers))
+	for i, nullifier := range gs.Nullifiers {
+		if nullifier.Scheme == "" || len(nullifier.Nullifier) == 0 {
+			return errorsmod.Wrapf(ErrInvalid, "empty nullifier %d", i)
+		}
+		key := nullifier.Scheme + "/" + string(nullifier.Nullifier)
+		if _, ok := nullifiers[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "%s nullifier %X", nullifier.Scheme, nullifier.Nullifier)
+		}
+		nullifiers[key] = struct{}{}
+	}
+
+	approvals := make(map[Approval]struct{}, len(gs.Approvals))
+	for _, approval := range gs.Approvals {
+		if _, ok := wills[approval.WillId]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "approval for unknown will %s", approval.WillId)
+		}
+		if approval.ComponentId == "" {
+			return errorsmod.Wrapf(ErrInvalid, "approval without a component for will %s", approval.WillId)
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Claimer); err != nil {
+			return errorsmod.Wrapf(ErrInvalid, "approval for component %s of will %s: claimer: %s", approval.ComponentId, approval.WillId, err)
+		}
+		if _, ok := approvals[approval]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "approval of guardian %d for %s on component %s of will %s", approval.Guardian, approval.Claimer, approval.ComponentId, approval.WillId)
+		}
+		approvals[approval] = struct{}{}
+	}
+
+	type receiptKey struct {
+		willID string
+		index  uint32
+	}
+	receipts := make(map[receiptKey]struct{}, len(gs.Receipts))
+	for _, receipt := range gs.Receipts {
+		if _, ok := wills[receipt.WillId]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "receipt for unknown will %s", receipt.WillId)
+		}
+		key := receiptKey{receipt.WillId, receipt.Index}
+		if _, ok := receipts[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "receipt of component %d of will %s", receipt.Index, receipt.WillId)
+		}
+		receipts[key] = struct{}{}
+	}
+
+	type packetKey struct {
+		channel  string
+		sequence uint64
+	}
+	packets := make(map[packetKey]struct{}, len(gs.PendingPackets))
+	for _, packet := range gs.PendingPackets {
+		if _, ok := wills[packet.WillId]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "pending packet for unknown will %s", packet.WillId)
+		}
+		if err := host.ChannelIdentifierValidator(packet.Channel); err != nil {
+			return errorsmod.Wrapf(err, "pending packet of will %s", packet.WillId)
+		}
+		if packet.ComponentId == "" || packet.SettledStatus == "" {
+			return errorsmod.Wrapf(ErrInvalid, "pending packet %d on channel %s without a component or settled status", packet.Sequence, packet.Channel)
+		}
+		key := packetKey{packet.Channel, packet.Sequence}
+		if _, ok := packets[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "pending packet %d on channel %s", packet.Sequence, packet.Channel)
+		}
+		packets[key] = struct{}{}
+	}
+
+	type retryKey struct {
+		height      int64
+		willID      string
+		componentID string
+	}
+	retries := make(map[retryKey]struct{}, len(gs.PacketRetries))
+	for _, retry := range gs.PacketRetries {
+		if _, ok := wills[retry.WillId]; !ok {
+			return errorsmod.Wrapf(ErrWillNotFound, "packet retry for unknown will %s", retry.WillId)
+		}
+		if retry.ComponentId == "" || retry.Height <= 0 || retry.Attempt == 0 {
+			return errorsmod.Wrapf(ErrInvalid, "packet retry of will %s needs a component, a positive height and attempt", retry.WillId)
+		}
+		key := retryKey{retry.Height, retry.WillId, retry.ComponentId}
+		if _, ok := retries[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "packet retry of component %s of will %s at height %d", retry.ComponentId, retry.WillId, retry.Height)
+		}
+		retries[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a stored will
+func (w Will) ValidateBasic() error {
+	if w.ID == "" {
+		return errorsmod.Wrap(ErrInvalid, "empty will id")
+	}
+	if _, err := sdk.AccAddressFromBech32(w.Creator); err != nil {
+		return errorsmod.Wrapf(ErrInvalid, "creator %s: %s", w.Creator, err)
+	}
+	switch w.Status {
+	case WillStatusLive, WillStatusQueued, WillStatusExpired, WillStatusCancelled:
+	default:
+		return errorsmod.Wrapf(ErrInvalid, "unknown status %q", w.Status)
+	}
+	switch {
+	case w.TriggerTime != nil && w.Height != 0:
+		return errorsmod.Wrapf(ErrInvalid, "will with trigger time %s has height %d", w.TriggerTime, w.Height)
+	case w.TriggerTime != nil && w.InactivityWindow != 0:
+		return errorsmod.Wrapf(ErrInvalid, "will with trigger time %s has inactivity window %d", w.TriggerTime, w.InactivityWindow)
+	case w.Condition != nil && (w.Height != 0 || w.TriggerTime != nil):
+		return errorsmod.Wrapf(ErrInvalid, "will with a condition has height %d or trigger time %s", w.Height, w.TriggerTime)
+	case w.Condition != nil && (w.InactivityWindow != 0 || w.InactivityDuration != 0):
+		return errorsmod.Wrap(ErrInvalid, "will with a condition has an inactivity window or duration")
+	case w.Condition != nil && w.Condition.NextCheckHeight <= 0:
+		return errorsmod.Wrapf(ErrInvalid, "condition next check height %d must be positive", w.Condition.NextCheckHeight)
+	case w.TriggerTime == nil && w.Condition == nil && w.Height <= 0:
+		return errorsmod.Wrapf(ErrInvalid, "height %d must be positive", w.Height)
+	}
+	if w.InactivityWindow < 0 {
+		return errorsmod.Wrapf(ErrInvalid, "inactivity window %d must not be negative", w.InactivityWindow)
+	}
+	if w.InactivityDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalid, "inactivity duration %s must not be negative", w.InactivityDuration)
+	}
+	for i, component := range w.Components {
+		if component == nil {
+			return errorsmod.Wrapf(ErrInvalid, "nil component %d", i)
+		}
+	}
+	for _, channel := range w.RemoteChannels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return errorsmod.Wrapf(ErrInvalid, "remote channel %q: %s", channel, err)
+		}
+	}
+	return nil
+}
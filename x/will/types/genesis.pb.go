@@ -11,6 +11,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -35,6 +36,25 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// holds the ibc port for the module
 	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// wills holds every will, whatever its status, with its component states
+	Wills []Will `protobuf:"bytes,3,rep,name=wills,proto3" json:"wills"`
+	// escrows holds the assets and creation deposits held for the wills
+	Escrows []WillEscrow `protobuf:"bytes,4,rep,name=escrows,proto3" json:"escrows"`
+	// nullifiers holds the claim proofs that accepted claims used
+	Nullifiers []Nullifier `protobuf:"bytes,5,rep,name=nullifiers,proto3" json:"nullifiers"`
+	// approvals holds the guardian approvals collected for components that are
+	// not claimed yet
+	Approvals []Approval `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals"`
+	// receipts holds what the components of the wills that fired did
+	Receipts []ExecutionReceipt `protobuf:"bytes,7,rep,name=receipts,proto3" json:"receipts"`
+	// queue holds the IDs of the wills that fired and wait to be executed, in
+	// execution order
+	Queue []string `protobuf:"bytes,8,rep,name=queue,proto3" json:"queue,omitempty"`
+	// pending_packets holds the IBC packets sent by will components that are
+	// not acknowledged yet
+	PendingPackets []PendingPacket `protobuf:"bytes,9,rep,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets"`
+	// packet_retries holds the packets that timed out and are sent again later
+	PacketRetries []PacketRetry `protobuf:"bytes,10,rep,name=packet_retries,json=packetRetries,proto3" json:"packet_retries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,29 +109,246 @@ func (m *GenesisState) GetPortId() string {
 	return ""
 }
 
+func (m *GenesisState) GetWills() []Will {
+	if m != nil {
+		return m.Wills
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []WillEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNullifiers() []Nullifier {
+	if m != nil {
+		return m.Nullifiers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApprovals() []Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceipts() []ExecutionReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueue() []string {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPackets() []PendingPacket {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketRetries() []PacketRetry {
+	if m != nil {
+		return m.PacketRetries
+	}
+	return nil
+}
+
+// Nullifier identifies a claim proof that was used, so it cannot be used again
+type Nullifier struct {
+	// scheme is the name of the claim scheme the proof belongs to
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// nullifier is the value the scheme derives from the proof
+	Nullifier []byte `protobuf:"bytes,2,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
+}
+
+func (m *Nullifier) Reset()         { *m = Nullifier{} }
+func (m *Nullifier) String() string { return proto.CompactTextString(m) }
+func (*Nullifier) ProtoMessage()    {}
+func (*Nullifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f76cd46d504e388, []int{1}
+}
+
+func (m *Nullifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Nullifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Nullifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Nullifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nullifier.Merge(m, src)
+}
+
+func (m *Nullifier) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Nullifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nullifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nullifier proto.InternalMessageInfo
+
+func (m *Nullifier) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *Nullifier) GetNullifier() []byte {
+	if m != nil {
+		return m.Nullifier
+	}
+	return nil
+}
+
+// Approval records that a guardian approved the claim of a component by a
+// claimer
+type Approval struct {
+	WillId      string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// guardian is the index of the approving guardian in the component
+	Guardian uint32 `protobuf:"varint,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// claimer is the address whose claim the guardian approved
+	Claimer string `protobuf:"bytes,4,opt,name=claimer,proto3" json:"claimer,omitempty"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f76cd46d504e388, []int{2}
+}
+
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func (m *Approval) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+func (m *Approval) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+func (m *Approval) GetGuardian() uint32 {
+	if m != nil {
+		return m.Guardian
+	}
+	return 0
+}
+
+func (m *Approval) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.will.GenesisState")
+	proto.RegisterType((*Nullifier)(nil), "cosmwasm.will.Nullifier")
+	proto.RegisterType((*Approval)(nil), "cosmwasm.will.Approval")
 }
 
 func init() { proto.RegisterFile("cosmwasm/will/genesis.proto", fileDescriptor_4f76cd46d504e388) }
 
 var fileDescriptor_4f76cd46d504e388 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xce, 0x2f, 0xce,
-	0x2d, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xcf, 0xcc, 0xc9, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x49, 0xea, 0x81, 0x24, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x85, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xa5, 0x50, 0x0d, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x9a,
-	0xa9, 0x94, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x24, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x82, 0x8b,
-	0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xaa, 0x87, 0x62, 0xa9, 0x5e, 0x00,
-	0x58, 0xd2, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xd5,
-	0x0b, 0x89, 0x73, 0xb1, 0x17, 0xe4, 0x17, 0x95, 0xc4, 0x67, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a,
-	0x70, 0x06, 0xb1, 0x81, 0xb8, 0x9e, 0x29, 0x4e, 0x0e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef,
-	0x9c, 0x5f, 0x9c, 0x1b, 0x0e, 0x76, 0x63, 0x62, 0x71, 0x6e, 0x8a, 0x7e, 0x05, 0xc4, 0xad, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb7, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x95,
-	0xdc, 0x89, 0x06, 0x1e, 0x01, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xba, 0xb5, 0x8d, 0xb7, 0x0e, 0x61, 0x0a, 0xf3, 0xca, 0x94, 0x95, 0x1e, 0x50,
+	0x85, 0x44, 0x23, 0x15, 0x0e, 0x9c, 0xd0, 0xda, 0x69, 0xa0, 0x4a, 0x08, 0x55, 0xd9, 0x61, 0x12,
+	0x97, 0xca, 0x4b, 0x4c, 0x66, 0x91, 0xc4, 0xc6, 0x76, 0xe8, 0xf6, 0x16, 0x9c, 0x78, 0x06, 0x8e,
+	0x1c, 0x78, 0x88, 0x1d, 0x27, 0x4e, 0x1c, 0x10, 0x42, 0xed, 0x81, 0xd7, 0x40, 0x71, 0xd2, 0xd4,
+	0x2b, 0x5c, 0xaa, 0x7e, 0xdf, 0xff, 0xff, 0xff, 0xd9, 0x72, 0xbe, 0x0f, 0x3c, 0xf0, 0x99, 0x8c,
+	0x67, 0x58, 0xc6, 0xee, 0x8c, 0x46, 0x91, 0x1b, 0x92, 0x84, 0x48, 0x2a, 0xfb, 0x5c, 0x30, 0xc5,
+	0x60, 0x73, 0x29, 0xf6, 0x33, 0xb1, 0x7d, 0x07, 0xc7, 0x34, 0x61, 0xae, 0xfe, 0xcd, 0x1d, 0xed,
+	0xbd, 0xcc, 0xc1, 0xe4, 0x54, 0x57, 0x6e, 0x5e, 0x14, 0x52, 0x2b, 0x64, 0x21, 0xcb, 0xfb, 0xd9,
+	0xbf, 0xa2, 0xdb, 0xbe, 0x79, 0x1e, 0xc7, 0x02, 0xc7, 0xd2, 0x84, 0xad, 0x34, 0x75, 0xc9, 0x49,
+	0x21, 0x75, 0x7f, 0x6e, 0x80, 0xed, 0x57, 0xf9, 0xdd, 0x4e, 0x14, 0x56, 0x04, 0x3e, 0x07, 0xb5,
+	0x3c, 0x8b, 0xac, 0x8e, 0xd5, 0xdb, 0x1a, 0xdc, 0xeb, 0xdf, 0xb8, 0x6b, 0x7f, 0xa2, 0xc5, 0x91,
+	0x7d, 0xf5, 0xeb, 0xa0, 0xf2, 0xe5, 0xcf, 0xd7, 0xc7, 0x96, 0x57, 0xf8, 0xe1, 0x2e, 0xa8, 0x73,
+	0x26, 0xd4, 0x94, 0x06, 0xe8, 0x56, 0xc7, 0xea, 0xd9, 0x5e, 0x2d, 0x2b, 0xc7, 0x01, 0x7c, 0x06,
+	0x36, 0xb3, 0xa8, 0x44, 0xd5, 0x4e, 0xb5, 0xb7, 0x35, 0xb8, 0xbb, 0x46, 0x3c, 0xa5, 0x51, 0x64,
+	0xf2, 0x72, 0x33, 0x7c, 0x01, 0xea, 0x44, 0xfa, 0x82, 0xcd, 0x24, 0xda, 0xd0, 0xb9, 0xbd, 0xff,
+	0xe4, 0x8e, 0xb5, 0xc3, 0x4c, 0x2f, 0x43, 0xf0, 0x08, 0x80, 0x24, 0x8d, 0x22, 0xfa, 0x8e, 0x12,
+	0x21, 0xd1, 0xa6, 0x46, 0xa0, 0x35, 0xc4, 0x9b, 0xa5, 0xc1, 0x24, 0x18, 0x31, 0x78, 0x08, 0x6c,
+	0xcc, 0xb9, 0x60, 0x1f, 0x71, 0x24, 0x51, 0x4d, 0x33, 0x76, 0xd7, 0x18, 0xc3, 0x42, 0x37, 0x11,
+	0xab, 0x10, 0x7c, 0x09, 0x1a, 0x82, 0xf8, 0x84, 0x72, 0x25, 0x51, 0x5d, 0x03, 0x0e, 0xd6, 0x00,
+	0xc7, 0x17, 0xc4, 0x4f, 0x15, 0x65, 0x89, 0x97, 0xfb, 0x4c, 0x50, 0x99, 0x85, 0x2d, 0xb0, 0xf9,
+	0x21, 0x25, 0x29, 0x41, 0x8d, 0x4e, 0xb5, 0x67, 0x7b, 0x79, 0x01, 0x27, 0xe0, 0x36, 0x27, 0x49,
+	0x40, 0x93, 0x70, 0xca, 0xb1, 0xff, 0x9e, 0x28, 0x89, 0x6c, 0x7d, 0xc8, 0xfe, 0xfa, 0x67, 0xcb,
+	0x5d, 0x13, 0x6d, 0x32, 0x4f, 0xd8, 0xe1, 0xa6, 0x22, 0xe1, 0x6b, 0xb0, 0x93, 0x93, 0xa6, 0x82,
+	0x28, 0x41, 0x89, 0x44, 0x40, 0x03, 0xdb, 0xff, 0xcc, 0x41, 0x66, 0xf2, 0x88, 0x12, 0x97, 0x26,
+	0xae, 0xc9, 0xcb, 0x3e, 0x25, 0xb2, 0x3b, 0x04, 0x76, 0xf9, 0xc6, 0xf0, 0x3e, 0xa8, 0x49, 0xff,
+	0x9c, 0xc4, 0x44, 0x8f, 0x96, 0xed, 0x15, 0x15, 0xdc, 0x07, 0x76, 0xf9, 0xe4, 0x7a, 0x74, 0xb6,
+	0xbd, 0x55, 0xa3, 0xfb, 0xd9, 0x02, 0x8d, 0xe5, 0x1b, 0x67, 0x33, 0x96, 0x9d, 0x9e, 0xcd, 0x58,
+	0xc1, 0xc8, 0xca, 0x71, 0x00, 0x1f, 0x82, 0x6d, 0x9f, 0xc5, 0x9c, 0x25, 0x24, 0x31, 0x26, 0x70,
+	0xab, 0xec, 0x8d, 0x03, 0xd8, 0x06, 0x8d, 0x30, 0xc5, 0x22, 0xa0, 0x38, 0x41, 0xd5, 0x8e, 0xd5,
+	0x6b, 0x7a, 0x65, 0x0d, 0x07, 0xa0, 0xee, 0x47, 0x98, 0xc6, 0x44, 0xa0, 0x8d, 0x2c, 0x39, 0x42,
+	0xdf, 0xbf, 0x3d, 0x69, 0x15, 0x6b, 0x37, 0x0c, 0x02, 0x41, 0xa4, 0x3c, 0x51, 0x82, 0x26, 0xa1,
+	0xb7, 0x34, 0x8e, 0x0e, 0xaf, 0xe6, 0x8e, 0x75, 0x3d, 0x77, 0xac, 0xdf, 0x73, 0xc7, 0xfa, 0xb4,
+	0x70, 0x2a, 0xd7, 0x0b, 0xa7, 0xf2, 0x63, 0xe1, 0x54, 0xde, 0x3e, 0x0a, 0xa9, 0x3a, 0x4f, 0xcf,
+	0xfa, 0x3e, 0x8b, 0xdd, 0x23, 0x26, 0xe3, 0x53, 0xbd, 0x7a, 0x58, 0xc6, 0x81, 0x7b, 0x61, 0xac,
+	0xe0, 0x59, 0x4d, 0xef, 0xe0, 0xd3, 0xbf, 0x03, 0x00, 0xd5, 0x2d, 0xc1, 0x95, 0x2c, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +371,113 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PacketRetries) > 0 {
+		for iNdEx := len(m.PacketRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for iNdEx := len(m.PendingPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Queue) > 0 {
+		for iNdEx := len(m.Queue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Queue[iNdEx])
+			copy(dAtA[i:], m.Queue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Queue[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Nullifiers) > 0 {
+		for iNdEx := len(m.Nullifiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nullifiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Wills) > 0 {
+		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PortId) > 0 {
 		i -= len(m.PortId)
 		copy(dAtA[i:], m.PortId)
@@ -154,6 +498,92 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Nullifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nullifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nullifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nullifier) > 0 {
+		i -= len(m.Nullifier)
+		copy(dAtA[i:], m.Nullifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Nullifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scheme) > 0 {
+		i -= len(m.Scheme)
+		copy(dAtA[i:], m.Scheme)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Scheme)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Guardian != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Guardian))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -178,6 +608,95 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Wills) > 0 {
+		for _, e := range m.Wills {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Nullifiers) > 0 {
+		for _, e := range m.Nullifiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Queue) > 0 {
+		for _, s := range m.Queue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for _, e := range m.PendingPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketRetries) > 0 {
+		for _, e := range m.PacketRetries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Nullifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Nullifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Guardian != 0 {
+		n += 1 + sovGenesis(uint64(m.Guardian))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -283,6 +802,559 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wills = append(m.Wills, Will{})
+			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, WillEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nullifiers = append(m.Nullifiers, Nullifier{})
+			if err := m.Nullifiers[len(m.Nullifiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, ExecutionReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = append(m.Queue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPackets = append(m.PendingPackets, PendingPacket{})
+			if err := m.PendingPackets[len(m.PendingPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketRetries = append(m.PacketRetries, PacketRetry{})
+			if err := m.PacketRetries[len(m.PacketRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Nullifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nullifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nullifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nullifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nullifier = append(m.Nullifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nullifier == nil {
+				m.Nullifier = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Approval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			m.Guardian = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Guardian |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,6 @@
 package types
 
-import "strings"
+import "cosmossdk.io/collections"
 
 const (
 	ModuleName = "will"
@@ -16,22 +16,47 @@ const (
 )
 
 var (
-	WillPrefix = []byte{0x01}
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x02}
-)
-
-func GetWillKey(willID string) []byte {
-	// willID8z := sdk.Uint64ToBigEndian(willID)
-	stringKey := []byte(strings.ToLower(willID))
-	// return append(WillPrefix, willID8z...)
-	// return append(WillPrefix, willID...)
-	return append(WillPrefix, stringKey...)
-}
 
-/*
-var ints []int32 = []int32{1, 2}
-fmt.Println(ints[0])
-var _map map[int]int = map[int]int{1: 10}
-print(_map[1])
-*/
+	// WillsPrefix defines the prefix of the wills collection, keyed by will ID
+	WillsPrefix = collections.NewPrefix(16)
+	// WillsByCreatorPrefix defines the prefix of the wills index by creator
+	WillsByCreatorPrefix = collections.NewPrefix(17)
+	// WillsByBeneficiaryPrefix defines the prefix of the wills index by beneficiary
+	WillsByBeneficiaryPrefix = collections.NewPrefix(18)
+	// WillsByHeightPrefix defines the prefix of the wills index by trigger height
+	WillsByHeightPrefix = collections.NewPrefix(19)
+	// WillsByStatusPrefix defines the prefix of the wills index by status
+	WillsByStatusPrefix = collections.NewPrefix(20)
+	// EscrowsPrefix defines the prefix of the will escrows collection, keyed by will ID
+	EscrowsPrefix = collections.NewPrefix(21)
+	// WillsByClaimantPrefix defines the prefix of the wills index by claim component claimant
+	WillsByClaimantPrefix = collections.NewPrefix(22)
+	// NullifiersPrefix defines the prefix of the used claim proofs, keyed by claim scheme and nullifier
+	NullifiersPrefix = collections.NewPrefix(23)
+	// ApprovalsPrefix defines the prefix of the guardian approvals, keyed by will ID, component ID, claimer and guardian
+	ApprovalsPrefix = collections.NewPrefix(24)
+	// LapsesByHeightPrefix defines the prefix of the claim windows closing at a block height, keyed by height, will ID and component ID
+	LapsesByHeightPrefix = collections.NewPrefix(25)
+	// LapsesByTimePrefix defines the prefix of the claim windows closing at a block time, keyed by time, will ID and component ID
+	LapsesByTimePrefix = collections.NewPrefix(26)
+	// WillsByTriggerTimePrefix defines the prefix of the live wills index by trigger time
+	WillsByTriggerTimePrefix = collections.NewPrefix(27)
+	// WillsByConditionHeightPrefix defines the prefix of the live wills index by the height their condition is evaluated at next
+	WillsByConditionHeightPrefix = collections.NewPrefix(28)
+	// ReceiptsPrefix defines the prefix of the component execution receipts, keyed by will ID and component index
+	ReceiptsPrefix = collections.NewPrefix(29)
+	// WillsByTriggerHeightPrefix defines the prefix of the live wills index by trigger height
+	WillsByTriggerHeightPrefix = collections.NewPrefix(30)
+	// ExecutionQueuePrefix defines the prefix of the queue of wills that fired and wait to be executed, keyed by sequence
+	ExecutionQueuePrefix = collections.NewPrefix(31)
+	// ExecutionQueueSequencePrefix defines the prefix of the sequence of the execution queue
+	ExecutionQueueSequencePrefix = collections.NewPrefix(32)
+	// QueuePositionsPrefix defines the prefix of the sequences of the queued wills in the execution queue, keyed by will ID
+	QueuePositionsPrefix = collections.NewPrefix(33)
+	// PendingPacketsPrefix defines the prefix of the IBC packets sent by will components that are not acknowledged yet, keyed by source channel and sequence
+	PendingPacketsPrefix = collections.NewPrefix(34)
+	// PacketRetriesPrefix defines the prefix of the packets that timed out and are sent again, keyed by block height, will ID and component ID
+	PacketRetriesPrefix = collections.NewPrefix(35)
+)
@@ -0,0 +1,66 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Version is the version of the will packet protocol, negotiated as the version of the channels
+// of the will port
+const Version = "will-version-1"
+
+// packetCdc encodes the will packets as JSON
+var packetCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// ResultAcknowledgement is the acknowledgement result of the will packets that have no other result
+var ResultAcknowledgement = []byte{0x01}
+
+// GetBytes returns the JSON encoding of the packet data, as it is sent over the will port
+func (p WillPacketData) GetBytes() ([]byte, error) {
+	bz, err := packetCdc.MarshalJSON(&p)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// DecodeWillPacketData decodes and validates the JSON encoding of a will packet
+func DecodeWillPacketData(bz []byte) (WillPacketData, error) {
+	var data WillPacketData
+	if err := packetCdc.UnmarshalJSON(bz, &data); err != nil {
+		return data, errorsmod.Wrapf(ErrInvalid, "will packet data: %s", err)
+	}
+	return data, data.ValidateBasic()
+}
+
+// ValidateBasic checks that the packet data holds a packet with the fields it needs. The
+// sender is set by the sending chain, it may be empty until then.
+func (p WillPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *WillPacketData_CheckIn:
+		if packet.CheckIn == nil || packet.CheckIn.WillId == "" {
+			return errorsmod.Wrap(ErrInvalid, "check-in packet without a will id")
+		}
+	case *WillPacketData_Claim:
+		if packet.Claim == nil || packet.Claim.Claim == nil {
+			return errorsmod.Wrap(ErrInvalid, "claim packet without a claim")
+		}
+		if packet.Claim.Claim.WillId == "" || packet.Claim.Claim.ComponentId == "" {
+			return errorsmod.Wrap(ErrInvalid, "claim packet without a will or component id")
+		}
+	case *WillPacketData_StatusQuery:
+		if packet.StatusQuery == nil || packet.StatusQuery.WillId == "" {
+			return errorsmod.Wrap(ErrInvalid, "status query packet without a will id")
+		}
+	case *WillPacketData_Triggered:
+		if packet.Triggered == nil {
+			return errorsmod.Wrap(ErrInvalid, "empty will triggered packet")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalid, "unknown will packet %T", p.Packet)
+	}
+	return nil
+}
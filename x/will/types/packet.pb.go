@@ -0,0 +1,1996 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/will/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WillPacketData is the data of a packet sent over the will port, encoded as
+// JSON. The sending chain sets the sender to the creator of the will whose IBC
+// message component sends the packet. The receiving chain only acts for a
+// local account with the same address bytes as the sender.
+type WillPacketData struct {
+	// sender is the address on the sending chain the packet acts for
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// packet is what the packet asks of the receiving chain
+	//
+	// Types that are valid to be assigned to Packet:
+	//	*WillPacketData_CheckIn
+	//	*WillPacketData_Claim
+	//	*WillPacketData_StatusQuery
+	//	*WillPacketData_Triggered
+	Packet isWillPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *WillPacketData) Reset()         { *m = WillPacketData{} }
+func (m *WillPacketData) String() string { return proto.CompactTextString(m) }
+func (*WillPacketData) ProtoMessage()    {}
+func (*WillPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{0}
+}
+
+func (m *WillPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WillPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WillPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillPacketData.Merge(m, src)
+}
+
+func (m *WillPacketData) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WillPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WillPacketData proto.InternalMessageInfo
+
+type isWillPacketData_Packet interface {
+	isWillPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type WillPacketData_CheckIn struct {
+	CheckIn *RemoteCheckInPacket `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3,oneof" json:"check_in,omitempty"`
+}
+type WillPacketData_Claim struct {
+	Claim *RemoteClaimPacket `protobuf:"bytes,3,opt,name=claim,proto3,oneof" json:"claim,omitempty"`
+}
+type WillPacketData_StatusQuery struct {
+	StatusQuery *WillStatusQueryPacket `protobuf:"bytes,4,opt,name=status_query,json=statusQuery,proto3,oneof" json:"status_query,omitempty"`
+}
+type WillPacketData_Triggered struct {
+	Triggered *WillTriggeredPacket `protobuf:"bytes,5,opt,name=triggered,proto3,oneof" json:"triggered,omitempty"`
+}
+
+func (*WillPacketData_CheckIn) isWillPacketData_Packet()     {}
+func (*WillPacketData_Claim) isWillPacketData_Packet()       {}
+func (*WillPacketData_StatusQuery) isWillPacketData_Packet() {}
+func (*WillPacketData_Triggered) isWillPacketData_Packet()   {}
+
+func (m *WillPacketData) GetPacket() isWillPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *WillPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *WillPacketData) GetCheckIn() *RemoteCheckInPacket {
+	if x, ok := m.GetPacket().(*WillPacketData_CheckIn); ok {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (m *WillPacketData) GetClaim() *RemoteClaimPacket {
+	if x, ok := m.GetPacket().(*WillPacketData_Claim); ok {
+		return x.Claim
+	}
+	return nil
+}
+
+func (m *WillPacketData) GetStatusQuery() *WillStatusQueryPacket {
+	if x, ok := m.GetPacket().(*WillPacketData_StatusQuery); ok {
+		return x.StatusQuery
+	}
+	return nil
+}
+
+func (m *WillPacketData) GetTriggered() *WillTriggeredPacket {
+	if x, ok := m.GetPacket().(*WillPacketData_Triggered); ok {
+		return x.Triggered
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WillPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WillPacketData_CheckIn)(nil),
+		(*WillPacketData_Claim)(nil),
+		(*WillPacketData_StatusQuery)(nil),
+		(*WillPacketData_Triggered)(nil),
+	}
+}
+
+// RemoteCheckInPacket checks in on a will of the receiving chain whose creator
+// is the sender
+type RemoteCheckInPacket struct {
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+}
+
+func (m *RemoteCheckInPacket) Reset()         { *m = RemoteCheckInPacket{} }
+func (m *RemoteCheckInPacket) String() string { return proto.CompactTextString(m) }
+func (*RemoteCheckInPacket) ProtoMessage()    {}
+func (*RemoteCheckInPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{1}
+}
+
+func (m *RemoteCheckInPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RemoteCheckInPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteCheckInPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RemoteCheckInPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteCheckInPacket.Merge(m, src)
+}
+
+func (m *RemoteCheckInPacket) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RemoteCheckInPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteCheckInPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteCheckInPacket proto.InternalMessageInfo
+
+func (m *RemoteCheckInPacket) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+// RemoteClaimPacket submits a claim on a will component of the receiving chain,
+// the claimer must be the sender
+type RemoteClaimPacket struct {
+	Claim *MsgClaimRequest `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+}
+
+func (m *RemoteClaimPacket) Reset()         { *m = RemoteClaimPacket{} }
+func (m *RemoteClaimPacket) String() string { return proto.CompactTextString(m) }
+func (*RemoteClaimPacket) ProtoMessage()    {}
+func (*RemoteClaimPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{2}
+}
+
+func (m *RemoteClaimPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RemoteClaimPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteClaimPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RemoteClaimPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteClaimPacket.Merge(m, src)
+}
+
+func (m *RemoteClaimPacket) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RemoteClaimPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteClaimPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteClaimPacket proto.InternalMessageInfo
+
+func (m *RemoteClaimPacket) GetClaim() *MsgClaimRequest {
+	if m != nil {
+		return m.Claim
+	}
+	return nil
+}
+
+// WillStatusQueryPacket asks for the status of a will of the receiving chain,
+// the acknowledgement result holds a WillStatusAck
+type WillStatusQueryPacket struct {
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+}
+
+func (m *WillStatusQueryPacket) Reset()         { *m = WillStatusQueryPacket{} }
+func (m *WillStatusQueryPacket) String() string { return proto.CompactTextString(m) }
+func (*WillStatusQueryPacket) ProtoMessage()    {}
+func (*WillStatusQueryPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{3}
+}
+
+func (m *WillStatusQueryPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WillStatusQueryPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillStatusQueryPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WillStatusQueryPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillStatusQueryPacket.Merge(m, src)
+}
+
+func (m *WillStatusQueryPacket) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WillStatusQueryPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillStatusQueryPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WillStatusQueryPacket proto.InternalMessageInfo
+
+func (m *WillStatusQueryPacket) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+// WillTriggeredPacket notifies the receiving chain that a will of the sending
+// chain fired, the sending chain sets the will and height
+type WillTriggeredPacket struct {
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	// height is the block height of the sending chain the will fired at
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *WillTriggeredPacket) Reset()         { *m = WillTriggeredPacket{} }
+func (m *WillTriggeredPacket) String() string { return proto.CompactTextString(m) }
+func (*WillTriggeredPacket) ProtoMessage()    {}
+func (*WillTriggeredPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{4}
+}
+
+func (m *WillTriggeredPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WillTriggeredPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillTriggeredPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WillTriggeredPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillTriggeredPacket.Merge(m, src)
+}
+
+func (m *WillTriggeredPacket) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WillTriggeredPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillTriggeredPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WillTriggeredPacket proto.InternalMessageInfo
+
+func (m *WillTriggeredPacket) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+func (m *WillTriggeredPacket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// WillStatusAck is the acknowledgement result of a WillStatusQueryPacket, the
+// other packets acknowledge success with the result 0x01
+type WillStatusAck struct {
+	WillId     string                `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	Status     string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Components []WillComponentStatus `protobuf:"bytes,3,rep,name=components,proto3" json:"components"`
+}
+
+func (m *WillStatusAck) Reset()         { *m = WillStatusAck{} }
+func (m *WillStatusAck) String() string { return proto.CompactTextString(m) }
+func (*WillStatusAck) ProtoMessage()    {}
+func (*WillStatusAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{5}
+}
+
+func (m *WillStatusAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WillStatusAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillStatusAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WillStatusAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillStatusAck.Merge(m, src)
+}
+
+func (m *WillStatusAck) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WillStatusAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillStatusAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WillStatusAck proto.InternalMessageInfo
+
+func (m *WillStatusAck) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+func (m *WillStatusAck) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *WillStatusAck) GetComponents() []WillComponentStatus {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+// WillComponentStatus is the status of a component of a will
+type WillComponentStatus struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *WillComponentStatus) Reset()         { *m = WillComponentStatus{} }
+func (m *WillComponentStatus) String() string { return proto.CompactTextString(m) }
+func (*WillComponentStatus) ProtoMessage()    {}
+func (*WillComponentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f76b5a292eb55f, []int{6}
+}
+
+func (m *WillComponentStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WillComponentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillComponentStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WillComponentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillComponentStatus.Merge(m, src)
+}
+
+func (m *WillComponentStatus) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WillComponentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillComponentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WillComponentStatus proto.InternalMessageInfo
+
+func (m *WillComponentStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WillComponentStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*WillPacketData)(nil), "cosmwasm.will.WillPacketData")
+	proto.RegisterType((*RemoteCheckInPacket)(nil), "cosmwasm.will.RemoteCheckInPacket")
+	proto.RegisterType((*RemoteClaimPacket)(nil), "cosmwasm.will.RemoteClaimPacket")
+	proto.RegisterType((*WillStatusQueryPacket)(nil), "cosmwasm.will.WillStatusQueryPacket")
+	proto.RegisterType((*WillTriggeredPacket)(nil), "cosmwasm.will.WillTriggeredPacket")
+	proto.RegisterType((*WillStatusAck)(nil), "cosmwasm.will.WillStatusAck")
+	proto.RegisterType((*WillComponentStatus)(nil), "cosmwasm.will.WillComponentStatus")
+}
+
+func init() { proto.RegisterFile("cosmwasm/will/packet.proto", fileDescriptor_c3f76b5a292eb55f) }
+
+var fileDescriptor_c3f76b5a292eb55f = []byte{
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0x3a, 0x6d, 0xda, 0x4e, 0x69, 0x25, 0x36, 0x50, 0xac, 0x1c, 0x4c, 0x64, 0x21, 0xd4,
+	0x93, 0x8d, 0x80, 0x03, 0x17, 0x04, 0x24, 0x08, 0x35, 0x07, 0x24, 0x30, 0x48, 0x95, 0xb8, 0x44,
+	0xee, 0x7a, 0xe5, 0xac, 0xe2, 0xf5, 0xa6, 0xde, 0x8d, 0xda, 0xfe, 0x02, 0x27, 0x3e, 0x88, 0x0f,
+	0xe8, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x3f, 0x82, 0x76, 0xd7, 0x4e, 0x68, 0x70, 0x7a, 0xf3, 0xec,
+	0xbc, 0xf7, 0x66, 0xde, 0x8c, 0x07, 0xba, 0x44, 0x48, 0x7e, 0x91, 0x48, 0x1e, 0x5d, 0xb0, 0x3c,
+	0x8f, 0xa6, 0x09, 0x99, 0x50, 0x15, 0x4e, 0x4b, 0xa1, 0x04, 0x3e, 0xa8, 0x73, 0xa1, 0xce, 0x75,
+	0x1f, 0x64, 0x22, 0x13, 0x26, 0x13, 0xe9, 0x2f, 0x0b, 0xea, 0x1e, 0xdd, 0x16, 0x50, 0x97, 0xf6,
+	0x3d, 0xf8, 0xe9, 0xc2, 0xe1, 0x29, 0xcb, 0xf3, 0x4f, 0x46, 0xf1, 0x7d, 0xa2, 0x12, 0x7c, 0x04,
+	0x6d, 0x49, 0x8b, 0x94, 0x96, 0x1e, 0xea, 0xa1, 0xe3, 0xbd, 0xb8, 0x8a, 0xf0, 0x1b, 0xd8, 0x25,
+	0x63, 0x4a, 0x26, 0x23, 0x56, 0x78, 0x6e, 0x0f, 0x1d, 0xef, 0x3f, 0x0f, 0xc2, 0x5b, 0xa5, 0xc3,
+	0x98, 0x72, 0xa1, 0xe8, 0x40, 0x83, 0x86, 0x85, 0x55, 0x3c, 0x71, 0xe2, 0x1d, 0x62, 0x1f, 0xf0,
+	0x2b, 0xd8, 0x26, 0x79, 0xc2, 0xb8, 0xd7, 0x32, 0xec, 0x5e, 0x33, 0x5b, 0x23, 0x96, 0x5c, 0x4b,
+	0xc0, 0x43, 0xb8, 0x27, 0x55, 0xa2, 0x66, 0x72, 0x74, 0x3e, 0xa3, 0xe5, 0x95, 0xb7, 0x65, 0x04,
+	0x9e, 0xac, 0x09, 0x68, 0x1f, 0x5f, 0x0c, 0xec, 0xb3, 0x46, 0x2d, 0x45, 0xf6, 0xe5, 0xea, 0x11,
+	0xf7, 0x61, 0x4f, 0x95, 0x2c, 0xcb, 0x68, 0x49, 0x53, 0x6f, 0xbb, 0xd1, 0x86, 0xd6, 0xf9, 0x5a,
+	0x63, 0x96, 0x2a, 0x2b, 0x5a, 0x7f, 0x17, 0xda, 0x76, 0x03, 0x41, 0x08, 0x9d, 0x06, 0xd3, 0xf8,
+	0x11, 0xec, 0x68, 0xa5, 0x11, 0x4b, 0xeb, 0x19, 0xea, 0x70, 0x98, 0x06, 0x43, 0xb8, 0xff, 0x9f,
+	0x4d, 0xfc, 0xb2, 0x9e, 0x0b, 0x32, 0xed, 0xf8, 0x6b, 0xed, 0x7c, 0x94, 0x99, 0x41, 0xc7, 0xf4,
+	0x7c, 0x46, 0xa5, 0xaa, 0x66, 0x12, 0x3c, 0x83, 0x87, 0x8d, 0x86, 0x37, 0x17, 0xff, 0x00, 0x9d,
+	0x06, 0x6b, 0x1b, 0xf1, 0xfa, 0x47, 0x18, 0x53, 0x96, 0x8d, 0x95, 0x59, 0x77, 0x2b, 0xae, 0xa2,
+	0xe0, 0x3b, 0x82, 0x83, 0x55, 0xe9, 0x77, 0x64, 0x72, 0xa7, 0x84, 0x1d, 0xbe, 0xe7, 0x56, 0xff,
+	0x92, 0x89, 0xf0, 0x09, 0x00, 0x11, 0x7c, 0x2a, 0x0a, 0x5a, 0x28, 0xe9, 0xb5, 0x7a, 0xad, 0x0d,
+	0x6b, 0x18, 0xd4, 0x20, 0x5b, 0xab, 0xbf, 0x75, 0xfd, 0xfb, 0xb1, 0x13, 0xff, 0xc3, 0x0d, 0x5e,
+	0x43, 0xa7, 0x01, 0x88, 0x0f, 0xc1, 0x5d, 0x36, 0xe3, 0xb2, 0x8d, 0x8d, 0xf4, 0xdf, 0x5e, 0xcf,
+	0x7d, 0x74, 0x33, 0xf7, 0xd1, 0x9f, 0xb9, 0x8f, 0x7e, 0x2c, 0x7c, 0xe7, 0x66, 0xe1, 0x3b, 0xbf,
+	0x16, 0xbe, 0xf3, 0xed, 0x69, 0xc6, 0xd4, 0x78, 0x76, 0x16, 0x12, 0xc1, 0xa3, 0x81, 0x90, 0xfc,
+	0xd4, 0x1c, 0x4f, 0x22, 0x79, 0x1a, 0x5d, 0x56, 0x47, 0x74, 0x35, 0xa5, 0xf2, 0xac, 0x6d, 0x0e,
+	0xe9, 0xc5, 0xdf, 0x01, 0x00, 0x81, 0x1b, 0x82, 0x7f, 0xa3, 0x03, 0x00, 0x00,
+}
+
+func (m *WillPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillPacketData_CheckIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillPacketData_CheckIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CheckIn != nil {
+		{
+			size, err := m.CheckIn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillPacketData_Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillPacketData_Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillPacketData_StatusQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillPacketData_StatusQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StatusQuery != nil {
+		{
+			size, err := m.StatusQuery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillPacketData_Triggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillPacketData_Triggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Triggered != nil {
+		{
+			size, err := m.Triggered.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteCheckInPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteCheckInPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteCheckInPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteClaimPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteClaimPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteClaimPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillStatusQueryPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillStatusQueryPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillStatusQueryPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillTriggeredPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillTriggeredPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillTriggeredPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillStatusAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillStatusAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillStatusAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillComponentStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillComponentStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillComponentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *WillPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *WillPacketData_CheckIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckIn != nil {
+		l = m.CheckIn.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *WillPacketData_Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *WillPacketData_StatusQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatusQuery != nil {
+		l = m.StatusQuery.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *WillPacketData_Triggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Triggered != nil {
+		l = m.Triggered.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteCheckInPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteClaimPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *WillStatusQueryPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *WillTriggeredPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *WillStatusAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WillComponentStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *WillPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WillPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WillPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RemoteCheckInPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &WillPacketData_CheckIn{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RemoteClaimPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &WillPacketData_Claim{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WillStatusQueryPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &WillPacketData_StatusQuery{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WillTriggeredPacket{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &WillPacketData_Triggered{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RemoteCheckInPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteCheckInPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteCheckInPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RemoteClaimPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteClaimPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteClaimPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &MsgClaimRequest{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WillStatusQueryPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WillStatusQueryPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WillStatusQueryPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WillTriggeredPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WillTriggeredPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WillTriggeredPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WillStatusAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WillStatusAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WillStatusAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, WillComponentStatus{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WillComponentStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WillComponentStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WillComponentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
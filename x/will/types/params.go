@@ -1,6 +1,147 @@
 package types
 
-// DefaultParams returns default wasm parameters
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// component type names used by Params.EnabledComponentTypes
+const (
+	ComponentTypeTransfer     = "transfer"
+	ComponentTypeClaim        = "claim"
+	ComponentTypeContract     = "contract"
+	ComponentTypeIBCMsg       = "ibc_msg"
+	ComponentTypeIBCSend      = "ibc_send"
+	ComponentTypeDistribution = "distribution"
+	ComponentTypeVesting      = "vesting"
+	ComponentTypeICA          = "ica"
+)
+
+// AllComponentTypes lists every component type the module can execute
+var AllComponentTypes = []string{
+	ComponentTypeTransfer,
+	ComponentTypeClaim,
+	ComponentTypeContract,
+	ComponentTypeIBCMsg,
+	ComponentTypeIBCSend,
+	ComponentTypeDistribution,
+	ComponentTypeVesting,
+	ComponentTypeICA,
+}
+
+const (
+	// DefaultMaxWillsPerHeight is the number of due wills of each trigger kind scheduled in a single block
+	DefaultMaxWillsPerHeight uint32 = 10
+	// DefaultMaxComponentsPerWill is the number of components a will can hold
+	DefaultMaxComponentsPerWill uint32 = 16
+	// DefaultMaxConditionGas is the gas the contract query of a condition trigger can use
+	DefaultMaxConditionGas uint64 = 200_000
+	// DefaultMaxBlockExecutionGas is the gas the queued wills can use together in a single block
+	DefaultMaxBlockExecutionGas uint64 = 10_000_000
+	// DefaultExecutorBountyPercent is the percentage of the creation deposit paid to the executor of a will
+	DefaultExecutorBountyPercent uint32 = 50
+	// DefaultIBCTimeout is the time after which an IBC transfer sent by a will times out
+	DefaultIBCTimeout = 10 * time.Minute
+)
+
+// DefaultParams returns default will parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		MaxWillsPerHeight:     DefaultMaxWillsPerHeight,
+		MaxComponentsPerWill:  DefaultMaxComponentsPerWill,
+		MinTriggerHorizon:     0,
+		MaxTriggerHorizon:     0,
+		EnabledComponentTypes: append([]string{}, AllComponentTypes...),
+		MaxConditionGas:       DefaultMaxConditionGas,
+		MaxBlockExecutionGas:  DefaultMaxBlockExecutionGas,
+		ExecutorBountyPercent: DefaultExecutorBountyPercent,
+		IBCTimeout:            DefaultIBCTimeout,
+	}
+}
+
+// ValidateBasic performs basic validation on will parameters
+func (p Params) ValidateBasic() error {
+	if p.MaxWillsPerHeight == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max wills per height must be positive")
+	}
+	if p.MaxBlockExecutionGas == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max block execution gas must be positive")
+	}
+	if p.ExecutorBountyPercent > 100 {
+		return errorsmod.Wrapf(ErrInvalid, "executor bounty percent %d is above 100", p.ExecutorBountyPercent)
+	}
+	if p.IBCTimeout <= 0 {
+		return errorsmod.Wrapf(ErrInvalid, "ibc timeout %s must be positive", p.IBCTimeout)
+	}
+	if p.MaxComponentsPerWill == 0 {
+		return errorsmod.Wrap(ErrInvalid, "max components per will must be positive")
+	}
+	if p.MinTriggerHorizon < 0 {
+		return errorsmod.Wrapf(ErrInvalid, "min trigger horizon %d must not be negative", p.MinTriggerHorizon)
+	}
+	if p.MaxTriggerHorizon < 0 {
+		return errorsmod.Wrapf(ErrInvalid, "max trigger horizon %d must not be negative", p.MaxTriggerHorizon)
+	}
+	if p.MaxTriggerHorizon != 0 && p.MaxTriggerHorizon < p.MinTriggerHorizon {
+		return errorsmod.Wrapf(ErrInvalid, "max trigger horizon %d is below min trigger horizon %d", p.MaxTriggerHorizon, p.MinTriggerHorizon)
+	}
+	if err := p.CreationDeposit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "creation deposit")
+	}
+	seen := make(map[string]struct{}, len(p.EnabledComponentTypes))
+	for _, t := range p.EnabledComponentTypes {
+		if !isComponentType(t) {
+			return errorsmod.Wrapf(ErrInvalid, "unknown component type: %q", t)
+		}
+		if _, ok := seen[t]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "component type: %q", t)
+		}
+		seen[t] = struct{}{}
+	}
+	return nil
+}
+
+// IsComponentTypeEnabled returns true when wills may use the given component type
+func (p Params) IsComponentTypeEnabled(componentType string) bool {
+	for _, t := range p.EnabledComponentTypes {
+		if t == componentType {
+			return true
+		}
+	}
+	return false
+}
+
+func isComponentType(componentType string) bool {
+	for _, t := range AllComponentTypes {
+		if t == componentType {
+			return true
+		}
+	}
+	return false
+}
+
+// ComponentTypeName returns the params name of the type of a component
+func ComponentTypeName(component *ExecutionComponent) (string, error) {
+	switch component.ComponentType.(type) {
+	case *ExecutionComponent_Transfer:
+		return ComponentTypeTransfer, nil
+	case *ExecutionComponent_Claim:
+		return ComponentTypeClaim, nil
+	case *ExecutionComponent_Contract:
+		return ComponentTypeContract, nil
+	case *ExecutionComponent_IbcMsg:
+		return ComponentTypeIBCMsg, nil
+	case *ExecutionComponent_IbcSend:
+		return ComponentTypeIBCSend, nil
+	case *ExecutionComponent_Distribution:
+		return ComponentTypeDistribution, nil
+	case *ExecutionComponent_Vesting:
+		return ComponentTypeVesting, nil
+	case *ExecutionComponent_Ica:
+		return ComponentTypeICA, nil
+	default:
+		return "", fmt.Errorf("unsupported component type: %T", component.ComponentType)
+	}
 }
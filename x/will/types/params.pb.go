@@ -10,10 +10,15 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,6 +26,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -30,7 +36,37 @@ var (
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-type Params struct{}
+type Params struct {
+	// maximum number of due wills of each trigger kind moved to the execution
+	// queue in a block, and of condition triggers evaluated in a block, the
+	// others stay due for the next blocks
+	MaxWillsPerHeight uint32 `protobuf:"varint,1,opt,name=max_wills_per_height,json=maxWillsPerHeight,proto3" json:"max_wills_per_height,omitempty"`
+	// maximum number of components a will can hold
+	MaxComponentsPerWill uint32 `protobuf:"varint,2,opt,name=max_components_per_will,json=maxComponentsPerWill,proto3" json:"max_components_per_will,omitempty"`
+	// minimum number of blocks between now and a will's trigger height
+	MinTriggerHorizon int64 `protobuf:"varint,3,opt,name=min_trigger_horizon,json=minTriggerHorizon,proto3" json:"min_trigger_horizon,omitempty"`
+	// maximum number of blocks between now and a will's trigger height, zero
+	// disables the limit
+	MaxTriggerHorizon int64 `protobuf:"varint,4,opt,name=max_trigger_horizon,json=maxTriggerHorizon,proto3" json:"max_trigger_horizon,omitempty"`
+	// deposit taken from the creator of a will, returned once the will fires or
+	// is cancelled
+	CreationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=creation_deposit,json=creationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_deposit"`
+	// component types wills may use, e.g. transfer, claim, contract, ibc_msg,
+	// ibc_send
+	EnabledComponentTypes []string `protobuf:"bytes,6,rep,name=enabled_component_types,json=enabledComponentTypes,proto3" json:"enabled_component_types,omitempty"`
+	// maximum gas the contract query of a condition trigger may use, zero
+	// disables condition triggers
+	MaxConditionGas uint64 `protobuf:"varint,7,opt,name=max_condition_gas,json=maxConditionGas,proto3" json:"max_condition_gas,omitempty"`
+	// gas the components of the queued wills may use together in a block, the
+	// wills that do not fit in it are carried over to the next block
+	MaxBlockExecutionGas uint64 `protobuf:"varint,8,opt,name=max_block_execution_gas,json=maxBlockExecutionGas,proto3" json:"max_block_execution_gas,omitempty"`
+	// percentage of a will's creation deposit paid to whoever executes the will
+	// or claims from it before the execution queue runs it, the rest is returned
+	// to the creator
+	ExecutorBountyPercent uint32 `protobuf:"varint,9,opt,name=executor_bounty_percent,json=executorBountyPercent,proto3" json:"executor_bounty_percent,omitempty"`
+	// time after the block time an IBC transfer sent by a will times out
+	IBCTimeout time.Duration `protobuf:"bytes,10,opt,name=ibc_timeout,json=ibcTimeout,proto3,stdduration" json:"ibc_timeout"`
+}
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
@@ -70,6 +106,76 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxWillsPerHeight() uint32 {
+	if m != nil {
+		return m.MaxWillsPerHeight
+	}
+	return 0
+}
+
+func (m *Params) GetMaxComponentsPerWill() uint32 {
+	if m != nil {
+		return m.MaxComponentsPerWill
+	}
+	return 0
+}
+
+func (m *Params) GetMinTriggerHorizon() int64 {
+	if m != nil {
+		return m.MinTriggerHorizon
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTriggerHorizon() int64 {
+	if m != nil {
+		return m.MaxTriggerHorizon
+	}
+	return 0
+}
+
+func (m *Params) GetCreationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetEnabledComponentTypes() []string {
+	if m != nil {
+		return m.EnabledComponentTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxConditionGas() uint64 {
+	if m != nil {
+		return m.MaxConditionGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxBlockExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetExecutorBountyPercent() uint32 {
+	if m != nil {
+		return m.ExecutorBountyPercent
+	}
+	return 0
+}
+
+func (m *Params) GetIBCTimeout() time.Duration {
+	if m != nil {
+		return m.IBCTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmwasm.will.Params")
 }
@@ -77,18 +183,43 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/will/params.proto", fileDescriptor_2f5bdc4c04926bb3) }
 
 var fileDescriptor_2f5bdc4c04926bb3 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2f, 0xce,
-	0x2d, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xcf, 0xcc, 0xc9, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xc9, 0xe9, 0x81, 0xe4, 0xa4, 0x04, 0x13,
-	0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x85, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98,
-	0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xd4, 0xb8, 0xd8, 0x02, 0xc0, 0xe6, 0x58, 0xc9, 0xbc, 0x58,
-	0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x30, 0xc8, 0x98, 0x14, 0xfd, 0x0a, 0x88, 0x2d, 0x10,
-	0x59, 0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4b, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0xce, 0x2f, 0xce, 0x0d, 0x07, 0x3b,
-	0x10, 0xd9, 0x88, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x85, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x2a, 0xdf, 0x25, 0x07, 0xc6, 0x00, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc7, 0x9b, 0x5f, 0xb7, 0xfd, 0x98, 0xa7, 0x69, 0x34, 0xdb, 0x58, 0xa8, 0x50, 0x1a, 0x71,
+	0x40, 0x51, 0x25, 0x62, 0x0d, 0x34, 0x0e, 0x9c, 0x50, 0x3a, 0xc4, 0xb8, 0x55, 0x51, 0xa5, 0x49,
+	0x5c, 0x22, 0x27, 0x31, 0xa9, 0xb5, 0xda, 0x8e, 0x62, 0x87, 0x65, 0xf0, 0x1f, 0x70, 0x42, 0x9c,
+	0x38, 0x72, 0x44, 0x9c, 0xf6, 0x67, 0xec, 0xb8, 0x23, 0xa7, 0x0d, 0xb5, 0x87, 0xf1, 0x67, 0x20,
+	0xdb, 0x49, 0x99, 0xe0, 0xd2, 0xda, 0xfe, 0xbc, 0xaf, 0xdf, 0xcb, 0xf7, 0x3d, 0x83, 0x7e, 0xca,
+	0x05, 0x3d, 0x45, 0x82, 0xc2, 0x53, 0x32, 0x9b, 0xc1, 0x02, 0x95, 0x88, 0x8a, 0xa0, 0x28, 0xb9,
+	0xe4, 0xf6, 0x66, 0xcb, 0x02, 0xc5, 0xfa, 0x3d, 0x44, 0x09, 0xe3, 0x50, 0xff, 0x9a, 0x88, 0xfe,
+	0x4e, 0xce, 0x73, 0xae, 0x97, 0x50, 0xad, 0x9a, 0x53, 0x57, 0xe9, 0xb8, 0x80, 0x09, 0x12, 0x18,
+	0xbe, 0xdb, 0x4f, 0xb0, 0x44, 0xfb, 0x30, 0xe5, 0x84, 0xb5, 0x3c, 0xe7, 0x3c, 0x9f, 0x61, 0xa8,
+	0x77, 0x49, 0xf5, 0x16, 0x66, 0x55, 0x89, 0x24, 0xe1, 0x0d, 0x7f, 0xf8, 0x79, 0x15, 0xac, 0x8d,
+	0x75, 0x21, 0x36, 0x04, 0x3b, 0x14, 0xd5, 0xb1, 0xca, 0x2f, 0xe2, 0x02, 0x97, 0xf1, 0x14, 0x93,
+	0x7c, 0x2a, 0x1d, 0xcb, 0xb3, 0xfc, 0xcd, 0xa8, 0x47, 0x51, 0x7d, 0xac, 0xd0, 0x18, 0x97, 0x47,
+	0x1a, 0xd8, 0x07, 0x60, 0x4f, 0x09, 0x52, 0x4e, 0x0b, 0xce, 0x30, 0x93, 0x46, 0xa5, 0xf4, 0xce,
+	0x7f, 0x5a, 0xa3, 0xee, 0x1b, 0x2d, 0xe9, 0x18, 0x97, 0xea, 0x02, 0x3b, 0x00, 0xdb, 0x94, 0xb0,
+	0x58, 0x96, 0x24, 0xcf, 0x55, 0x16, 0x5e, 0x92, 0xf7, 0x9c, 0x39, 0x5d, 0xcf, 0xf2, 0xbb, 0x51,
+	0x8f, 0x12, 0x36, 0x31, 0xe4, 0xc8, 0x00, 0x1d, 0x8f, 0xea, 0x7f, 0xe2, 0x57, 0x9a, 0x78, 0x54,
+	0xff, 0x15, 0xff, 0x01, 0xdc, 0x4d, 0x4b, 0xac, 0x3f, 0x32, 0xce, 0x70, 0xc1, 0x05, 0x91, 0xce,
+	0xaa, 0xd7, 0xf5, 0x37, 0x9e, 0xdc, 0x0f, 0x8c, 0x5b, 0x81, 0x72, 0x2b, 0x68, 0xdc, 0x0a, 0x46,
+	0x9c, 0xb0, 0xf0, 0xe0, 0xe2, 0x6a, 0xd0, 0xf9, 0x7e, 0x3d, 0xf0, 0x73, 0x22, 0xa7, 0x55, 0x12,
+	0xa4, 0x9c, 0xc2, 0xc6, 0x5a, 0xf3, 0xf7, 0x58, 0x64, 0x27, 0x50, 0x9e, 0x15, 0x58, 0x68, 0x81,
+	0xf8, 0x76, 0x73, 0x3e, 0xb4, 0xa2, 0xad, 0x36, 0xd3, 0xa1, 0x49, 0x64, 0x3f, 0x03, 0x7b, 0x98,
+	0xa1, 0x64, 0x86, 0xb3, 0x3f, 0xbe, 0xc4, 0x5a, 0xe6, 0xac, 0x79, 0x5d, 0x7f, 0x3d, 0xda, 0x6d,
+	0xf0, 0xd2, 0x97, 0x89, 0x82, 0xf6, 0x10, 0xf4, 0x8c, 0x97, 0x2c, 0x23, 0xba, 0xf2, 0x1c, 0x09,
+	0xe7, 0x7f, 0xcf, 0xf2, 0x57, 0xa2, 0x2d, 0xed, 0x62, 0x73, 0xfe, 0x0a, 0x89, 0xd6, 0xf7, 0x64,
+	0xc6, 0xd3, 0x93, 0x18, 0xd7, 0x38, 0xad, 0x96, 0x8a, 0x3b, 0x5a, 0xa1, 0x7c, 0x0f, 0x15, 0x7d,
+	0xd9, 0x42, 0x25, 0x53, 0xa5, 0xe9, 0x3d, 0x2f, 0xe3, 0x84, 0x57, 0x4c, 0x9e, 0xa9, 0x7e, 0xa5,
+	0x98, 0x49, 0x67, 0x5d, 0xb7, 0x6b, 0xb7, 0xc5, 0xa1, 0xa6, 0x63, 0x03, 0xed, 0x08, 0x6c, 0x90,
+	0x24, 0x8d, 0x25, 0xa1, 0x98, 0x57, 0xd2, 0x01, 0x9e, 0xa5, 0xad, 0x34, 0x83, 0x15, 0xb4, 0x83,
+	0x15, 0x1c, 0x36, 0x83, 0x15, 0xde, 0x53, 0x56, 0xce, 0xaf, 0x06, 0xe0, 0x75, 0x38, 0x9a, 0x18,
+	0xd1, 0x97, 0xeb, 0x81, 0x15, 0x01, 0x92, 0xa4, 0xcd, 0xfe, 0xf9, 0x83, 0x5f, 0x5f, 0x07, 0xd6,
+	0xc7, 0x9b, 0xf3, 0xe1, 0xb6, 0x9a, 0xf9, 0x0c, 0xd6, 0xe6, 0x49, 0x98, 0x49, 0x0c, 0x5f, 0x5c,
+	0xcc, 0x5d, 0xeb, 0x72, 0xee, 0x5a, 0x3f, 0xe7, 0xae, 0xf5, 0x69, 0xe1, 0x76, 0x2e, 0x17, 0x6e,
+	0xe7, 0xc7, 0xc2, 0xed, 0xbc, 0x79, 0x74, 0xab, 0x3d, 0x23, 0x2e, 0xe8, 0xb1, 0x7e, 0x4d, 0xb7,
+	0xaf, 0xd0, 0x5e, 0x27, 0x6b, 0xba, 0xac, 0xa7, 0xbf, 0x07, 0x00, 0x46, 0x8f, 0xec, 0xca, 0x73,
+	0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -110,6 +241,46 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxWillsPerHeight != that1.MaxWillsPerHeight {
+		return false
+	}
+	if this.MaxComponentsPerWill != that1.MaxComponentsPerWill {
+		return false
+	}
+	if this.MinTriggerHorizon != that1.MinTriggerHorizon {
+		return false
+	}
+	if this.MaxTriggerHorizon != that1.MaxTriggerHorizon {
+		return false
+	}
+	if len(this.CreationDeposit) != len(that1.CreationDeposit) {
+		return false
+	}
+	for i := range this.CreationDeposit {
+		if !this.CreationDeposit[i].Equal(&that1.CreationDeposit[i]) {
+			return false
+		}
+	}
+	if len(this.EnabledComponentTypes) != len(that1.EnabledComponentTypes) {
+		return false
+	}
+	for i := range this.EnabledComponentTypes {
+		if this.EnabledComponentTypes[i] != that1.EnabledComponentTypes[i] {
+			return false
+		}
+	}
+	if this.MaxConditionGas != that1.MaxConditionGas {
+		return false
+	}
+	if this.MaxBlockExecutionGas != that1.MaxBlockExecutionGas {
+		return false
+	}
+	if this.ExecutorBountyPercent != that1.ExecutorBountyPercent {
+		return false
+	}
+	if this.IBCTimeout != that1.IBCTimeout {
+		return false
+	}
 	return true
 }
 
@@ -133,6 +304,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IBCTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IBCTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.ExecutorBountyPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecutorBountyPercent))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxBlockExecutionGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockExecutionGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxConditionGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConditionGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EnabledComponentTypes) > 0 {
+		for iNdEx := len(m.EnabledComponentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnabledComponentTypes[iNdEx])
+			copy(dAtA[i:], m.EnabledComponentTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.EnabledComponentTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CreationDeposit) > 0 {
+		for iNdEx := len(m.CreationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxTriggerHorizon != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTriggerHorizon))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinTriggerHorizon != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTriggerHorizon))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxComponentsPerWill != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxComponentsPerWill))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxWillsPerHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWillsPerHeight))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -154,6 +391,41 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxWillsPerHeight != 0 {
+		n += 1 + sovParams(uint64(m.MaxWillsPerHeight))
+	}
+	if m.MaxComponentsPerWill != 0 {
+		n += 1 + sovParams(uint64(m.MaxComponentsPerWill))
+	}
+	if m.MinTriggerHorizon != 0 {
+		n += 1 + sovParams(uint64(m.MinTriggerHorizon))
+	}
+	if m.MaxTriggerHorizon != 0 {
+		n += 1 + sovParams(uint64(m.MaxTriggerHorizon))
+	}
+	if len(m.CreationDeposit) > 0 {
+		for _, e := range m.CreationDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.EnabledComponentTypes) > 0 {
+		for _, s := range m.EnabledComponentTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxConditionGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxConditionGas))
+	}
+	if m.MaxBlockExecutionGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockExecutionGas))
+	}
+	if m.ExecutorBountyPercent != 0 {
+		n += 1 + sovParams(uint64(m.ExecutorBountyPercent))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IBCTimeout)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -194,6 +466,238 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWillsPerHeight", wireType)
+			}
+			m.MaxWillsPerHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWillsPerHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxComponentsPerWill", wireType)
+			}
+			m.MaxComponentsPerWill = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxComponentsPerWill |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTriggerHorizon", wireType)
+			}
+			m.MinTriggerHorizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTriggerHorizon |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTriggerHorizon", wireType)
+			}
+			m.MaxTriggerHorizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTriggerHorizon |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationDeposit = append(m.CreationDeposit, types.Coin{})
+			if err := m.CreationDeposit[len(m.CreationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledComponentTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledComponentTypes = append(m.EnabledComponentTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConditionGas", wireType)
+			}
+			m.MaxConditionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConditionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockExecutionGas", wireType)
+			}
+			m.MaxBlockExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorBountyPercent", wireType)
+			}
+			m.ExecutorBountyPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorBountyPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IBCTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
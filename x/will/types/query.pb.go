@@ -11,6 +11,8 @@ import (
 	math_bits "math/bits"
 
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -256,411 +258,4270 @@ func (m *QueryListWillsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetWillRequest)(nil), "cosmwasm.will.QueryGetWillRequest")
-	proto.RegisterType((*QueryGetWillResponse)(nil), "cosmwasm.will.QueryGetWillResponse")
-	proto.RegisterType((*QueryListWillsRequest)(nil), "cosmwasm.will.QueryListWillsRequest")
-	proto.RegisterType((*QueryListWillsResponse)(nil), "cosmwasm.will.QueryListWillsResponse")
+// QueryWillEscrowRequest is the request type for the escrow of a will.
+type QueryWillEscrowRequest struct {
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/will/query.proto", fileDescriptor_0210316a1a2cb467) }
-
-var fileDescriptor_0210316a1a2cb467 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x44, 0xdb, 0x90, 0x11, 0x0f, 0x4e, 0xa3, 0xc6, 0x20, 0x6b, 0xd9, 0xda, 0x54, 0x2a,
-	0xce, 0xd0, 0xe8, 0x5d, 0x8d, 0x60, 0x11, 0x3c, 0xe8, 0x7a, 0x28, 0x78, 0x29, 0x93, 0xee, 0xb0,
-	0x0e, 0xec, 0xee, 0x6c, 0xf3, 0x9b, 0x58, 0x6b, 0xe9, 0x45, 0xf0, 0xe4, 0x45, 0xe8, 0xc1, 0xaf,
-	0xe0, 0xd1, 0x83, 0x1f, 0xa2, 0xc7, 0xa2, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xd7, 0x90, 0xf9, 0x13,
-	0x93, 0x86, 0xb5, 0xb9, 0x84, 0x99, 0xbc, 0x37, 0xef, 0xbd, 0xdf, 0x9f, 0xc5, 0xd7, 0x76, 0x14,
-	0x64, 0x7b, 0x1c, 0x32, 0xb6, 0x27, 0xd3, 0x94, 0xed, 0x0e, 0x44, 0x7f, 0x9f, 0x16, 0x7d, 0xa5,
-	0x15, 0xb9, 0x38, 0x86, 0xa8, 0x81, 0x5a, 0xd7, 0x13, 0xa5, 0x92, 0x54, 0x30, 0x5e, 0x48, 0xc6,
-	0xf3, 0x5c, 0x69, 0xae, 0xa5, 0xca, 0xc1, 0x91, 0x5b, 0x33, 0x3a, 0x7a, 0xbf, 0x10, 0x63, 0x68,
-	0xdd, 0x40, 0x0a, 0x58, 0x8f, 0x83, 0x70, 0x06, 0xec, 0xf5, 0x46, 0x4f, 0x68, 0xbe, 0xc1, 0x0a,
-	0x9e, 0xc8, 0xdc, 0xea, 0x78, 0x6e, 0x23, 0x51, 0x89, 0xb2, 0x47, 0x66, 0x4e, 0xd3, 0xe2, 0x0a,
-	0xb6, 0x1d, 0xe0, 0x2e, 0x1e, 0xba, 0xc4, 0x33, 0x99, 0x2b, 0x66, 0x7f, 0xdd, 0x5f, 0x21, 0xc5,
-	0x4b, 0xcf, 0x8d, 0xcb, 0xa6, 0xd0, 0x5b, 0x32, 0x4d, 0x23, 0xb1, 0x3b, 0x10, 0xa0, 0xc9, 0x55,
-	0x5c, 0x33, 0xd1, 0xb6, 0x65, 0xdc, 0x44, 0xcb, 0xe8, 0x56, 0x3d, 0x5a, 0x34, 0xd7, 0x27, 0x71,
-	0x78, 0x1f, 0x37, 0x4e, 0xf3, 0xa1, 0x50, 0x39, 0x08, 0xb2, 0x86, 0xcf, 0x1b, 0x86, 0x65, 0x5f,
-	0xe8, 0x2c, 0xd1, 0x53, 0xed, 0xa0, 0x96, 0x6a, 0x09, 0xe1, 0x11, 0xc2, 0x97, 0xad, 0xc2, 0x53,
-	0x09, 0x56, 0x02, 0xc6, 0x9e, 0x1d, 0x5c, 0xe3, 0x71, 0xdc, 0x17, 0x00, 0xce, 0xb3, 0xdb, 0xfc,
-	0xf6, 0xf5, 0x4e, 0xc3, 0x17, 0xf0, 0xd0, 0x21, 0x2f, 0x74, 0x5f, 0xe6, 0x49, 0x34, 0x26, 0x92,
-	0xc7, 0x18, 0x4f, 0xda, 0xd2, 0xac, 0x5a, 0xf3, 0x36, 0xf5, 0x6f, 0x4c, 0x0f, 0xa9, 0x1b, 0x92,
-	0xef, 0x21, 0x7d, 0xc6, 0x13, 0xe1, 0xfd, 0xa2, 0xa9, 0x97, 0xe1, 0x27, 0x84, 0xaf, 0xcc, 0xa6,
-	0xf2, 0x95, 0xdd, 0xc3, 0x0b, 0x26, 0xb8, 0x09, 0x75, 0xee, 0x3f, 0xa5, 0x75, 0xeb, 0xc7, 0x3f,
-	0x6f, 0x54, 0x3e, 0xff, 0xf9, 0xb2, 0x8e, 0x22, 0x47, 0x26, 0x9b, 0x25, 0xc1, 0xd6, 0xe6, 0x06,
-	0x73, 0x96, 0xd3, 0xc9, 0x3a, 0x1f, 0xaa, 0x78, 0xc1, 0x26, 0x23, 0x6f, 0x71, 0xcd, 0x77, 0x9d,
-	0x84, 0x33, 0x21, 0x4a, 0x46, 0xd8, 0x5a, 0x39, 0x93, 0xe3, 0x9c, 0xc2, 0xf6, 0xbb, 0xef, 0xbf,
-	0x8f, 0xaa, 0xcb, 0x24, 0x60, 0x93, 0x95, 0xe4, 0x90, 0xc5, 0x6e, 0x31, 0x0f, 0xfc, 0x0e, 0x1c,
-	0x92, 0xf7, 0x08, 0xd7, 0xff, 0xb5, 0x86, 0xdc, 0x2c, 0x93, 0x9e, 0x9d, 0x67, 0x6b, 0x75, 0x0e,
-	0xcb, 0x47, 0xb8, 0x6d, 0x23, 0xac, 0x92, 0x95, 0xd2, 0x08, 0xa9, 0x04, 0xcd, 0x0e, 0xfc, 0xb8,
-	0x0f, 0xbb, 0x0f, 0x8e, 0x87, 0x01, 0x3a, 0x19, 0x06, 0xe8, 0xd7, 0x30, 0x40, 0x1f, 0x47, 0x41,
-	0xe5, 0x64, 0x14, 0x54, 0x7e, 0x8c, 0x82, 0xca, 0xcb, 0x76, 0x22, 0xf5, 0xab, 0x41, 0x8f, 0xee,
-	0xa8, 0x8c, 0x3d, 0x52, 0x90, 0x6d, 0x4d, 0x84, 0xde, 0x4c, 0x7d, 0x66, 0xbd, 0x45, 0xbb, 0xf7,
-	0x77, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xca, 0xf0, 0x10, 0x8d, 0xcc, 0x03, 0x00, 0x00,
+func (m *QueryWillEscrowRequest) Reset()         { *m = QueryWillEscrowRequest{} }
+func (m *QueryWillEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWillEscrowRequest) ProtoMessage()    {}
+func (*QueryWillEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{4}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ context.Context
-	_ grpc.ClientConn
-)
+func (m *QueryWillEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryWillEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// GetWill retrieves a will by its ID.
-	GetWill(ctx context.Context, in *QueryGetWillRequest, opts ...grpc.CallOption) (*QueryGetWillResponse, error)
-	// GetWill retrieves all wills by an account address
-	ListWills(ctx context.Context, in *QueryListWillsRequest, opts ...grpc.CallOption) (*QueryListWillsResponse, error)
+func (m *QueryWillEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillEscrowRequest.Merge(m, src)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryWillEscrowRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWillEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillEscrowRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWill(ctx context.Context, in *QueryGetWillRequest, opts ...grpc.CallOption) (*QueryGetWillResponse, error) {
-	out := new(QueryGetWillResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/GetWill", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWillEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryWillEscrowRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListWills(ctx context.Context, in *QueryListWillsRequest, opts ...grpc.CallOption) (*QueryListWillsResponse, error) {
-	out := new(QueryListWillsResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/ListWills", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryWillEscrowResponse is the response type for the escrow of a will.
+type QueryWillEscrowResponse struct {
+	// escrow held for the will
+	Escrow WillEscrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// GetWill retrieves a will by its ID.
-	GetWill(context.Context, *QueryGetWillRequest) (*QueryGetWillResponse, error)
-	// GetWill retrieves all wills by an account address
-	ListWills(context.Context, *QueryListWillsRequest) (*QueryListWillsResponse, error)
+func (m *QueryWillEscrowResponse) Reset()         { *m = QueryWillEscrowResponse{} }
+func (m *QueryWillEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWillEscrowResponse) ProtoMessage()    {}
+func (*QueryWillEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{5}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
+func (m *QueryWillEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-func (*UnimplementedQueryServer) GetWill(ctx context.Context, req *QueryGetWillRequest) (*QueryGetWillResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWill not implemented")
+func (m *QueryWillEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func (*UnimplementedQueryServer) ListWills(ctx context.Context, req *QueryListWillsRequest) (*QueryListWillsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWills not implemented")
+func (m *QueryWillEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillEscrowResponse.Merge(m, src)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryWillEscrowResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func _Query_GetWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetWillRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWill(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Query/GetWill",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWill(ctx, req.(*QueryGetWillRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryWillEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillEscrowResponse.DiscardUnknown(m)
 }
 
-func _Query_ListWills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListWillsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListWills(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Query/ListWills",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListWills(ctx, req.(*QueryListWillsRequest))
+var xxx_messageInfo_QueryWillEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryWillEscrowResponse) GetEscrow() WillEscrow {
+	if m != nil {
+		return m.Escrow
 	}
-	return interceptor(ctx, in, info, handler)
+	return WillEscrow{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmwasm.will.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetWill",
-			Handler:    _Query_GetWill_Handler,
-		},
-		{
-			MethodName: "ListWills",
-			Handler:    _Query_ListWills_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmwasm/will/query.proto",
-}
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct{}
 
-func (m *QueryGetWillRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{6}
 }
 
-func (m *QueryGetWillRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryGetWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WillId) > 0 {
-		i -= len(m.WillId)
-		copy(dAtA[i:], m.WillId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetWillResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
 
-func (m *QueryGetWillResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryGetWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Will != nil {
-		{
-			size, err := m.Will.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListWillsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryListWillsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{7}
 }
 
-func (m *QueryListWillsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryListWillsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
 
-func (m *QueryListWillsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryListWillsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryWillsByBeneficiaryRequest is the request type for the
+// Query/WillsByBeneficiary RPC method.
+type QueryWillsByBeneficiaryRequest struct {
+	// address is the beneficiary address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWillsByBeneficiaryRequest) Reset()         { *m = QueryWillsByBeneficiaryRequest{} }
+func (m *QueryWillsByBeneficiaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWillsByBeneficiaryRequest) ProtoMessage()    {}
+func (*QueryWillsByBeneficiaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{8}
+}
+
+func (m *QueryWillsByBeneficiaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWillsByBeneficiaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillsByBeneficiaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Wills) > 0 {
-		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+
+func (m *QueryWillsByBeneficiaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillsByBeneficiaryRequest.Merge(m, src)
+}
+
+func (m *QueryWillsByBeneficiaryRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWillsByBeneficiaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillsByBeneficiaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWillsByBeneficiaryRequest proto.InternalMessageInfo
+
+func (m *QueryWillsByBeneficiaryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryWillsByBeneficiaryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWillsByBeneficiaryResponse is the response type for the
+// Query/WillsByBeneficiary RPC method.
+type QueryWillsByBeneficiaryResponse struct {
+	// wills naming the address as beneficiary
+	Wills []Will `protobuf:"bytes,1,rep,name=wills,proto3" json:"wills"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWillsByBeneficiaryResponse) Reset()         { *m = QueryWillsByBeneficiaryResponse{} }
+func (m *QueryWillsByBeneficiaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWillsByBeneficiaryResponse) ProtoMessage()    {}
+func (*QueryWillsByBeneficiaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{9}
+}
+
+func (m *QueryWillsByBeneficiaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWillsByBeneficiaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillsByBeneficiaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryWillsByBeneficiaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillsByBeneficiaryResponse.Merge(m, src)
+}
+
+func (m *QueryWillsByBeneficiaryResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWillsByBeneficiaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillsByBeneficiaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWillsByBeneficiaryResponse proto.InternalMessageInfo
+
+func (m *QueryWillsByBeneficiaryResponse) GetWills() []Will {
+	if m != nil {
+		return m.Wills
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
 
-func (m *QueryGetWillRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWillsByBeneficiaryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.WillId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryClaimableComponentsRequest is the request type for the
+// Query/ClaimableComponents RPC method.
+type QueryClaimableComponentsRequest struct {
+	// address is the claimer address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request. The pages run
+	// over the wills naming the address, then over the wills with a public
+	// claim component.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimableComponentsRequest) Reset()         { *m = QueryClaimableComponentsRequest{} }
+func (m *QueryClaimableComponentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableComponentsRequest) ProtoMessage()    {}
+func (*QueryClaimableComponentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{10}
+}
+
+func (m *QueryClaimableComponentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryClaimableComponentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableComponentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *QueryGetWillResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimableComponentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableComponentsRequest.Merge(m, src)
+}
+
+func (m *QueryClaimableComponentsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryClaimableComponentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableComponentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableComponentsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableComponentsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	if m.Will != nil {
-		l = m.Will.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryClaimableComponentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryListWillsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ClaimableComponent points at an active claim component of a will
+type ClaimableComponent struct {
+	// will_id is the id of the will holding the component
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	// component_id is the id of the claim component
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// scheme is the claim scheme the claim must be proven with
+	Scheme string `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// public is true when anyone may claim the component
+	Public bool `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+}
+
+func (m *ClaimableComponent) Reset()         { *m = ClaimableComponent{} }
+func (m *ClaimableComponent) String() string { return proto.CompactTextString(m) }
+func (*ClaimableComponent) ProtoMessage()    {}
+func (*ClaimableComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{11}
+}
+
+func (m *ClaimableComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ClaimableComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+
+func (m *ClaimableComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableComponent.Merge(m, src)
+}
+
+func (m *ClaimableComponent) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ClaimableComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableComponent proto.InternalMessageInfo
+
+func (m *ClaimableComponent) GetWillId() string {
+	if m != nil {
+		return m.WillId
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ClaimableComponent) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryListWillsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimableComponent) GetScheme() string {
+	if m != nil {
+		return m.Scheme
 	}
-	var l int
-	_ = l
-	if len(m.Wills) > 0 {
-		for _, e := range m.Wills {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ClaimableComponent) GetPublic() bool {
+	if m != nil {
+		return m.Public
+	}
+	return false
+}
+
+// QueryClaimableComponentsResponse is the response type for the
+// Query/ClaimableComponents RPC method.
+type QueryClaimableComponentsResponse struct {
+	// components the address may claim
+	Components []ClaimableComponent `protobuf:"bytes,1,rep,name=components,proto3" json:"components"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClaimableComponentsResponse) Reset()         { *m = QueryClaimableComponentsResponse{} }
+func (m *QueryClaimableComponentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableComponentsResponse) ProtoMessage()    {}
+func (*QueryClaimableComponentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{12}
+}
+
+func (m *QueryClaimableComponentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryClaimableComponentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableComponentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryClaimableComponentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableComponentsResponse.Merge(m, src)
+}
+
+func (m *QueryClaimableComponentsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryClaimableComponentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableComponentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableComponentsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableComponentsResponse) GetComponents() []ClaimableComponent {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func (m *QueryClaimableComponentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllWillsRequest is the request type for the Query/AllWills RPC method.
+type QueryAllWillsRequest struct {
+	// status only returns the wills with this status when set
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// min_height only returns the wills that trigger at or after this height
+	// when set
+	MinHeight int64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height only returns the wills that trigger at or before this height
+	// when set
+	MaxHeight int64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWillsRequest) Reset()         { *m = QueryAllWillsRequest{} }
+func (m *QueryAllWillsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWillsRequest) ProtoMessage()    {}
+func (*QueryAllWillsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{13}
+}
+
+func (m *QueryAllWillsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryAllWillsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWillsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryAllWillsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWillsRequest.Merge(m, src)
+}
+
+func (m *QueryAllWillsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryAllWillsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWillsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWillsRequest proto.InternalMessageInfo
+
+func (m *QueryAllWillsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryAllWillsRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryAllWillsRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryAllWillsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllWillsResponse is the response type for the Query/AllWills RPC
+// method.
+type QueryAllWillsResponse struct {
+	// wills matching the filters
+	Wills []Will `protobuf:"bytes,1,rep,name=wills,proto3" json:"wills"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllWillsResponse) Reset()         { *m = QueryAllWillsResponse{} }
+func (m *QueryAllWillsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllWillsResponse) ProtoMessage()    {}
+func (*QueryAllWillsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{14}
+}
+
+func (m *QueryAllWillsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryAllWillsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllWillsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryAllWillsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllWillsResponse.Merge(m, src)
+}
+
+func (m *QueryAllWillsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryAllWillsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllWillsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllWillsResponse proto.InternalMessageInfo
+
+func (m *QueryAllWillsResponse) GetWills() []Will {
+	if m != nil {
+		return m.Wills
+	}
+	return nil
+}
+
+func (m *QueryAllWillsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWillExecutionReceiptsRequest is the request type for the
+// Query/WillExecutionReceipts RPC method.
+type QueryWillExecutionReceiptsRequest struct {
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWillExecutionReceiptsRequest) Reset()         { *m = QueryWillExecutionReceiptsRequest{} }
+func (m *QueryWillExecutionReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWillExecutionReceiptsRequest) ProtoMessage()    {}
+func (*QueryWillExecutionReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{15}
+}
+
+func (m *QueryWillExecutionReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWillExecutionReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillExecutionReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryWillExecutionReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillExecutionReceiptsRequest.Merge(m, src)
+}
+
+func (m *QueryWillExecutionReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWillExecutionReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillExecutionReceiptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWillExecutionReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryWillExecutionReceiptsRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+func (m *QueryWillExecutionReceiptsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWillExecutionReceiptsResponse is the response type for the
+// Query/WillExecutionReceipts RPC method.
+type QueryWillExecutionReceiptsResponse struct {
+	// receipts of the components, in component order
+	Receipts []ExecutionReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWillExecutionReceiptsResponse) Reset()         { *m = QueryWillExecutionReceiptsResponse{} }
+func (m *QueryWillExecutionReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWillExecutionReceiptsResponse) ProtoMessage()    {}
+func (*QueryWillExecutionReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{16}
+}
+
+func (m *QueryWillExecutionReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWillExecutionReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWillExecutionReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryWillExecutionReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWillExecutionReceiptsResponse.Merge(m, src)
+}
+
+func (m *QueryWillExecutionReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWillExecutionReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWillExecutionReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWillExecutionReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryWillExecutionReceiptsResponse) GetReceipts() []ExecutionReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *QueryWillExecutionReceiptsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingStatusRequest is the request type for the Query/VestingStatus
+// RPC method.
+type QueryVestingStatusRequest struct {
+	WillId      string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+}
+
+func (m *QueryVestingStatusRequest) Reset()         { *m = QueryVestingStatusRequest{} }
+func (m *QueryVestingStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingStatusRequest) ProtoMessage()    {}
+func (*QueryVestingStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{17}
+}
+
+func (m *QueryVestingStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryVestingStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryVestingStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingStatusRequest.Merge(m, src)
+}
+
+func (m *QueryVestingStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryVestingStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingStatusRequest proto.InternalMessageInfo
+
+func (m *QueryVestingStatusRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
+	}
+	return ""
+}
+
+func (m *QueryVestingStatusRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
+	}
+	return ""
+}
+
+// QueryVestingStatusResponse is the response type for the Query/VestingStatus
+// RPC method.
+type QueryVestingStatusResponse struct {
+	// coins vested so far, including the ones withdrawn
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// coins withdrawn so far
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+	// coins vested but not withdrawn yet
+	Withdrawable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawable"`
+	// coins still to vest
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// block height the schedule started at, zero before the will fires
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// block height everything vests at, zero before the will fires
+	EndHeight int64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryVestingStatusResponse) Reset()         { *m = QueryVestingStatusResponse{} }
+func (m *QueryVestingStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingStatusResponse) ProtoMessage()    {}
+func (*QueryVestingStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0210316a1a2cb467, []int{18}
+}
+
+func (m *QueryVestingStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryVestingStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryVestingStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingStatusResponse.Merge(m, src)
+}
+
+func (m *QueryVestingStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryVestingStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingStatusResponse proto.InternalMessageInfo
+
+func (m *QueryVestingStatusResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryVestingStatusResponse) GetWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return nil
+}
+
+func (m *QueryVestingStatusResponse) GetWithdrawable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Withdrawable
+	}
+	return nil
+}
+
+func (m *QueryVestingStatusResponse) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *QueryVestingStatusResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryVestingStatusResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryGetWillRequest)(nil), "cosmwasm.will.QueryGetWillRequest")
+	proto.RegisterType((*QueryGetWillResponse)(nil), "cosmwasm.will.QueryGetWillResponse")
+	proto.RegisterType((*QueryListWillsRequest)(nil), "cosmwasm.will.QueryListWillsRequest")
+	proto.RegisterType((*QueryListWillsResponse)(nil), "cosmwasm.will.QueryListWillsResponse")
+	proto.RegisterType((*QueryWillEscrowRequest)(nil), "cosmwasm.will.QueryWillEscrowRequest")
+	proto.RegisterType((*QueryWillEscrowResponse)(nil), "cosmwasm.will.QueryWillEscrowResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmwasm.will.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmwasm.will.QueryParamsResponse")
+	proto.RegisterType((*QueryWillsByBeneficiaryRequest)(nil), "cosmwasm.will.QueryWillsByBeneficiaryRequest")
+	proto.RegisterType((*QueryWillsByBeneficiaryResponse)(nil), "cosmwasm.will.QueryWillsByBeneficiaryResponse")
+	proto.RegisterType((*QueryClaimableComponentsRequest)(nil), "cosmwasm.will.QueryClaimableComponentsRequest")
+	proto.RegisterType((*ClaimableComponent)(nil), "cosmwasm.will.ClaimableComponent")
+	proto.RegisterType((*QueryClaimableComponentsResponse)(nil), "cosmwasm.will.QueryClaimableComponentsResponse")
+	proto.RegisterType((*QueryAllWillsRequest)(nil), "cosmwasm.will.QueryAllWillsRequest")
+	proto.RegisterType((*QueryAllWillsResponse)(nil), "cosmwasm.will.QueryAllWillsResponse")
+	proto.RegisterType((*QueryWillExecutionReceiptsRequest)(nil), "cosmwasm.will.QueryWillExecutionReceiptsRequest")
+	proto.RegisterType((*QueryWillExecutionReceiptsResponse)(nil), "cosmwasm.will.QueryWillExecutionReceiptsResponse")
+	proto.RegisterType((*QueryVestingStatusRequest)(nil), "cosmwasm.will.QueryVestingStatusRequest")
+	proto.RegisterType((*QueryVestingStatusResponse)(nil), "cosmwasm.will.QueryVestingStatusResponse")
+}
+
+func init() { proto.RegisterFile("cosmwasm/will/query.proto", fileDescriptor_0210316a1a2cb467) }
+
+var fileDescriptor_0210316a1a2cb467 = []byte{
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x34, 0xa9, 0x93, 0xbc, 0xb4, 0x07, 0x26, 0x49, 0xe3, 0xac, 0xa8, 0x93, 0x6c, 0x9b,
+	0x8f, 0x06, 0xe2, 0x6d, 0x02, 0x15, 0x1c, 0x40, 0x50, 0x47, 0xb4, 0x54, 0xaa, 0x44, 0x71, 0x25,
+	0x22, 0x71, 0x89, 0xc6, 0xbb, 0xc3, 0x7a, 0xc4, 0x7e, 0xb8, 0x3b, 0xeb, 0x7c, 0x10, 0x45, 0x42,
+	0x08, 0x2e, 0x9c, 0x90, 0x2a, 0xc1, 0x05, 0x10, 0x12, 0x07, 0x0a, 0x12, 0x12, 0x87, 0x72, 0xe0,
+	0x3f, 0xe8, 0xb1, 0x82, 0x0b, 0x27, 0x40, 0x09, 0x12, 0xff, 0x06, 0x9a, 0x8f, 0xb5, 0x77, 0x9d,
+	0xb5, 0x1d, 0x50, 0x22, 0xe5, 0x92, 0x78, 0xe6, 0xfd, 0xde, 0xfc, 0x7e, 0xf3, 0xe6, 0xcd, 0x9b,
+	0x67, 0xc3, 0xb4, 0x1d, 0x72, 0x7f, 0x9b, 0x70, 0xdf, 0xda, 0x66, 0x9e, 0x67, 0x3d, 0x68, 0xd2,
+	0x68, 0xb7, 0xdc, 0x88, 0xc2, 0x38, 0xc4, 0x17, 0x13, 0x53, 0x59, 0x98, 0x8c, 0x67, 0xdd, 0x30,
+	0x74, 0x3d, 0x6a, 0x91, 0x06, 0xb3, 0x48, 0x10, 0x84, 0x31, 0x89, 0x59, 0x18, 0x70, 0x05, 0x36,
+	0x3a, 0xd6, 0x89, 0x77, 0x1b, 0x34, 0x31, 0x19, 0x59, 0x53, 0x83, 0x44, 0xc4, 0x4f, 0x6c, 0xcb,
+	0xc2, 0x16, 0x72, 0xab, 0x46, 0x38, 0x55, 0xe4, 0xd6, 0xd6, 0x6a, 0x8d, 0xc6, 0x64, 0xd5, 0x6a,
+	0x10, 0x97, 0x05, 0x92, 0x43, 0x63, 0x4b, 0x69, 0x6c, 0x82, 0xb2, 0x43, 0x96, 0xd8, 0x27, 0xdc,
+	0xd0, 0x0d, 0xe5, 0x47, 0x4b, 0x7c, 0x4a, 0x0b, 0x0b, 0xf9, 0xa6, 0x32, 0xa8, 0x81, 0x36, 0x3d,
+	0x43, 0x7c, 0x16, 0x84, 0x96, 0xfc, 0xab, 0xa6, 0xcc, 0x32, 0x8c, 0xbf, 0x2d, 0x54, 0xdc, 0xa6,
+	0xf1, 0x06, 0xf3, 0xbc, 0x2a, 0x7d, 0xd0, 0xa4, 0x3c, 0xc6, 0x53, 0x30, 0x2c, 0xb4, 0x6f, 0x32,
+	0xa7, 0x88, 0x66, 0xd1, 0xd2, 0x68, 0xb5, 0x20, 0x86, 0x77, 0x1c, 0xf3, 0x35, 0x98, 0xc8, 0xe2,
+	0x79, 0x23, 0x0c, 0x38, 0xc5, 0x8b, 0x30, 0x24, 0x10, 0x12, 0x3d, 0xb6, 0x36, 0x5e, 0xce, 0x84,
+	0xb2, 0x2c, 0xa1, 0x12, 0x60, 0x3e, 0x44, 0x30, 0x29, 0x57, 0xb8, 0xcb, 0xb8, 0x5c, 0x82, 0x27,
+	0x9c, 0x6b, 0x30, 0x4c, 0x1c, 0x27, 0xa2, 0x9c, 0x2b, 0xce, 0x4a, 0xf1, 0xd7, 0xc7, 0x2b, 0x13,
+	0x7a, 0x03, 0x37, 0x95, 0xe5, 0x7e, 0x1c, 0xb1, 0xc0, 0xad, 0x26, 0x40, 0x7c, 0x0b, 0xa0, 0x1d,
+	0xb6, 0xe2, 0x39, 0x49, 0xbe, 0x50, 0xd6, 0x3e, 0x22, 0x6e, 0x65, 0x75, 0xc0, 0x3a, 0x7a, 0xe5,
+	0x7b, 0xc4, 0xa5, 0x9a, 0xaf, 0x9a, 0xf2, 0x34, 0xbf, 0x40, 0x70, 0xa9, 0x53, 0x95, 0xde, 0xd9,
+	0x8b, 0x70, 0x5e, 0x08, 0x17, 0xa2, 0x06, 0xbb, 0x6c, 0xad, 0x32, 0xfa, 0xe4, 0x8f, 0x99, 0x81,
+	0x47, 0xff, 0xfc, 0xb4, 0x8c, 0xaa, 0x0a, 0x8c, 0x6f, 0xe7, 0x08, 0x5b, 0xec, 0x2b, 0x4c, 0x51,
+	0x66, 0x94, 0xad, 0x6a, 0x61, 0x82, 0xe7, 0x0d, 0x6e, 0x47, 0xe1, 0x76, 0xdf, 0x33, 0xda, 0x80,
+	0xa9, 0x23, 0x2e, 0x7a, 0x33, 0xaf, 0x40, 0x81, 0xca, 0x19, 0x7d, 0x50, 0xd3, 0x39, 0xbb, 0x51,
+	0x2e, 0xe9, 0x3d, 0x69, 0x1f, 0x73, 0x02, 0xb0, 0x5c, 0xf8, 0x9e, 0xcc, 0x68, 0xad, 0xc3, 0x7c,
+	0x0b, 0xc6, 0x33, 0xb3, 0x9a, 0xea, 0x65, 0x28, 0xa8, 0xcc, 0xd7, 0x54, 0x93, 0x1d, 0x54, 0x0a,
+	0x9e, 0xa1, 0x51, 0x78, 0xf3, 0x4b, 0x04, 0xa5, 0xd6, 0x06, 0x78, 0x65, 0xb7, 0x42, 0x03, 0xfa,
+	0x1e, 0xb3, 0x19, 0x89, 0x76, 0xcf, 0x42, 0xae, 0x7c, 0x83, 0x60, 0xa6, 0xab, 0xbc, 0xb3, 0x91,
+	0x34, 0x5f, 0x25, 0x12, 0xd7, 0x3d, 0xc2, 0x7c, 0x52, 0xf3, 0xe8, 0x7a, 0xe8, 0x37, 0xc2, 0x80,
+	0x06, 0xf1, 0x99, 0xb8, 0x6e, 0x1f, 0x22, 0xc0, 0x47, 0xa5, 0x75, 0xcd, 0x68, 0x3c, 0x07, 0x17,
+	0xec, 0x04, 0x25, 0xac, 0xe7, 0xa4, 0x75, 0xac, 0x35, 0x77, 0xc7, 0xc1, 0x97, 0xa0, 0xc0, 0xed,
+	0x3a, 0xf5, 0x69, 0x71, 0x50, 0xb9, 0xaa, 0x91, 0x98, 0x6f, 0x34, 0x6b, 0x1e, 0xb3, 0x8b, 0x43,
+	0xb3, 0x68, 0x69, 0xa4, 0xaa, 0x47, 0xe6, 0x2f, 0x08, 0x66, 0xbb, 0x87, 0x48, 0x1f, 0xe3, 0x5d,
+	0x80, 0x16, 0x47, 0x72, 0x96, 0x73, 0x1d, 0x67, 0x79, 0xd4, 0x3f, 0x7d, 0xb2, 0x29, 0xff, 0x93,
+	0x3b, 0xde, 0xc7, 0x48, 0x57, 0xe1, 0x9b, 0x9e, 0x97, 0x29, 0xa1, 0x22, 0x08, 0x31, 0x89, 0x9b,
+	0x3c, 0x89, 0x9f, 0x1a, 0xe1, 0xcb, 0x00, 0x3e, 0x0b, 0x36, 0xeb, 0x94, 0xb9, 0xf5, 0x58, 0x32,
+	0x0f, 0x56, 0x47, 0x7d, 0x16, 0xbc, 0x29, 0x27, 0xa4, 0x99, 0xec, 0x24, 0xe6, 0x41, 0x6d, 0x26,
+	0x3b, 0xda, 0x9c, 0x3d, 0xf5, 0xa1, 0xff, 0x7d, 0xea, 0x9f, 0x27, 0xa5, 0xbf, 0x2d, 0xfb, 0x6c,
+	0x5c, 0x97, 0x8f, 0x11, 0xcc, 0xb5, 0x2b, 0xe6, 0x0e, 0xb5, 0x9b, 0x62, 0xba, 0x4a, 0x6d, 0xca,
+	0x1a, 0xed, 0x0b, 0xd3, 0x35, 0x3b, 0x4f, 0xea, 0x56, 0xfc, 0x8c, 0xc0, 0xec, 0x25, 0x43, 0x07,
+	0xeb, 0x16, 0x8c, 0x44, 0x7a, 0x4e, 0xc7, 0x6b, 0xa6, 0x23, 0x5e, 0x9d, 0xbe, 0xe9, 0xd8, 0xb5,
+	0x7c, 0x4f, 0x2e, 0x7c, 0x1b, 0x30, 0x2d, 0x65, 0xbf, 0x43, 0x79, 0xcc, 0x02, 0xf7, 0xbe, 0xcc,
+	0xb9, 0xbe, 0x51, 0xeb, 0x7f, 0xa7, 0xcd, 0xaf, 0x87, 0xc0, 0xc8, 0x5b, 0x59, 0x07, 0xa2, 0x0e,
+	0x85, 0x2d, 0xca, 0x63, 0xea, 0xe8, 0x30, 0x4c, 0x67, 0xc4, 0x27, 0xb2, 0xd7, 0x43, 0x16, 0x54,
+	0x6e, 0x88, 0x00, 0xfc, 0xf0, 0xe7, 0xcc, 0x92, 0xcb, 0xe2, 0x7a, 0xb3, 0x56, 0xb6, 0x43, 0x5f,
+	0xb7, 0x46, 0xfa, 0xdf, 0x0a, 0x77, 0xde, 0xd7, 0x4d, 0x9c, 0x70, 0xe0, 0xfa, 0x45, 0x52, 0xeb,
+	0xe3, 0x00, 0x46, 0xb7, 0x59, 0x5c, 0x77, 0x22, 0xb2, 0x2d, 0x22, 0x75, 0x3a, 0x64, 0x6d, 0x0a,
+	0x1c, 0xc3, 0x85, 0x64, 0x20, 0x2a, 0x4b, 0x71, 0xf0, 0x94, 0x28, 0x33, 0x2c, 0x62, 0x97, 0x11,
+	0xf5, 0x09, 0x0b, 0x58, 0xe0, 0x16, 0x87, 0x4e, 0x6b, 0x97, 0x2d, 0x0a, 0x91, 0x01, 0x3c, 0x26,
+	0x51, 0x9c, 0x14, 0x9e, 0xf3, 0xb2, 0xf0, 0x8c, 0xc9, 0xb9, 0x76, 0x65, 0xa2, 0x81, 0x93, 0x00,
+	0x0a, 0xaa, 0x32, 0xd1, 0xc0, 0x51, 0xe6, 0xb5, 0xef, 0x01, 0xce, 0xcb, 0x04, 0xc1, 0x1f, 0xc0,
+	0xb0, 0x6e, 0x49, 0xb1, 0xd9, 0x71, 0x1b, 0x72, 0xfa, 0x5b, 0xe3, 0x4a, 0x4f, 0x8c, 0xca, 0x2f,
+	0x73, 0xe1, 0xa3, 0xdf, 0xfe, 0x7e, 0x78, 0x6e, 0x16, 0x97, 0xac, 0x76, 0x43, 0x4f, 0xb8, 0xef,
+	0xa8, 0xb6, 0x7e, 0x4f, 0xa7, 0xf5, 0x3e, 0xfe, 0x04, 0xc1, 0x68, 0xab, 0x6f, 0xc4, 0x57, 0xf3,
+	0x96, 0xee, 0x6c, 0x76, 0x8d, 0xf9, 0x3e, 0x28, 0x2d, 0xe1, 0x39, 0x29, 0x61, 0x1e, 0x5f, 0xc9,
+	0x95, 0xe0, 0x31, 0x1e, 0x5b, 0x7b, 0xfa, 0x71, 0xde, 0xc7, 0x01, 0x14, 0x54, 0x53, 0x85, 0xe7,
+	0xf2, 0x56, 0xcf, 0x74, 0x6d, 0x86, 0xd9, 0x0b, 0xa2, 0xd9, 0x2f, 0x4b, 0xf6, 0x29, 0x3c, 0x69,
+	0xe5, 0x7d, 0xa3, 0xc1, 0x9f, 0x22, 0x80, 0x76, 0xc3, 0x88, 0x73, 0xb7, 0x74, 0xa4, 0x6d, 0x35,
+	0x16, 0xfa, 0xc1, 0x34, 0xf9, 0x8a, 0x24, 0x5f, 0xc4, 0xf3, 0xbd, 0xa3, 0x6f, 0xa9, 0xde, 0x14,
+	0xc7, 0x30, 0x92, 0x3c, 0x2b, 0x38, 0xf7, 0x74, 0x3b, 0xde, 0x4a, 0xe3, 0x6a, 0x6f, 0x50, 0x8f,
+	0x10, 0xb4, 0x54, 0x70, 0xfc, 0x1d, 0x02, 0x7c, 0xb4, 0x0d, 0xc4, 0x2b, 0xdd, 0xf6, 0x98, 0xdb,
+	0xcd, 0x1a, 0xe5, 0xe3, 0xc2, 0xb5, 0xa8, 0x35, 0x29, 0xea, 0x79, 0xbc, 0x9c, 0x1b, 0x9a, 0x5a,
+	0xdb, 0x23, 0x95, 0x1c, 0x8f, 0x10, 0x8c, 0xe7, 0xb4, 0x3a, 0x38, 0x97, 0xbb, 0x7b, 0xdb, 0x68,
+	0x58, 0xc7, 0xc6, 0x6b, 0xb1, 0xd7, 0xa5, 0xd8, 0x65, 0xbc, 0x94, 0x2b, 0xd6, 0x4e, 0x3c, 0x53,
+	0x52, 0xbf, 0x45, 0x70, 0x31, 0x53, 0xf1, 0xf1, 0x52, 0x1e, 0x69, 0xde, 0x73, 0x63, 0x5c, 0x3b,
+	0x06, 0x52, 0x0b, 0x7b, 0x55, 0x0a, 0x7b, 0x09, 0xdf, 0xe8, 0x93, 0x60, 0x5b, 0xca, 0xdb, 0xda,
+	0x4b, 0x3f, 0x57, 0xfb, 0xf8, 0x47, 0x04, 0x93, 0xb9, 0x0f, 0x35, 0xbe, 0xde, 0x35, 0xc3, 0xbb,
+	0xb4, 0x16, 0xc6, 0xea, 0x7f, 0xf0, 0xd0, 0xea, 0x2d, 0xa9, 0xfe, 0x1a, 0x5e, 0xec, 0xa3, 0x3e,
+	0x79, 0xee, 0x2b, 0xaf, 0x3f, 0x39, 0x28, 0xa1, 0xa7, 0x07, 0x25, 0xf4, 0xd7, 0x41, 0x09, 0x7d,
+	0x76, 0x58, 0x1a, 0x78, 0x7a, 0x58, 0x1a, 0xf8, 0xfd, 0xb0, 0x34, 0xf0, 0xee, 0x42, 0xaa, 0x82,
+	0xaf, 0x87, 0xdc, 0xdf, 0x68, 0x2f, 0xb6, 0x93, 0xfa, 0x75, 0xa3, 0x56, 0x90, 0x3f, 0x19, 0xbc,
+	0xf0, 0xef, 0x00, 0xa5, 0x1a, 0x9c, 0x7a, 0x43, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetWill retrieves a will by its ID.
+	GetWill(ctx context.Context, in *QueryGetWillRequest, opts ...grpc.CallOption) (*QueryGetWillResponse, error)
+	// GetWill retrieves all wills by an account address
+	ListWills(ctx context.Context, in *QueryListWillsRequest, opts ...grpc.CallOption) (*QueryListWillsResponse, error)
+	// Params retrieves the will module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// WillEscrow retrieves the assets held in escrow for a will
+	WillEscrow(ctx context.Context, in *QueryWillEscrowRequest, opts ...grpc.CallOption) (*QueryWillEscrowResponse, error)
+	// AllWills retrieves the wills of all accounts, optionally filtered by
+	// status and trigger height range
+	AllWills(ctx context.Context, in *QueryAllWillsRequest, opts ...grpc.CallOption) (*QueryAllWillsResponse, error)
+	// WillsByBeneficiary retrieves all wills naming an address as beneficiary
+	WillsByBeneficiary(ctx context.Context, in *QueryWillsByBeneficiaryRequest, opts ...grpc.CallOption) (*QueryWillsByBeneficiaryResponse, error)
+	// ClaimableComponents retrieves every active claim component an address is
+	// allowed to claim
+	ClaimableComponents(ctx context.Context, in *QueryClaimableComponentsRequest, opts ...grpc.CallOption) (*QueryClaimableComponentsResponse, error)
+	// VestingStatus retrieves the vested and remaining coins of a vesting
+	// component
+	VestingStatus(ctx context.Context, in *QueryVestingStatusRequest, opts ...grpc.CallOption) (*QueryVestingStatusResponse, error)
+	// WillExecutionReceipts retrieves what each component of a will did when
+	// the will fired
+	WillExecutionReceipts(ctx context.Context, in *QueryWillExecutionReceiptsRequest, opts ...grpc.CallOption) (*QueryWillExecutionReceiptsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetWill(ctx context.Context, in *QueryGetWillRequest, opts ...grpc.CallOption) (*QueryGetWillResponse, error) {
+	out := new(QueryGetWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/GetWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListWills(ctx context.Context, in *QueryListWillsRequest, opts ...grpc.CallOption) (*QueryListWillsResponse, error) {
+	out := new(QueryListWillsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/ListWills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WillEscrow(ctx context.Context, in *QueryWillEscrowRequest, opts ...grpc.CallOption) (*QueryWillEscrowResponse, error) {
+	out := new(QueryWillEscrowResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/WillEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllWills(ctx context.Context, in *QueryAllWillsRequest, opts ...grpc.CallOption) (*QueryAllWillsResponse, error) {
+	out := new(QueryAllWillsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/AllWills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WillsByBeneficiary(ctx context.Context, in *QueryWillsByBeneficiaryRequest, opts ...grpc.CallOption) (*QueryWillsByBeneficiaryResponse, error) {
+	out := new(QueryWillsByBeneficiaryResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/WillsByBeneficiary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableComponents(ctx context.Context, in *QueryClaimableComponentsRequest, opts ...grpc.CallOption) (*QueryClaimableComponentsResponse, error) {
+	out := new(QueryClaimableComponentsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/ClaimableComponents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingStatus(ctx context.Context, in *QueryVestingStatusRequest, opts ...grpc.CallOption) (*QueryVestingStatusResponse, error) {
+	out := new(QueryVestingStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/VestingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WillExecutionReceipts(ctx context.Context, in *QueryWillExecutionReceiptsRequest, opts ...grpc.CallOption) (*QueryWillExecutionReceiptsResponse, error) {
+	out := new(QueryWillExecutionReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Query/WillExecutionReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetWill retrieves a will by its ID.
+	GetWill(context.Context, *QueryGetWillRequest) (*QueryGetWillResponse, error)
+	// GetWill retrieves all wills by an account address
+	ListWills(context.Context, *QueryListWillsRequest) (*QueryListWillsResponse, error)
+	// Params retrieves the will module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// WillEscrow retrieves the assets held in escrow for a will
+	WillEscrow(context.Context, *QueryWillEscrowRequest) (*QueryWillEscrowResponse, error)
+	// AllWills retrieves the wills of all accounts, optionally filtered by
+	// status and trigger height range
+	AllWills(context.Context, *QueryAllWillsRequest) (*QueryAllWillsResponse, error)
+	// WillsByBeneficiary retrieves all wills naming an address as beneficiary
+	WillsByBeneficiary(context.Context, *QueryWillsByBeneficiaryRequest) (*QueryWillsByBeneficiaryResponse, error)
+	// ClaimableComponents retrieves every active claim component an address is
+	// allowed to claim
+	ClaimableComponents(context.Context, *QueryClaimableComponentsRequest) (*QueryClaimableComponentsResponse, error)
+	// VestingStatus retrieves the vested and remaining coins of a vesting
+	// component
+	VestingStatus(context.Context, *QueryVestingStatusRequest) (*QueryVestingStatusResponse, error)
+	// WillExecutionReceipts retrieves what each component of a will did when
+	// the will fired
+	WillExecutionReceipts(context.Context, *QueryWillExecutionReceiptsRequest) (*QueryWillExecutionReceiptsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) GetWill(ctx context.Context, req *QueryGetWillRequest) (*QueryGetWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWill not implemented")
+}
+
+func (*UnimplementedQueryServer) ListWills(ctx context.Context, req *QueryListWillsRequest) (*QueryListWillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWills not implemented")
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func (*UnimplementedQueryServer) WillEscrow(ctx context.Context, req *QueryWillEscrowRequest) (*QueryWillEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WillEscrow not implemented")
+}
+
+func (*UnimplementedQueryServer) AllWills(ctx context.Context, req *QueryAllWillsRequest) (*QueryAllWillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllWills not implemented")
+}
+
+func (*UnimplementedQueryServer) WillsByBeneficiary(ctx context.Context, req *QueryWillsByBeneficiaryRequest) (*QueryWillsByBeneficiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WillsByBeneficiary not implemented")
+}
+
+func (*UnimplementedQueryServer) ClaimableComponents(ctx context.Context, req *QueryClaimableComponentsRequest) (*QueryClaimableComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableComponents not implemented")
+}
+
+func (*UnimplementedQueryServer) VestingStatus(ctx context.Context, req *QueryVestingStatusRequest) (*QueryVestingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingStatus not implemented")
+}
+
+func (*UnimplementedQueryServer) WillExecutionReceipts(ctx context.Context, req *QueryWillExecutionReceiptsRequest) (*QueryWillExecutionReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WillExecutionReceipts not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/GetWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWill(ctx, req.(*QueryGetWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListWills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListWillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListWills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/ListWills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListWills(ctx, req.(*QueryListWillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WillEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWillEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WillEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/WillEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WillEscrow(ctx, req.(*QueryWillEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllWills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllWillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllWills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/AllWills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllWills(ctx, req.(*QueryAllWillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WillsByBeneficiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWillsByBeneficiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WillsByBeneficiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/WillsByBeneficiary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WillsByBeneficiary(ctx, req.(*QueryWillsByBeneficiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/ClaimableComponents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableComponents(ctx, req.(*QueryClaimableComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/VestingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingStatus(ctx, req.(*QueryVestingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WillExecutionReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWillExecutionReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WillExecutionReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Query/WillExecutionReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WillExecutionReceipts(ctx, req.(*QueryWillExecutionReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmwasm.will.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWill",
+			Handler:    _Query_GetWill_Handler,
+		},
+		{
+			MethodName: "ListWills",
+			Handler:    _Query_ListWills_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "WillEscrow",
+			Handler:    _Query_WillEscrow_Handler,
+		},
+		{
+			MethodName: "AllWills",
+			Handler:    _Query_AllWills_Handler,
+		},
+		{
+			MethodName: "WillsByBeneficiary",
+			Handler:    _Query_WillsByBeneficiary_Handler,
+		},
+		{
+			MethodName: "ClaimableComponents",
+			Handler:    _Query_ClaimableComponents_Handler,
+		},
+		{
+			MethodName: "VestingStatus",
+			Handler:    _Query_VestingStatus_Handler,
+		},
+		{
+			MethodName: "WillExecutionReceipts",
+			Handler:    _Query_WillExecutionReceipts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmwasm/will/query.proto",
+}
+
+func (m *QueryGetWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Will != nil {
+		{
+			size, err := m.Will.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListWillsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListWillsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListWillsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListWillsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListWillsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListWillsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Wills) > 0 {
+		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillsByBeneficiaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillsByBeneficiaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillsByBeneficiaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillsByBeneficiaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillsByBeneficiaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillsByBeneficiaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Wills) > 0 {
+		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableComponentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableComponentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableComponentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Public {
+		i--
+		if m.Public {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Scheme) > 0 {
+		i -= len(m.Scheme)
+		copy(dAtA[i:], m.Scheme)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Scheme)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableComponentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableComponentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableComponentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWillsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWillsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWillsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWillsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWillsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWillsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Wills) > 0 {
+		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillExecutionReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillExecutionReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillExecutionReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWillExecutionReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWillExecutionReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWillExecutionReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Withdrawable) > 0 {
+		for iNdEx := len(m.Withdrawable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryGetWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Will != nil {
+		l = m.Will.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListWillsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListWillsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Wills) > 0 {
+		for _, e := range m.Wills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWillEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWillEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWillsByBeneficiaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWillsByBeneficiaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Wills) > 0 {
+		for _, e := range m.Wills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableComponentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimableComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Public {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClaimableComponentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWillsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllWillsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Wills) > 0 {
+		for _, e := range m.Wills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWillExecutionReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWillExecutionReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Withdrawable) > 0 {
+		for _, e := range m.Withdrawable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryGetWillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryGetWillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Will", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Will == nil {
+				m.Will = &Will{}
+			}
+			if err := m.Will.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListWillsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListWillsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryListWillsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListWillsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListWillsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wills = append(m.Wills, Will{})
+			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryWillEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWillEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWillEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryWillEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWillEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWillEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryWillsByBeneficiaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWillsByBeneficiaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWillsByBeneficiaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryWillsByBeneficiaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWillsByBeneficiaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWillsByBeneficiaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wills = append(m.Wills, Will{})
+			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryClaimableComponentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableComponentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableComponentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimableComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Public", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Public = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryClaimableComponentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableComponentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableComponentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, ClaimableComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryAllWillsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWillsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWillsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryAllWillsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllWillsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllWillsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wills = append(m.Wills, Will{})
+			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryGetWillRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWillExecutionReceiptsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -683,10 +4544,10 @@ func (m *QueryGetWillRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWillRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWillExecutionReceiptsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWillExecutionReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -721,6 +4582,42 @@ func (m *QueryGetWillRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -743,7 +4640,7 @@ func (m *QueryGetWillRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryGetWillResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWillExecutionReceiptsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,15 +4663,15 @@ func (m *QueryGetWillResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWillResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWillExecutionReceiptsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWillExecutionReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Will", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -801,10 +4698,44 @@ func (m *QueryGetWillResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Will == nil {
-				m.Will = &Will{}
+			m.Receipts = append(m.Receipts, ExecutionReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Will.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -830,7 +4761,7 @@ func (m *QueryGetWillResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -853,15 +4784,15 @@ func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListWillsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListWillsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -889,13 +4820,13 @@ func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -905,27 +4836,23 @@ func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -949,7 +4876,7 @@ func (m *QueryListWillsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryListWillsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,15 +4899,15 @@ func (m *QueryListWillsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListWillsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListWillsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1007,14 +4934,14 @@ func (m *QueryListWillsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wills = append(m.Wills, Will{})
-			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1041,13 +4968,117 @@ func (m *QueryListWillsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawable = append(m.Withdrawable, types.Coin{})
+			if err := m.Withdrawable[len(m.Withdrawable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,39 @@
+package types
+
+// will statuses
+const (
+	// WillStatusLive is the status of a will waiting for its trigger height
+	WillStatusLive = "live"
+	// WillStatusQueued is the status of a will that fired and waits in the execution queue
+	WillStatusQueued = "queued"
+	// WillStatusExpired is the status of a will that has fired
+	WillStatusExpired = "expired"
+	// WillStatusCancelled is the status of a will revoked by its creator
+	WillStatusCancelled = "cancelled"
+)
+
+// component statuses
+const (
+	// ComponentStatusInactive is the status of a component before its will fires
+	ComponentStatusInactive = "inactive"
+	// ComponentStatusActive is the status of a claim component that accepts claims
+	ComponentStatusActive = "active"
+	// ComponentStatusExecuted is the status of a component the will executed
+	ComponentStatusExecuted = "executed"
+	// ComponentStatusClaimed is the status of a claim component that was claimed
+	ComponentStatusClaimed = "claimed"
+	// ComponentStatusLapsed is the status of a claim component whose claim window closed before it was claimed
+	ComponentStatusLapsed = "lapsed"
+	// ComponentStatusVesting is the status of a vesting component whose beneficiary has not withdrawn everything yet
+	ComponentStatusVesting = "vesting"
+	// ComponentStatusPending is the status of a component whose IBC packet is not acknowledged yet
+	ComponentStatusPending = "pending"
+	// ComponentStatusFailed is the status of a component that failed when its will fired
+	ComponentStatusFailed = "failed"
+	// ComponentStatusSkipped is the status of a component that did not run when its will fired,
+	// because a component it depends on or an earlier component of an atomic will did not execute
+	ComponentStatusSkipped = "skipped"
+	// ComponentStatusReverted is the status of a component of an atomic will whose effects were
+	// undone because another component failed
+	ComponentStatusReverted = "reverted"
+)
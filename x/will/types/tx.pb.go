@@ -11,16 +11,22 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,6 +34,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -148,6 +155,21 @@ type MsgCreateWillRequest struct {
 	Beneficiary string                `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 	Height      int64                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 	Components  []*ExecutionComponent `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	// number of blocks a check-in moves the trigger height past the check-in
+	// block, zero disables check-ins
+	InactivityWindow int64 `protobuf:"varint,6,opt,name=inactivity_window,json=inactivityWindow,proto3" json:"inactivity_window,omitempty"`
+	// block time to trigger the will at, instead of a height
+	TriggerTime *time.Time `protobuf:"bytes,7,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
+	// time a check-in moves the trigger time past the check-in block time, zero
+	// disables check-ins of wills with a trigger time
+	InactivityDuration time.Duration `protobuf:"bytes,8,opt,name=inactivity_duration,json=inactivityDuration,proto3,stdduration" json:"inactivity_duration"`
+	// contract query to trigger the will on, instead of a height or time
+	Condition *WasmCondition `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	// whether a failed component reverts the others when the will fires
+	ExecutionMode ExecutionMode `protobuf:"varint,10,opt,name=execution_mode,json=executionMode,proto3,enum=cosmwasm.will.ExecutionMode" json:"execution_mode,omitempty"`
+	// channels of the will port whose will packets may check in on the will and
+	// claim its components, empty only accepts local check-ins and claims
+	RemoteChannels []string `protobuf:"bytes,11,rep,name=remote_channels,json=remoteChannels,proto3" json:"remote_channels,omitempty"`
 }
 
 func (m *MsgCreateWillRequest) Reset()         { *m = MsgCreateWillRequest{} }
@@ -223,6 +245,48 @@ func (m *MsgCreateWillRequest) GetComponents() []*ExecutionComponent {
 	return nil
 }
 
+func (m *MsgCreateWillRequest) GetInactivityWindow() int64 {
+	if m != nil {
+		return m.InactivityWindow
+	}
+	return 0
+}
+
+func (m *MsgCreateWillRequest) GetTriggerTime() *time.Time {
+	if m != nil {
+		return m.TriggerTime
+	}
+	return nil
+}
+
+func (m *MsgCreateWillRequest) GetInactivityDuration() time.Duration {
+	if m != nil {
+		return m.InactivityDuration
+	}
+	return 0
+}
+
+func (m *MsgCreateWillRequest) GetCondition() *WasmCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (m *MsgCreateWillRequest) GetExecutionMode() ExecutionMode {
+	if m != nil {
+		return m.ExecutionMode
+	}
+	return ExecutionMode_EXECUTION_MODE_BEST_EFFORT
+}
+
+func (m *MsgCreateWillRequest) GetRemoteChannels() []string {
+	if m != nil {
+		return m.RemoteChannels
+	}
+	return nil
+}
+
 // to get the will response
 type MsgCreateWillResponse struct {
 	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -230,6 +294,8 @@ type MsgCreateWillResponse struct {
 	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Beneficiary string `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 	Height      int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// block time the will triggers at, if it has a time trigger
+	TriggerTime *time.Time `protobuf:"bytes,6,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
 }
 
 func (m *MsgCreateWillResponse) Reset()         { *m = MsgCreateWillResponse{} }
@@ -305,6 +371,13 @@ func (m *MsgCreateWillResponse) GetHeight() int64 {
 	return 0
 }
 
+func (m *MsgCreateWillResponse) GetTriggerTime() *time.Time {
+	if m != nil {
+		return m.TriggerTime
+	}
+	return nil
+}
+
 // checkins
 //
 //	message for checking in
@@ -375,8 +448,11 @@ func (m *MsgCheckInRequest) GetHeight() int64 {
 
 // response for checkin
 type MsgCheckInResponse struct {
-	Status bool  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status bool `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the new trigger height of the will
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the new trigger time of the will, if it has a time trigger
+	TriggerTime *time.Time `protobuf:"bytes,3,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
 }
 
 func (m *MsgCheckInResponse) Reset()         { *m = MsgCheckInResponse{} }
@@ -431,38 +507,35 @@ func (m *MsgCheckInResponse) GetHeight() int64 {
 	return 0
 }
 
-// claims
-type MsgClaimRequest struct {
-	// ID of the will being claimed
-	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
-	// Claimer's address
-	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
-	// component id
-	ComponentId string `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
-	// OneOf claim data that contains the specific claim information based on the
-	// will's execution component
-	//
-	// Types that are valid to be assigned to ClaimType:
-	//	*MsgClaimRequest_SchnorrClaim
-	//	*MsgClaimRequest_PedersenClaim
-	//	*MsgClaimRequest_GnarkClaim
-	ClaimType isMsgClaimRequest_ClaimType `protobuf_oneof:"claim_type"`
+func (m *MsgCheckInResponse) GetTriggerTime() *time.Time {
+	if m != nil {
+		return m.TriggerTime
+	}
+	return nil
 }
 
-func (m *MsgClaimRequest) Reset()         { *m = MsgClaimRequest{} }
-func (m *MsgClaimRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimRequest) ProtoMessage()    {}
-func (*MsgClaimRequest) Descriptor() ([]byte, []int) {
+// message for topping up the escrow of a will
+type MsgFundWillRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// coins to move from the creator into the will escrow
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundWillRequest) Reset()         { *m = MsgFundWillRequest{} }
+func (m *MsgFundWillRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFundWillRequest) ProtoMessage()    {}
+func (*MsgFundWillRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_22e268a87ad78580, []int{6}
 }
 
-func (m *MsgClaimRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgFundWillRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *MsgClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFundWillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFundWillRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -473,123 +546,121 @@ func (m *MsgClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 	}
 }
 
-func (m *MsgClaimRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimRequest.Merge(m, src)
+func (m *MsgFundWillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundWillRequest.Merge(m, src)
 }
 
-func (m *MsgClaimRequest) XXX_Size() int {
+func (m *MsgFundWillRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *MsgClaimRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgClaimRequest proto.InternalMessageInfo
-
-type isMsgClaimRequest_ClaimType interface {
-	isMsgClaimRequest_ClaimType()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type MsgClaimRequest_SchnorrClaim struct {
-	SchnorrClaim *SchnorrClaim `protobuf:"bytes,4,opt,name=schnorr_claim,json=schnorrClaim,proto3,oneof" json:"schnorr_claim,omitempty"`
-}
-type MsgClaimRequest_PedersenClaim struct {
-	PedersenClaim *PedersenClaim `protobuf:"bytes,5,opt,name=pedersen_claim,json=pedersenClaim,proto3,oneof" json:"pedersen_claim,omitempty"`
-}
-type MsgClaimRequest_GnarkClaim struct {
-	GnarkClaim *GnarkClaim `protobuf:"bytes,6,opt,name=gnark_claim,json=gnarkClaim,proto3,oneof" json:"gnark_claim,omitempty"`
+func (m *MsgFundWillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundWillRequest.DiscardUnknown(m)
 }
 
-func (*MsgClaimRequest_SchnorrClaim) isMsgClaimRequest_ClaimType()  {}
-func (*MsgClaimRequest_PedersenClaim) isMsgClaimRequest_ClaimType() {}
-func (*MsgClaimRequest_GnarkClaim) isMsgClaimRequest_ClaimType()    {}
+var xxx_messageInfo_MsgFundWillRequest proto.InternalMessageInfo
 
-func (m *MsgClaimRequest) GetClaimType() isMsgClaimRequest_ClaimType {
+func (m *MsgFundWillRequest) GetCreator() string {
 	if m != nil {
-		return m.ClaimType
+		return m.Creator
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgClaimRequest) GetWillId() string {
+func (m *MsgFundWillRequest) GetId() string {
 	if m != nil {
-		return m.WillId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *MsgClaimRequest) GetClaimer() string {
+func (m *MsgFundWillRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Claimer
+		return m.Amount
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgClaimRequest) GetComponentId() string {
-	if m != nil {
-		return m.ComponentId
-	}
-	return ""
+// response for funding a will
+type MsgFundWillResponse struct {
+	// coins held in escrow for the will after the top up
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
 }
 
-func (m *MsgClaimRequest) GetSchnorrClaim() *SchnorrClaim {
-	if x, ok := m.GetClaimType().(*MsgClaimRequest_SchnorrClaim); ok {
-		return x.SchnorrClaim
-	}
-	return nil
+func (m *MsgFundWillResponse) Reset()         { *m = MsgFundWillResponse{} }
+func (m *MsgFundWillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundWillResponse) ProtoMessage()    {}
+func (*MsgFundWillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{7}
 }
 
-func (m *MsgClaimRequest) GetPedersenClaim() *PedersenClaim {
-	if x, ok := m.GetClaimType().(*MsgClaimRequest_PedersenClaim); ok {
-		return x.PedersenClaim
-	}
-	return nil
+func (m *MsgFundWillResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *MsgClaimRequest) GetGnarkClaim() *GnarkClaim {
-	if x, ok := m.GetClaimType().(*MsgClaimRequest_GnarkClaim); ok {
-		return x.GnarkClaim
+func (m *MsgFundWillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundWillResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*MsgClaimRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*MsgClaimRequest_SchnorrClaim)(nil),
-		(*MsgClaimRequest_PedersenClaim)(nil),
-		(*MsgClaimRequest_GnarkClaim)(nil),
-	}
+func (m *MsgFundWillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundWillResponse.Merge(m, src)
 }
 
-// SchnorrClaim is specifically structured for claims requiring a Schnorr
-// signature.
-type SchnorrClaim struct {
-	// The public key associated with the Schnorr signature, typically 33 bytes.
-	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// The actual Schnorr signature, could be 64 bytes (r || s).
-	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	// The original message that was signed, needed for verification.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+func (m *MsgFundWillResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *SchnorrClaim) Reset()         { *m = SchnorrClaim{} }
-func (m *SchnorrClaim) String() string { return proto.CompactTextString(m) }
-func (*SchnorrClaim) ProtoMessage()    {}
-func (*SchnorrClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22e268a87ad78580, []int{7}
+func (m *MsgFundWillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundWillResponse.DiscardUnknown(m)
 }
 
-func (m *SchnorrClaim) XXX_Unmarshal(b []byte) error {
+var xxx_messageInfo_MsgFundWillResponse proto.InternalMessageInfo
+
+func (m *MsgFundWillResponse) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
+}
+
+// message for updating a live will, zero values keep the current setting
+type MsgUpdateWillRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// new beneficiary, empty keeps the current one
+	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// new trigger height, zero keeps the current one
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// new components, empty keeps the current ones
+	Components []*ExecutionComponent `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	// new trigger time of a will with a time trigger, unset keeps the current
+	// one
+	TriggerTime *time.Time `protobuf:"bytes,6,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
+}
+
+func (m *MsgUpdateWillRequest) Reset()         { *m = MsgUpdateWillRequest{} }
+func (m *MsgUpdateWillRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWillRequest) ProtoMessage()    {}
+func (*MsgUpdateWillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{8}
+}
+
+func (m *MsgUpdateWillRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *SchnorrClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchnorrClaim.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWillRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,64 +671,85 @@ func (m *SchnorrClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *SchnorrClaim) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchnorrClaim.Merge(m, src)
+func (m *MsgUpdateWillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWillRequest.Merge(m, src)
 }
 
-func (m *SchnorrClaim) XXX_Size() int {
+func (m *MsgUpdateWillRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *SchnorrClaim) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchnorrClaim.DiscardUnknown(m)
+func (m *MsgUpdateWillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWillRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchnorrClaim proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWillRequest proto.InternalMessageInfo
 
-func (m *SchnorrClaim) GetPublicKey() []byte {
+func (m *MsgUpdateWillRequest) GetCreator() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Creator
 	}
-	return nil
+	return ""
 }
 
-func (m *SchnorrClaim) GetSignature() []byte {
+func (m *MsgUpdateWillRequest) GetId() string {
 	if m != nil {
-		return m.Signature
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *SchnorrClaim) GetMessage() string {
+func (m *MsgUpdateWillRequest) GetBeneficiary() string {
 	if m != nil {
-		return m.Message
+		return m.Beneficiary
 	}
 	return ""
 }
 
-// pedersen
-type PedersenClaim struct {
-	// Specific fields for Pedersen claims, e.g., commitment, blinding factor,
-	// etc.
-	Commitment     []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	BlindingFactor []byte `protobuf:"bytes,2,opt,name=blinding_factor,json=blindingFactor,proto3" json:"blinding_factor,omitempty"`
-	Value          []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+func (m *MsgUpdateWillRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *PedersenClaim) Reset()         { *m = PedersenClaim{} }
-func (m *PedersenClaim) String() string { return proto.CompactTextString(m) }
-func (*PedersenClaim) ProtoMessage()    {}
-func (*PedersenClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22e268a87ad78580, []int{8}
+func (m *MsgUpdateWillRequest) GetComponents() []*ExecutionComponent {
+	if m != nil {
+		return m.Components
+	}
+	return nil
 }
 
-func (m *PedersenClaim) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWillRequest) GetTriggerTime() *time.Time {
+	if m != nil {
+		return m.TriggerTime
+	}
+	return nil
+}
+
+// response for updating a will
+type MsgUpdateWillResponse struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Beneficiary string `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Height      int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// trigger time of the will, if it has a time trigger
+	TriggerTime *time.Time `protobuf:"bytes,4,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
+}
+
+func (m *MsgUpdateWillResponse) Reset()         { *m = MsgUpdateWillResponse{} }
+func (m *MsgUpdateWillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWillResponse) ProtoMessage()    {}
+func (*MsgUpdateWillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{9}
+}
+
+func (m *MsgUpdateWillResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *PedersenClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PedersenClaim.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWillResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -668,62 +760,68 @@ func (m *PedersenClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 }
 
-func (m *PedersenClaim) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PedersenClaim.Merge(m, src)
+func (m *MsgUpdateWillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWillResponse.Merge(m, src)
 }
 
-func (m *PedersenClaim) XXX_Size() int {
+func (m *MsgUpdateWillResponse) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *PedersenClaim) XXX_DiscardUnknown() {
-	xxx_messageInfo_PedersenClaim.DiscardUnknown(m)
+func (m *MsgUpdateWillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWillResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PedersenClaim proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWillResponse proto.InternalMessageInfo
 
-func (m *PedersenClaim) GetCommitment() []byte {
+func (m *MsgUpdateWillResponse) GetId() string {
 	if m != nil {
-		return m.Commitment
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *PedersenClaim) GetBlindingFactor() []byte {
+func (m *MsgUpdateWillResponse) GetBeneficiary() string {
 	if m != nil {
-		return m.BlindingFactor
+		return m.Beneficiary
 	}
-	return nil
+	return ""
 }
 
-func (m *PedersenClaim) GetValue() []byte {
+func (m *MsgUpdateWillResponse) GetHeight() int64 {
 	if m != nil {
-		return m.Value
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgUpdateWillResponse) GetTriggerTime() *time.Time {
+	if m != nil {
+		return m.TriggerTime
 	}
 	return nil
 }
 
-// gnark
-type GnarkClaim struct {
-	// Specific fields for Gnark zk-SNARK claims, e.g., proof, public inputs, etc.
-	Proof        []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
-	PublicInputs []byte `protobuf:"bytes,2,opt,name=public_inputs,json=publicInputs,proto3" json:"public_inputs,omitempty"`
+// message for cancelling a live will
+type MsgCancelWillRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *GnarkClaim) Reset()         { *m = GnarkClaim{} }
-func (m *GnarkClaim) String() string { return proto.CompactTextString(m) }
-func (*GnarkClaim) ProtoMessage()    {}
-func (*GnarkClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22e268a87ad78580, []int{9}
+func (m *MsgCancelWillRequest) Reset()         { *m = MsgCancelWillRequest{} }
+func (m *MsgCancelWillRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWillRequest) ProtoMessage()    {}
+func (*MsgCancelWillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{10}
 }
 
-func (m *GnarkClaim) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelWillRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *GnarkClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelWillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GnarkClaim.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelWillRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -734,56 +832,54 @@ func (m *GnarkClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *GnarkClaim) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GnarkClaim.Merge(m, src)
+func (m *MsgCancelWillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWillRequest.Merge(m, src)
 }
 
-func (m *GnarkClaim) XXX_Size() int {
+func (m *MsgCancelWillRequest) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *GnarkClaim) XXX_DiscardUnknown() {
-	xxx_messageInfo_GnarkClaim.DiscardUnknown(m)
+func (m *MsgCancelWillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWillRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GnarkClaim proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelWillRequest proto.InternalMessageInfo
 
-func (m *GnarkClaim) GetProof() []byte {
+func (m *MsgCancelWillRequest) GetCreator() string {
 	if m != nil {
-		return m.Proof
+		return m.Creator
 	}
-	return nil
+	return ""
 }
 
-func (m *GnarkClaim) GetPublicInputs() []byte {
+func (m *MsgCancelWillRequest) GetId() string {
 	if m != nil {
-		return m.PublicInputs
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-// MsgClaimResponse
-type MsgClaimResponse struct {
-	// Indicates whether the claim was successful or not
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Optional message providing more details on the claim result
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+// response for cancelling a will
+type MsgCancelWillResponse struct {
+	// escrow returned to the creator
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
 }
 
-func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
-func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimResponse) ProtoMessage()    {}
-func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_22e268a87ad78580, []int{10}
+func (m *MsgCancelWillResponse) Reset()         { *m = MsgCancelWillResponse{} }
+func (m *MsgCancelWillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWillResponse) ProtoMessage()    {}
+func (*MsgCancelWillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{11}
 }
 
-func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelWillResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelWillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelWillResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -794,1136 +890,4835 @@ func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 }
 
-func (m *MsgClaimResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimResponse.Merge(m, src)
+func (m *MsgCancelWillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWillResponse.Merge(m, src)
 }
 
-func (m *MsgClaimResponse) XXX_Size() int {
+func (m *MsgCancelWillResponse) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *MsgClaimResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimResponse.DiscardUnknown(m)
+func (m *MsgCancelWillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWillResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelWillResponse proto.InternalMessageInfo
 
-func (m *MsgClaimResponse) GetSuccess() bool {
+func (m *MsgCancelWillResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Success
+		return m.Refund
 	}
-	return false
+	return nil
 }
 
-func (m *MsgClaimResponse) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
+// message for withdrawing the coins that vested from a vesting component
+type MsgWithdrawVestedRequest struct {
+	// beneficiary of the vesting component
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	WillId      string `protobuf:"bytes,2,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "cosmwasm.will.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmwasm.will.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateWillRequest)(nil), "cosmwasm.will.MsgCreateWillRequest")
-	proto.RegisterType((*MsgCreateWillResponse)(nil), "cosmwasm.will.MsgCreateWillResponse")
-	proto.RegisterType((*MsgCheckInRequest)(nil), "cosmwasm.will.MsgCheckInRequest")
-	proto.RegisterType((*MsgCheckInResponse)(nil), "cosmwasm.will.MsgCheckInResponse")
-	proto.RegisterType((*MsgClaimRequest)(nil), "cosmwasm.will.MsgClaimRequest")
-	proto.RegisterType((*SchnorrClaim)(nil), "cosmwasm.will.SchnorrClaim")
-	proto.RegisterType((*PedersenClaim)(nil), "cosmwasm.will.PedersenClaim")
-	proto.RegisterType((*GnarkClaim)(nil), "cosmwasm.will.GnarkClaim")
-	proto.RegisterType((*MsgClaimResponse)(nil), "cosmwasm.will.MsgClaimResponse")
+func (m *MsgWithdrawVestedRequest) Reset()         { *m = MsgWithdrawVestedRequest{} }
+func (m *MsgWithdrawVestedRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVestedRequest) ProtoMessage()    {}
+func (*MsgWithdrawVestedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{12}
 }
 
-func init() { proto.RegisterFile("cosmwasm/will/tx.proto", fileDescriptor_22e268a87ad78580) }
-
-var fileDescriptor_22e268a87ad78580 = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xbd, 0x6f, 0x23, 0x45,
-	0x14, 0xf7, 0xda, 0xb1, 0x83, 0x9f, 0xd7, 0x39, 0x6e, 0x94, 0x4b, 0x1c, 0x13, 0x7c, 0xce, 0x1e,
-	0x3a, 0xa2, 0x48, 0x67, 0x0b, 0x23, 0x21, 0x14, 0x51, 0x70, 0x0e, 0xf7, 0x11, 0xa1, 0x20, 0xb4,
-	0x27, 0x88, 0x44, 0x63, 0xad, 0x77, 0x27, 0xeb, 0x51, 0xbc, 0x3b, 0xcb, 0xce, 0xec, 0x5d, 0xd2,
-	0x21, 0x0a, 0x0a, 0x1a, 0xf8, 0x33, 0x28, 0x53, 0xdc, 0x1f, 0x71, 0xe5, 0x89, 0x06, 0x2a, 0x84,
-	0x92, 0x22, 0x15, 0x25, 0x3d, 0x9a, 0x8f, 0xb5, 0xc7, 0x9b, 0x0f, 0x1a, 0x7b, 0xdf, 0x6f, 0xde,
-	0xfc, 0xde, 0x7b, 0xbf, 0x79, 0x6f, 0x06, 0xd6, 0x7c, 0xca, 0xa2, 0x57, 0x1e, 0x8b, 0xfa, 0xaf,
-	0xc8, 0x74, 0xda, 0xe7, 0x27, 0xbd, 0x24, 0xa5, 0x9c, 0xa2, 0x66, 0x8e, 0xf7, 0x04, 0xde, 0xbe,
-	0xeb, 0x45, 0x24, 0xa6, 0x7d, 0xf9, 0xab, 0x3c, 0xda, 0xeb, 0xc2, 0x83, 0xb2, 0x7e, 0xc4, 0xc2,
-	0xfe, 0xcb, 0x8f, 0xc4, 0x9f, 0x5e, 0xd8, 0x50, 0x0b, 0x23, 0x69, 0xf5, 0x95, 0xa1, 0x97, 0x56,
-	0x43, 0x1a, 0x52, 0x85, 0x8b, 0x2f, 0x8d, 0xb6, 0x17, 0x73, 0x48, 0xbc, 0xd4, 0x8b, 0x98, 0x49,
-	0x66, 0xe4, 0x77, 0x9a, 0x60, 0xbd, 0xe4, 0xbc, 0xb6, 0xe0, 0xce, 0x01, 0x0b, 0xbf, 0x49, 0x02,
-	0x8f, 0xe3, 0xaf, 0xe5, 0x26, 0xf4, 0x09, 0xd4, 0xbd, 0x8c, 0x4f, 0x68, 0x4a, 0xf8, 0x69, 0xcb,
-	0xea, 0x5a, 0xdb, 0xf5, 0x61, 0xeb, 0xf7, 0xd7, 0x8f, 0x56, 0x75, 0x16, 0x8f, 0x83, 0x20, 0xc5,
-	0x8c, 0xbd, 0xe0, 0x29, 0x89, 0x43, 0x77, 0xee, 0x8a, 0x3e, 0x85, 0x9a, 0x0a, 0xdb, 0x2a, 0x77,
-	0xad, 0xed, 0xc6, 0xe0, 0x5e, 0x6f, 0xa1, 0xfe, 0x9e, 0xa2, 0x1f, 0xd6, 0xdf, 0xfc, 0x75, 0xbf,
-	0xf4, 0xdb, 0xe5, 0xd9, 0x8e, 0xe5, 0x6a, 0xff, 0xdd, 0xfe, 0x8f, 0x97, 0x67, 0x3b, 0x73, 0xa6,
-	0x9f, 0x2f, 0xcf, 0x76, 0x36, 0xc5, 0xbe, 0xa0, 0x7f, 0xa2, 0x52, 0x2e, 0xa4, 0xe8, 0x6c, 0xc0,
-	0x7a, 0x01, 0x72, 0x31, 0x4b, 0x68, 0xcc, 0xb0, 0xf3, 0xaf, 0x05, 0xab, 0x07, 0x2c, 0xdc, 0x4b,
-	0xb1, 0xc7, 0xf1, 0x21, 0x99, 0x4e, 0x5d, 0xfc, 0x7d, 0x86, 0x19, 0x47, 0x2d, 0x58, 0xf6, 0x05,
-	0x48, 0x53, 0x55, 0x94, 0x9b, 0x9b, 0x08, 0xc1, 0x52, 0xec, 0x45, 0x58, 0xa6, 0x5d, 0x77, 0xe5,
-	0x37, 0xea, 0x42, 0x63, 0x8c, 0x63, 0x7c, 0x44, 0x7c, 0xe2, 0xa5, 0xa7, 0xad, 0x8a, 0x5c, 0x32,
-	0x21, 0xb4, 0x06, 0xb5, 0x09, 0x26, 0xe1, 0x84, 0xb7, 0x96, 0xba, 0xd6, 0x76, 0xc5, 0xd5, 0x16,
-	0x7a, 0x0c, 0xe0, 0xd3, 0x28, 0xa1, 0x31, 0x8e, 0x39, 0x6b, 0x55, 0xbb, 0x95, 0xed, 0xc6, 0x60,
-	0xab, 0x20, 0xc5, 0x93, 0x13, 0xec, 0x67, 0x9c, 0xd0, 0x78, 0x2f, 0xf7, 0x74, 0x8d, 0x4d, 0xbb,
-	0x03, 0xa1, 0x47, 0x9e, 0x9e, 0x50, 0x63, 0xab, 0xa8, 0xc6, 0x95, 0xf2, 0x9c, 0x5f, 0x2c, 0xb8,
-	0x57, 0x58, 0x50, 0x8a, 0xa0, 0x15, 0x28, 0x93, 0x40, 0xd7, 0x5c, 0x26, 0x81, 0x29, 0x44, 0xf9,
-	0x7a, 0x21, 0x2a, 0x37, 0x0b, 0xb1, 0x74, 0x9b, 0x10, 0x55, 0x53, 0x08, 0xe7, 0x27, 0x0b, 0xee,
-	0x8a, 0x8c, 0x26, 0xd8, 0x3f, 0xde, 0x8f, 0xff, 0xff, 0x18, 0x54, 0x9e, 0xe5, 0x59, 0x9e, 0x73,
-	0xde, 0x8a, 0xc9, 0xab, 0xba, 0xc5, 0x54, 0xa7, 0x73, 0x45, 0x9d, 0x85, 0x90, 0xce, 0x17, 0x80,
-	0x4c, 0x50, 0xcb, 0xb2, 0x06, 0x35, 0xc6, 0x3d, 0x9e, 0x31, 0x99, 0xc7, 0x3b, 0xae, 0xb6, 0x8c,
-	0xb0, 0xe5, 0x85, 0x72, 0xfe, 0x29, 0xcb, 0x51, 0xd9, 0x9b, 0x7a, 0x24, 0xca, 0x8b, 0x59, 0x87,
-	0x65, 0x11, 0x73, 0x34, 0xd3, 0xb7, 0x26, 0xcc, 0x7d, 0xa5, 0xb1, 0x70, 0xc4, 0x73, 0x8d, 0x95,
-	0x89, 0xb6, 0xc0, 0x9e, 0x9d, 0xb4, 0xd8, 0xa7, 0x3b, 0x6b, 0x86, 0xed, 0x07, 0x68, 0x08, 0x4d,
-	0xe6, 0x4f, 0x62, 0x9a, 0xa6, 0x23, 0xb9, 0x4b, 0x8a, 0xde, 0x18, 0xbc, 0x57, 0x68, 0xa2, 0x17,
-	0xca, 0x47, 0x26, 0xf4, 0xbc, 0xe4, 0xda, 0xcc, 0xb0, 0xd1, 0x13, 0x58, 0x49, 0x70, 0x80, 0x53,
-	0x86, 0x63, 0x4d, 0x52, 0x95, 0x24, 0x9b, 0xc5, 0xa1, 0xd4, 0x4e, 0x39, 0x4b, 0x33, 0x31, 0x01,
-	0xf4, 0x19, 0x34, 0xc2, 0xd8, 0x4b, 0x8f, 0x35, 0x47, 0x4d, 0x72, 0x6c, 0x14, 0x38, 0x9e, 0x09,
-	0x8f, 0x9c, 0x00, 0xc2, 0x99, 0xb5, 0xfb, 0x48, 0x9d, 0x94, 0xaa, 0xfc, 0xda, 0xa9, 0x36, 0xd5,
-	0x1c, 0xda, 0x00, 0xd2, 0x77, 0x24, 0x6e, 0x28, 0x07, 0x83, 0x6d, 0x56, 0x88, 0xde, 0x07, 0x48,
-	0xb2, 0xf1, 0x94, 0xf8, 0xa3, 0x63, 0xac, 0xee, 0x25, 0xdb, 0xad, 0x2b, 0xe4, 0x4b, 0x7c, 0x8a,
-	0x36, 0xa1, 0xce, 0x48, 0x18, 0x7b, 0x3c, 0x4b, 0xd5, 0x24, 0xdb, 0xee, 0x1c, 0x10, 0xe7, 0x11,
-	0x61, 0xc6, 0xbc, 0x30, 0x6f, 0xee, 0xdc, 0x74, 0x62, 0x68, 0x2e, 0x68, 0x80, 0x3a, 0x72, 0x7e,
-	0x23, 0xc2, 0x23, 0x1c, 0x73, 0x1d, 0xc7, 0x40, 0xd0, 0x87, 0x70, 0x67, 0x3c, 0x25, 0x71, 0x40,
-	0xe2, 0x70, 0x74, 0xe4, 0xf9, 0xf9, 0x18, 0xd9, 0xee, 0x4a, 0x0e, 0x3f, 0x95, 0x28, 0x5a, 0x85,
-	0xea, 0x4b, 0x6f, 0x9a, 0xa9, 0x88, 0xb6, 0xab, 0x0c, 0xe7, 0x19, 0xc0, 0x5c, 0x2f, 0xe1, 0x93,
-	0xa4, 0x94, 0x1e, 0xe9, 0x38, 0xca, 0x40, 0x0f, 0xa0, 0xa9, 0x4b, 0x25, 0x71, 0x92, 0x71, 0xa6,
-	0x03, 0xd8, 0x0a, 0xdc, 0x97, 0x98, 0xf3, 0x14, 0xde, 0x9d, 0x0b, 0xa8, 0x7b, 0xba, 0x05, 0xcb,
-	0x2c, 0xf3, 0x7d, 0xcc, 0xf2, 0xa6, 0xce, 0x4d, 0x53, 0x80, 0xf2, 0x82, 0x00, 0x83, 0x3f, 0xca,
-	0x50, 0x39, 0x60, 0x21, 0xfa, 0x16, 0xec, 0x85, 0x67, 0xa0, 0x53, 0x38, 0xe5, 0xc2, 0x85, 0xdb,
-	0x7e, 0x78, 0xfb, 0xfa, 0x2c, 0xa7, 0x43, 0x80, 0xf9, 0xa5, 0x84, 0x1e, 0x5c, 0xdd, 0x75, 0xe5,
-	0x2e, 0x6b, 0x7f, 0x70, 0xbb, 0x93, 0x26, 0xfe, 0x0a, 0x96, 0xf5, 0x4c, 0xa3, 0xee, 0x35, 0x1b,
-	0x16, 0xee, 0x80, 0xf6, 0xd6, 0x2d, 0x1e, 0x9a, 0xef, 0x39, 0x54, 0x75, 0x07, 0x5c, 0xe3, 0x6b,
-	0xf4, 0x69, 0xfb, 0xfe, 0x8d, 0xeb, 0x8a, 0xa9, 0x5d, 0xfd, 0x41, 0x3c, 0x6f, 0xc3, 0xcf, 0xdf,
-	0x9c, 0x77, 0xac, 0xb7, 0xe7, 0x1d, 0xeb, 0xef, 0xf3, 0x8e, 0xf5, 0xeb, 0x45, 0xa7, 0xf4, 0xf6,
-	0xa2, 0x53, 0xfa, 0xf3, 0xa2, 0x53, 0xfa, 0xee, 0x61, 0x48, 0xf8, 0x24, 0x1b, 0xf7, 0x7c, 0x1a,
-	0xf5, 0xf7, 0x28, 0x8b, 0x0e, 0xe5, 0xe3, 0x6c, 0xce, 0x86, 0x7c, 0xa4, 0xc7, 0x35, 0xf9, 0x4a,
-	0x7f, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0xa2, 0x93, 0x40, 0x62, 0x08, 0x00, 0x00,
+func (m *MsgWithdrawVestedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ context.Context
-	_ grpc.ClientConn
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgWithdrawVestedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVestedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// create a new will
-	CreateWill(ctx context.Context, in *MsgCreateWillRequest, opts ...grpc.CallOption) (*MsgCreateWillResponse, error)
-	// checkin into a will
-	CheckIn(ctx context.Context, in *MsgCheckInRequest, opts ...grpc.CallOption) (*MsgCheckInResponse, error)
-	// make a claim
-	Claim(ctx context.Context, in *MsgClaimRequest, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+func (m *MsgWithdrawVestedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVestedRequest.Merge(m, src)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWithdrawVestedRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgWithdrawVestedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVestedRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawVestedRequest proto.InternalMessageInfo
+
+func (m *MsgWithdrawVestedRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateWill(ctx context.Context, in *MsgCreateWillRequest, opts ...grpc.CallOption) (*MsgCreateWillResponse, error) {
-	out := new(MsgCreateWillResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/CreateWill", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawVestedRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CheckIn(ctx context.Context, in *MsgCheckInRequest, opts ...grpc.CallOption) (*MsgCheckInResponse, error) {
-	out := new(MsgCheckInResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/CheckIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawVestedRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Claim(ctx context.Context, in *MsgClaimRequest, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
-	out := new(MsgClaimResponse)
-	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/Claim", in, out, opts...)
-	if err != nil {
-		return nil, err
+// response for withdrawing vested coins
+type MsgWithdrawVestedResponse struct {
+	// coins paid out to the beneficiary
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawVestedResponse) Reset()         { *m = MsgWithdrawVestedResponse{} }
+func (m *MsgWithdrawVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVestedResponse) ProtoMessage()    {}
+func (*MsgWithdrawVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{13}
+}
+
+func (m *MsgWithdrawVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgWithdrawVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// create a new will
-	CreateWill(context.Context, *MsgCreateWillRequest) (*MsgCreateWillResponse, error)
-	// checkin into a will
-	CheckIn(context.Context, *MsgCheckInRequest) (*MsgCheckInResponse, error)
-	// make a claim
-	Claim(context.Context, *MsgClaimRequest) (*MsgClaimResponse, error)
+func (m *MsgWithdrawVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVestedResponse.Merge(m, src)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct{}
+func (m *MsgWithdrawVestedResponse) XXX_Size() int {
+	return m.Size()
+}
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgWithdrawVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVestedResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) CreateWill(ctx context.Context, req *MsgCreateWillRequest) (*MsgCreateWillResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWill not implemented")
+var xxx_messageInfo_MsgWithdrawVestedResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawVestedResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) CheckIn(ctx context.Context, req *MsgCheckInRequest) (*MsgCheckInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+// message for executing a will that is due, anyone can submit it
+type MsgExecuteWillRequest struct {
+	// executor pays the gas of the will's components and receives the bounty
+	Executor string `protobuf:"bytes,1,opt,name=executor,proto3" json:"executor,omitempty"`
+	WillId   string `protobuf:"bytes,2,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaimRequest) (*MsgClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+func (m *MsgExecuteWillRequest) Reset()         { *m = MsgExecuteWillRequest{} }
+func (m *MsgExecuteWillRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteWillRequest) ProtoMessage()    {}
+func (*MsgExecuteWillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{14}
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgExecuteWillRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgExecuteWillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteWillRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateWillRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateWill(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Msg/CreateWill",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateWill(ctx, req.(*MsgCreateWillRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgExecuteWillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteWillRequest.Merge(m, src)
 }
 
-func _Msg_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCheckInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CheckIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Msg/CheckIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CheckIn(ctx, req.(*MsgCheckInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgExecuteWillRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Claim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmwasm.will.Msg/Claim",
+func (m *MsgExecuteWillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteWillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteWillRequest proto.InternalMessageInfo
+
+func (m *MsgExecuteWillRequest) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Claim(ctx, req.(*MsgClaimRequest))
+	return ""
+}
+
+func (m *MsgExecuteWillRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmwasm.will.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateWill",
-			Handler:    _Msg_CreateWill_Handler,
-		},
-		{
-			MethodName: "CheckIn",
-			Handler:    _Msg_CheckIn_Handler,
-		},
-		{
-			MethodName: "Claim",
-			Handler:    _Msg_Claim_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmwasm/will/tx.proto",
+// response for executing a will
+type MsgExecuteWillResponse struct {
+	// bounty paid to the executor out of the will's creation deposit
+	Bounty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=bounty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bounty"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExecuteWillResponse) Reset()         { *m = MsgExecuteWillResponse{} }
+func (m *MsgExecuteWillResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteWillResponse) ProtoMessage()    {}
+func (*MsgExecuteWillResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{15}
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecuteWillResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgExecuteWillResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteWillResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgExecuteWillResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteWillResponse.Merge(m, src)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExecuteWillResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExecuteWillResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteWillResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateWillRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExecuteWillResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteWillResponse) GetBounty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bounty
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateWillRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// message for sending a will packet over a channel of the will port, the
+// packet acts for the sender on the other chain
+type MsgSendWillPacketRequest struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// channel of the will port to send the packet over
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// JSON encoded will packet data holding a check-in, claim or status query,
+	// its sender is set to the sender of the message
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgCreateWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Components) > 0 {
-		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSendWillPacketRequest) Reset()         { *m = MsgSendWillPacketRequest{} }
+func (m *MsgSendWillPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSendWillPacketRequest) ProtoMessage()    {}
+func (*MsgSendWillPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{16}
+}
+
+func (m *MsgSendWillPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSendWillPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendWillPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Beneficiary) > 0 {
-		i -= len(m.Beneficiary)
-		copy(dAtA[i:], m.Beneficiary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateWillResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSendWillPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendWillPacketRequest.Merge(m, src)
 }
 
-func (m *MsgCreateWillResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendWillPacketRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MsgCreateWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Beneficiary) > 0 {
-		i -= len(m.Beneficiary)
-		copy(dAtA[i:], m.Beneficiary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSendWillPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendWillPacketRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCheckInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendWillPacketRequest proto.InternalMessageInfo
+
+func (m *MsgSendWillPacketRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCheckInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendWillPacketRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
 
-func (m *MsgCheckInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSendWillPacketRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCheckInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// response for sending a will packet
+type MsgSendWillPacketResponse struct {
+	// sequence of the packet on its channel
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgCheckInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendWillPacketResponse) Reset()         { *m = MsgSendWillPacketResponse{} }
+func (m *MsgSendWillPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendWillPacketResponse) ProtoMessage()    {}
+func (*MsgSendWillPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{17}
 }
 
-func (m *MsgCheckInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSendWillPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSendWillPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendWillPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSendWillPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendWillPacketResponse.Merge(m, src)
 }
 
-func (m *MsgClaimRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendWillPacketResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MsgClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClaimType != nil {
-		{
-			size := m.ClaimType.Size()
-			i -= size
-			if _, err := m.ClaimType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.ComponentId) > 0 {
-		i -= len(m.ComponentId)
-		copy(dAtA[i:], m.ComponentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Claimer) > 0 {
-		i -= len(m.Claimer)
-		copy(dAtA[i:], m.Claimer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.WillId) > 0 {
-		i -= len(m.WillId)
-		copy(dAtA[i:], m.WillId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WillId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSendWillPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendWillPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendWillPacketResponse proto.InternalMessageInfo
+
+func (m *MsgSendWillPacketResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClaimRequest_SchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// claims
+type MsgClaimRequest struct {
+	// ID of the will being claimed
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	// Claimer's address
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// component id
+	ComponentId string `protobuf:"bytes,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// OneOf claim data that contains the specific claim information based on the
+	// will's execution component
+	//
+	// Types that are valid to be assigned to ClaimType:
+	//	*MsgClaimRequest_SchnorrClaim
+	//	*MsgClaimRequest_PedersenClaim
+	//	*MsgClaimRequest_GnarkClaim
+	//	*MsgClaimRequest_CustomClaim
+	//	*MsgClaimRequest_ThresholdSchnorrClaim
+	ClaimType isMsgClaimRequest_ClaimType `protobuf_oneof:"claim_type"`
 }
 
-func (m *MsgClaimRequest_SchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SchnorrClaim != nil {
-		{
-			size, err := m.SchnorrClaim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func (m *MsgClaimRequest) Reset()         { *m = MsgClaimRequest{} }
+func (m *MsgClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRequest) ProtoMessage()    {}
+func (*MsgClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{18}
 }
 
-func (m *MsgClaimRequest_PedersenClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *MsgClaimRequest_PedersenClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PedersenClaim != nil {
-		{
-			size, err := m.PedersenClaim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRequest_GnarkClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRequest.Merge(m, src)
 }
 
-func (m *MsgClaimRequest_GnarkClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.GnarkClaim != nil {
-		{
-			size, err := m.GnarkClaim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+func (m *MsgClaimRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *SchnorrClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRequest.DiscardUnknown(m)
 }
 
-func (m *SchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimRequest proto.InternalMessageInfo
+
+type isMsgClaimRequest_ClaimType interface {
+	isMsgClaimRequest_ClaimType()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *SchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x1a
+type MsgClaimRequest_SchnorrClaim struct {
+	SchnorrClaim *SchnorrClaim `protobuf:"bytes,4,opt,name=schnorr_claim,json=schnorrClaim,proto3,oneof" json:"schnorr_claim,omitempty"`
+}
+type MsgClaimRequest_PedersenClaim struct {
+	PedersenClaim *PedersenClaim `protobuf:"bytes,5,opt,name=pedersen_claim,json=pedersenClaim,proto3,oneof" json:"pedersen_claim,omitempty"`
+}
+type MsgClaimRequest_GnarkClaim struct {
+	GnarkClaim *GnarkClaim `protobuf:"bytes,6,opt,name=gnark_claim,json=gnarkClaim,proto3,oneof" json:"gnark_claim,omitempty"`
+}
+type MsgClaimRequest_CustomClaim struct {
+	CustomClaim *CustomClaim `protobuf:"bytes,7,opt,name=custom_claim,json=customClaim,proto3,oneof" json:"custom_claim,omitempty"`
+}
+type MsgClaimRequest_ThresholdSchnorrClaim struct {
+	ThresholdSchnorrClaim *ThresholdSchnorrClaim `protobuf:"bytes,8,opt,name=threshold_schnorr_claim,json=thresholdSchnorrClaim,proto3,oneof" json:"threshold_schnorr_claim,omitempty"`
+}
+
+func (*MsgClaimRequest_SchnorrClaim) isMsgClaimRequest_ClaimType()          {}
+func (*MsgClaimRequest_PedersenClaim) isMsgClaimRequest_ClaimType()         {}
+func (*MsgClaimRequest_GnarkClaim) isMsgClaimRequest_ClaimType()            {}
+func (*MsgClaimRequest_CustomClaim) isMsgClaimRequest_ClaimType()           {}
+func (*MsgClaimRequest_ThresholdSchnorrClaim) isMsgClaimRequest_ClaimType() {}
+
+func (m *MsgClaimRequest) GetClaimType() isMsgClaimRequest_ClaimType {
+	if m != nil {
+		return m.ClaimType
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *MsgClaimRequest) GetWillId() string {
+	if m != nil {
+		return m.WillId
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgClaimRequest) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PedersenClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRequest) GetComponentId() string {
+	if m != nil {
+		return m.ComponentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PedersenClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRequest) GetSchnorrClaim() *SchnorrClaim {
+	if x, ok := m.GetClaimType().(*MsgClaimRequest_SchnorrClaim); ok {
+		return x.SchnorrClaim
+	}
+	return nil
 }
 
-func (m *PedersenClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlindingFactor) > 0 {
-		i -= len(m.BlindingFactor)
-		copy(dAtA[i:], m.BlindingFactor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlindingFactor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClaimRequest) GetPedersenClaim() *PedersenClaim {
+	if x, ok := m.GetClaimType().(*MsgClaimRequest_PedersenClaim); ok {
+		return x.PedersenClaim
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GnarkClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRequest) GetGnarkClaim() *GnarkClaim {
+	if x, ok := m.GetClaimType().(*MsgClaimRequest_GnarkClaim); ok {
+		return x.GnarkClaim
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GnarkClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRequest) GetCustomClaim() *CustomClaim {
+	if x, ok := m.GetClaimType().(*MsgClaimRequest_CustomClaim); ok {
+		return x.CustomClaim
+	}
+	return nil
 }
 
-func (m *GnarkClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicInputs) > 0 {
-		i -= len(m.PublicInputs)
-		copy(dAtA[i:], m.PublicInputs)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicInputs)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClaimRequest) GetThresholdSchnorrClaim() *ThresholdSchnorrClaim {
+	if x, ok := m.GetClaimType().(*MsgClaimRequest_ThresholdSchnorrClaim); ok {
+		return x.ThresholdSchnorrClaim
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MsgClaimRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MsgClaimRequest_SchnorrClaim)(nil),
+		(*MsgClaimRequest_PedersenClaim)(nil),
+		(*MsgClaimRequest_GnarkClaim)(nil),
+		(*MsgClaimRequest_CustomClaim)(nil),
+		(*MsgClaimRequest_ThresholdSchnorrClaim)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SchnorrClaim is specifically structured for claims requiring a Schnorr
+// signature.
+type SchnorrClaim struct {
+	// Optional, when set it must be the public key the component commits to.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The 64 byte Schnorr signature (R || S) over the claim digest.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Unused, the signed message is the claim digest.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SchnorrClaim) Reset()         { *m = SchnorrClaim{} }
+func (m *SchnorrClaim) String() string { return proto.CompactTextString(m) }
+func (*SchnorrClaim) ProtoMessage()    {}
+func (*SchnorrClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{19}
+}
+
+func (m *SchnorrClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SchnorrClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchnorrClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *SchnorrClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchnorrClaim.Merge(m, src)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *SchnorrClaim) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *SchnorrClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchnorrClaim.DiscardUnknown(m)
 }
 
-func (m *MsgCreateWillRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Beneficiary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	if len(m.Components) > 0 {
-		for _, e := range m.Components {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+var xxx_messageInfo_SchnorrClaim proto.InternalMessageInfo
+
+func (m *SchnorrClaim) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCreateWillResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Beneficiary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+func (m *SchnorrClaim) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCheckInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+func (m *SchnorrClaim) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCheckInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	return n
+// ThresholdSchnorrClaim carries the approval of one or more guardians of a
+// threshold Schnorr component.
+type ThresholdSchnorrClaim struct {
+	// Strictly increasing indices of the approving guardians in the public keys
+	// of the component.
+	Signers []uint32 `protobuf:"varint,1,rep,packed,name=signers,proto3" json:"signers,omitempty"`
+	// The 64 byte MuSig aggregate signature (R || S) of the signers over the
+	// threshold claim digest, a single guardian signs for itself alone.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgClaimRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WillId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Claimer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ComponentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClaimType != nil {
-		n += m.ClaimType.Size()
-	}
-	return n
+func (m *ThresholdSchnorrClaim) Reset()         { *m = ThresholdSchnorrClaim{} }
+func (m *ThresholdSchnorrClaim) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSchnorrClaim) ProtoMessage()    {}
+func (*ThresholdSchnorrClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{20}
 }
 
-func (m *MsgClaimRequest_SchnorrClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SchnorrClaim != nil {
-		l = m.SchnorrClaim.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *ThresholdSchnorrClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *MsgClaimRequest_PedersenClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PedersenClaim != nil {
-		l = m.PedersenClaim.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *ThresholdSchnorrClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSchnorrClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *MsgClaimRequest_GnarkClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GnarkClaim != nil {
-		l = m.GnarkClaim.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *ThresholdSchnorrClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSchnorrClaim.Merge(m, src)
+}
+
+func (m *ThresholdSchnorrClaim) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ThresholdSchnorrClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSchnorrClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSchnorrClaim proto.InternalMessageInfo
+
+func (m *ThresholdSchnorrClaim) GetSigners() []uint32 {
+	if m != nil {
+		return m.Signers
 	}
-	return n
+	return nil
 }
 
-func (m *SchnorrClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ThresholdSchnorrClaim) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// pedersen
+type PedersenClaim struct {
+	// Unused, the claim opens the commitment of the component.
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The 32 byte little endian blinding factor scalar of the opening.
+	BlindingFactor []byte `protobuf:"bytes,2,opt,name=blinding_factor,json=blindingFactor,proto3" json:"blinding_factor,omitempty"`
+	// The 32 byte little endian value scalar the commitment was made to.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *PedersenClaim) Reset()         { *m = PedersenClaim{} }
+func (m *PedersenClaim) String() string { return proto.CompactTextString(m) }
+func (*PedersenClaim) ProtoMessage()    {}
+func (*PedersenClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{21}
+}
+
+func (m *PedersenClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PedersenClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PedersenClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+
+func (m *PedersenClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PedersenClaim.Merge(m, src)
+}
+
+func (m *PedersenClaim) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PedersenClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_PedersenClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PedersenClaim proto.InternalMessageInfo
+
+func (m *PedersenClaim) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *PedersenClaim) GetBlindingFactor() []byte {
+	if m != nil {
+		return m.BlindingFactor
 	}
-	return n
+	return nil
 }
 
-func (m *PedersenClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PedersenClaim) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	var l int
-	_ = l
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// gnark
+type GnarkClaim struct {
+	// Specific fields for Gnark zk-SNARK claims, e.g., proof, public inputs, etc.
+	Proof        []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	PublicInputs []byte `protobuf:"bytes,2,opt,name=public_inputs,json=publicInputs,proto3" json:"public_inputs,omitempty"`
+}
+
+func (m *GnarkClaim) Reset()         { *m = GnarkClaim{} }
+func (m *GnarkClaim) String() string { return proto.CompactTextString(m) }
+func (*GnarkClaim) ProtoMessage()    {}
+func (*GnarkClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{22}
+}
+
+func (m *GnarkClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GnarkClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnarkClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.BlindingFactor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+
+func (m *GnarkClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnarkClaim.Merge(m, src)
+}
+
+func (m *GnarkClaim) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GnarkClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnarkClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GnarkClaim proto.InternalMessageInfo
+
+func (m *GnarkClaim) GetProof() []byte {
+	if m != nil {
+		return m.Proof
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *GnarkClaim) GetPublicInputs() []byte {
+	if m != nil {
+		return m.PublicInputs
 	}
-	return n
+	return nil
 }
 
-func (m *GnarkClaim) Size() (n int) {
-	if m == nil {
-		return 0
+// CustomClaim is a claim on a component of a custom claim scheme
+type CustomClaim struct {
+	// Scheme specific claim data, e.g. a signature or a proof
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CustomClaim) Reset()         { *m = CustomClaim{} }
+func (m *CustomClaim) String() string { return proto.CompactTextString(m) }
+func (*CustomClaim) ProtoMessage()    {}
+func (*CustomClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{23}
+}
+
+func (m *CustomClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CustomClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+
+func (m *CustomClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomClaim.Merge(m, src)
+}
+
+func (m *CustomClaim) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CustomClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomClaim proto.InternalMessageInfo
+
+func (m *CustomClaim) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	l = len(m.PublicInputs)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgClaimResponse
+type MsgClaimResponse struct {
+	// Indicates whether the claim was successful or not
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Optional message providing more details on the claim result
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
+func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimResponse) ProtoMessage()    {}
+func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22e268a87ad78580, []int{24}
+}
+
+func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimResponse.Merge(m, src)
+}
+
+func (m *MsgClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
+
+func (m *MsgClaimResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgClaimResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmwasm.will.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmwasm.will.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateWillRequest)(nil), "cosmwasm.will.MsgCreateWillRequest")
+	proto.RegisterType((*MsgCreateWillResponse)(nil), "cosmwasm.will.MsgCreateWillResponse")
+	proto.RegisterType((*MsgCheckInRequest)(nil), "cosmwasm.will.MsgCheckInRequest")
+	proto.RegisterType((*MsgCheckInResponse)(nil), "cosmwasm.will.MsgCheckInResponse")
+	proto.RegisterType((*MsgFundWillRequest)(nil), "cosmwasm.will.MsgFundWillRequest")
+	proto.RegisterType((*MsgFundWillResponse)(nil), "cosmwasm.will.MsgFundWillResponse")
+	proto.RegisterType((*MsgUpdateWillRequest)(nil), "cosmwasm.will.MsgUpdateWillRequest")
+	proto.RegisterType((*MsgUpdateWillResponse)(nil), "cosmwasm.will.MsgUpdateWillResponse")
+	proto.RegisterType((*MsgCancelWillRequest)(nil), "cosmwasm.will.MsgCancelWillRequest")
+	proto.RegisterType((*MsgCancelWillResponse)(nil), "cosmwasm.will.MsgCancelWillResponse")
+	proto.RegisterType((*MsgWithdrawVestedRequest)(nil), "cosmwasm.will.MsgWithdrawVestedRequest")
+	proto.RegisterType((*MsgWithdrawVestedResponse)(nil), "cosmwasm.will.MsgWithdrawVestedResponse")
+	proto.RegisterType((*MsgExecuteWillRequest)(nil), "cosmwasm.will.MsgExecuteWillRequest")
+	proto.RegisterType((*MsgExecuteWillResponse)(nil), "cosmwasm.will.MsgExecuteWillResponse")
+	proto.RegisterType((*MsgSendWillPacketRequest)(nil), "cosmwasm.will.MsgSendWillPacketRequest")
+	proto.RegisterType((*MsgSendWillPacketResponse)(nil), "cosmwasm.will.MsgSendWillPacketResponse")
+	proto.RegisterType((*MsgClaimRequest)(nil), "cosmwasm.will.MsgClaimRequest")
+	proto.RegisterType((*SchnorrClaim)(nil), "cosmwasm.will.SchnorrClaim")
+	proto.RegisterType((*ThresholdSchnorrClaim)(nil), "cosmwasm.will.ThresholdSchnorrClaim")
+	proto.RegisterType((*PedersenClaim)(nil), "cosmwasm.will.PedersenClaim")
+	proto.RegisterType((*GnarkClaim)(nil), "cosmwasm.will.GnarkClaim")
+	proto.RegisterType((*CustomClaim)(nil), "cosmwasm.will.CustomClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "cosmwasm.will.MsgClaimResponse")
+}
+
+func init() { proto.RegisterFile("cosmwasm/will/tx.proto", fileDescriptor_22e268a87ad78580) }
+
+var fileDescriptor_22e268a87ad78580 = []byte{
+	// 1691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0x25, 0x59, 0xb6, 0x46, 0xb2, 0x93, 0x4c, 0xfc, 0x90, 0x79, 0x7d, 0x65, 0x99, 0x71,
+	0x12, 0x21, 0x17, 0x91, 0x6e, 0x7c, 0x6f, 0xda, 0x42, 0x28, 0xd0, 0x46, 0x6a, 0x1e, 0x46, 0xe1,
+	0x36, 0x65, 0xd2, 0x18, 0xc8, 0xa2, 0x02, 0x45, 0x8e, 0x29, 0xc2, 0x22, 0xa9, 0x72, 0x86, 0x76,
+	0xbc, 0x6a, 0x1b, 0xa0, 0x05, 0xda, 0x45, 0x91, 0x5d, 0xfb, 0x0b, 0x8a, 0xa2, 0xab, 0x2c, 0x82,
+	0xfe, 0x86, 0x2c, 0x83, 0xa2, 0x8b, 0xa2, 0x8b, 0xa6, 0x48, 0x16, 0xfe, 0x1b, 0xc5, 0x3c, 0x28,
+	0x3e, 0x44, 0xcb, 0x86, 0x51, 0xa3, 0x1b, 0x9b, 0xe7, 0x31, 0x67, 0xce, 0x7c, 0xf3, 0xcd, 0x99,
+	0x33, 0x02, 0x0b, 0xba, 0x8b, 0xed, 0x3d, 0x0d, 0xdb, 0x8d, 0x3d, 0xab, 0xdf, 0x6f, 0x90, 0x47,
+	0xf5, 0x81, 0xe7, 0x12, 0x17, 0xce, 0x04, 0xfa, 0x3a, 0xd5, 0xcb, 0xe7, 0x34, 0xdb, 0x72, 0xdc,
+	0x06, 0xfb, 0xcb, 0x3d, 0xe4, 0x45, 0xea, 0xe1, 0xe2, 0x86, 0x8d, 0xcd, 0xc6, 0xee, 0x35, 0xfa,
+	0x4f, 0x18, 0x96, 0xb8, 0xa1, 0xc3, 0xa4, 0x06, 0x17, 0x84, 0x69, 0xce, 0x74, 0x4d, 0x97, 0xeb,
+	0xe9, 0x97, 0xd0, 0x56, 0x44, 0xa4, 0xae, 0x86, 0x51, 0x63, 0xf7, 0x5a, 0x17, 0x11, 0xed, 0x5a,
+	0x43, 0x77, 0x2d, 0x27, 0xb0, 0x9b, 0xae, 0x6b, 0xf6, 0x51, 0x83, 0x49, 0x5d, 0x7f, 0xbb, 0x61,
+	0xf8, 0x9e, 0x46, 0x2c, 0x37, 0xb0, 0xaf, 0x24, 0xed, 0xc4, 0xb2, 0x11, 0x26, 0x9a, 0x3d, 0x10,
+	0x0e, 0x72, 0x7c, 0x91, 0x03, 0xcd, 0xd3, 0x6c, 0x1c, 0xcd, 0x36, 0xb4, 0x91, 0xfd, 0x01, 0x12,
+	0x26, 0xe5, 0x99, 0x04, 0xce, 0x6c, 0x62, 0xf3, 0xe3, 0x81, 0xa1, 0x11, 0x74, 0x97, 0x0d, 0x82,
+	0x6f, 0x80, 0x82, 0xe6, 0x93, 0x9e, 0xeb, 0x59, 0x64, 0xbf, 0x2c, 0x55, 0xa5, 0x5a, 0xa1, 0x55,
+	0xfe, 0xe5, 0xd9, 0xd5, 0x39, 0xb1, 0xcc, 0x1b, 0x86, 0xe1, 0x21, 0x8c, 0xef, 0x11, 0xcf, 0x72,
+	0x4c, 0x35, 0x74, 0x85, 0x6f, 0x81, 0x3c, 0x9f, 0xb6, 0x9c, 0xa9, 0x4a, 0xb5, 0xe2, 0xfa, 0x7c,
+	0x3d, 0x06, 0x70, 0x9d, 0x87, 0x6f, 0x15, 0x9e, 0xff, 0xb1, 0x32, 0xf1, 0xe3, 0xc1, 0xd3, 0x2b,
+	0x92, 0x2a, 0xfc, 0x9b, 0x8d, 0xc7, 0x07, 0x4f, 0xaf, 0x84, 0x91, 0xbe, 0x39, 0x78, 0x7a, 0x65,
+	0x99, 0x8e, 0x33, 0x1a, 0x8f, 0x78, 0xca, 0x89, 0x14, 0x95, 0x25, 0xb0, 0x98, 0x50, 0xa9, 0x08,
+	0x0f, 0x5c, 0x07, 0x23, 0xe5, 0x20, 0x07, 0xe6, 0x36, 0xb1, 0xd9, 0xf6, 0x90, 0x46, 0xd0, 0x96,
+	0xd5, 0xef, 0xab, 0xe8, 0x53, 0x1f, 0x61, 0x02, 0xcb, 0x60, 0x4a, 0xa7, 0x4a, 0xd7, 0xe3, 0x8b,
+	0x52, 0x03, 0x11, 0x42, 0x90, 0x73, 0x34, 0x1b, 0xb1, 0xb4, 0x0b, 0x2a, 0xfb, 0x86, 0x55, 0x50,
+	0xec, 0x22, 0x07, 0x6d, 0x5b, 0xba, 0xa5, 0x79, 0xfb, 0xe5, 0x2c, 0x33, 0x45, 0x55, 0x70, 0x01,
+	0xe4, 0x7b, 0xc8, 0x32, 0x7b, 0xa4, 0x9c, 0xab, 0x4a, 0xb5, 0xac, 0x2a, 0x24, 0x78, 0x03, 0x00,
+	0xdd, 0xb5, 0x07, 0xae, 0x83, 0x1c, 0x82, 0xcb, 0x93, 0xd5, 0x6c, 0xad, 0xb8, 0xbe, 0x9a, 0x80,
+	0xe2, 0xe6, 0x23, 0xa4, 0xfb, 0x74, 0x7b, 0xdb, 0x81, 0xa7, 0x1a, 0x19, 0x04, 0xff, 0x03, 0xce,
+	0x59, 0x8e, 0xa6, 0x13, 0x6b, 0xd7, 0x22, 0xfb, 0x9d, 0x3d, 0xcb, 0x31, 0xdc, 0xbd, 0x72, 0x9e,
+	0xcd, 0x72, 0x36, 0x34, 0x6c, 0x31, 0x3d, 0x6c, 0x83, 0x12, 0xf1, 0x2c, 0xd3, 0x44, 0x5e, 0x87,
+	0x92, 0xa2, 0x3c, 0xc5, 0xc0, 0x97, 0xeb, 0x9c, 0x31, 0xf5, 0x80, 0x31, 0xf5, 0xfb, 0x01, 0x63,
+	0x5a, 0xb9, 0x27, 0x2f, 0x57, 0x24, 0xb5, 0x28, 0x46, 0x51, 0x3d, 0xbc, 0x0f, 0xce, 0x47, 0x66,
+	0x0c, 0xc8, 0x57, 0x9e, 0x66, 0xb1, 0x96, 0x46, 0x62, 0xbd, 0x27, 0x1c, 0x5a, 0xd3, 0x74, 0x33,
+	0xbf, 0xa7, 0xe1, 0x60, 0x38, 0x3e, 0xb0, 0xc2, 0x26, 0x28, 0xe8, 0xae, 0x63, 0x58, 0x2c, 0x56,
+	0x81, 0xc5, 0x5a, 0x4e, 0x20, 0xb1, 0xa5, 0x61, 0xbb, 0x1d, 0xf8, 0xa8, 0xa1, 0x3b, 0x6c, 0x83,
+	0x59, 0x14, 0xa0, 0xd4, 0xb1, 0x5d, 0x03, 0x95, 0x41, 0x55, 0xaa, 0xcd, 0xae, 0x2f, 0x1f, 0x06,
+	0xe5, 0xa6, 0x6b, 0x20, 0x75, 0x06, 0x45, 0x45, 0x78, 0x19, 0x9c, 0xf1, 0x90, 0xed, 0x12, 0xd4,
+	0xd1, 0x7b, 0x9a, 0xe3, 0xa0, 0x3e, 0x2e, 0x17, 0xab, 0xd9, 0x5a, 0x41, 0x9d, 0xe5, 0xea, 0xb6,
+	0xd0, 0x36, 0xd7, 0x29, 0x03, 0x03, 0x42, 0x50, 0xfe, 0xad, 0x26, 0xf9, 0x37, 0x42, 0x28, 0xe5,
+	0x57, 0x09, 0xcc, 0x27, 0x0c, 0x9c, 0x83, 0x70, 0x16, 0x64, 0x2c, 0x43, 0xb0, 0x2c, 0x63, 0x19,
+	0x51, 0xea, 0x65, 0xd2, 0xa9, 0x97, 0x3d, 0x9c, 0x7a, 0xb9, 0x71, 0xd4, 0x9b, 0x8c, 0x51, 0x2f,
+	0x49, 0x85, 0xfc, 0x09, 0xa8, 0xa0, 0x7c, 0x25, 0x81, 0x73, 0x74, 0x59, 0x3d, 0xa4, 0xef, 0x6c,
+	0x38, 0x47, 0x9f, 0x1e, 0xbe, 0xd8, 0xcc, 0x70, 0xb1, 0x61, 0x72, 0xd9, 0x68, 0x72, 0xfc, 0x90,
+	0x47, 0x21, 0xae, 0x8c, 0x40, 0x1c, 0x9b, 0x52, 0xf9, 0x5a, 0x02, 0x30, 0xaa, 0x15, 0xe0, 0x2e,
+	0x80, 0x3c, 0x26, 0x1a, 0xf1, 0x31, 0x4b, 0x64, 0x5a, 0x15, 0x52, 0x64, 0xde, 0xcc, 0x58, 0x50,
+	0xb2, 0x27, 0x01, 0xe5, 0x77, 0x9e, 0xcb, 0x2d, 0xdf, 0x31, 0x8e, 0x57, 0x53, 0x92, 0xa8, 0xf4,
+	0x40, 0x5e, 0xb3, 0x5d, 0xdf, 0xa1, 0xa8, 0x64, 0xd9, 0x99, 0x12, 0xe5, 0x94, 0xde, 0x08, 0x75,
+	0x71, 0x23, 0xd4, 0xdb, 0xae, 0xe5, 0xb4, 0xae, 0xd3, 0x33, 0xf5, 0xd3, 0xcb, 0x95, 0x9a, 0x69,
+	0x91, 0x9e, 0xdf, 0xad, 0xeb, 0xae, 0x2d, 0xae, 0x18, 0xf1, 0xef, 0x2a, 0x36, 0x76, 0x44, 0x15,
+	0xa7, 0x03, 0xb0, 0x28, 0xa6, 0x3c, 0x7e, 0xf3, 0xbf, 0x49, 0x9c, 0x57, 0x92, 0x38, 0x27, 0x56,
+	0xa1, 0x7c, 0x06, 0xce, 0xc7, 0xb4, 0x02, 0xe8, 0x1e, 0xc8, 0x23, 0xac, 0x7b, 0xee, 0x5e, 0x59,
+	0x3a, 0xad, 0x94, 0x79, 0x7c, 0xe5, 0xe7, 0x0c, 0x98, 0x1b, 0xd6, 0xf3, 0x93, 0xe1, 0xfb, 0x8f,
+	0xd6, 0xeb, 0xbf, 0xe3, 0xdc, 0x1d, 0xa3, 0x04, 0x8d, 0xe0, 0xa3, 0xfc, 0xc0, 0x4b, 0x50, 0xd4,
+	0x70, 0x48, 0x09, 0x4a, 0xe0, 0x93, 0x19, 0x87, 0x4f, 0x76, 0xec, 0xf9, 0xc9, 0x9d, 0xe4, 0xfc,
+	0x10, 0x7e, 0x29, 0x6b, 0x8e, 0x8e, 0xfa, 0x27, 0xda, 0xe0, 0xe3, 0x54, 0xe8, 0x64, 0x74, 0xe5,
+	0x0b, 0x51, 0xa1, 0x23, 0x86, 0x90, 0xdb, 0x1e, 0xda, 0xf6, 0x1d, 0xe3, 0xf4, 0xb8, 0xcd, 0xe3,
+	0xd3, 0x0e, 0xab, 0xbc, 0x89, 0xcd, 0x2d, 0x8b, 0xf4, 0x0c, 0x4f, 0xdb, 0x7b, 0x80, 0x30, 0x41,
+	0x46, 0xb0, 0xfc, 0xc4, 0xae, 0x48, 0xa3, 0xbb, 0xb2, 0x08, 0xa6, 0xe8, 0xfa, 0x3a, 0x43, 0x2c,
+	0xf2, 0x54, 0xdc, 0x30, 0xe0, 0x2a, 0x28, 0x0d, 0x19, 0x48, 0xad, 0x82, 0xf1, 0x43, 0xdd, 0x86,
+	0xd1, 0x6c, 0x52, 0xc8, 0xa2, 0xd1, 0x28, 0x6c, 0x17, 0x93, 0xb0, 0xa5, 0x66, 0xa6, 0x7c, 0x29,
+	0x81, 0xa5, 0x14, 0x63, 0x08, 0x9f, 0xa8, 0x66, 0xd2, 0xe9, 0x56, 0x33, 0xe5, 0x31, 0xdf, 0x42,
+	0x7e, 0x00, 0x63, 0xb5, 0x41, 0x06, 0xd3, 0xfc, 0xb2, 0x1f, 0x72, 0x67, 0x28, 0x1f, 0x8a, 0x5a,
+	0xf3, 0xff, 0x14, 0x92, 0xa1, 0x1f, 0xc5, 0x43, 0x49, 0xe2, 0x31, 0x3a, 0x15, 0x4d, 0x62, 0x21,
+	0x69, 0x09, 0x91, 0xe8, 0xd2, 0x44, 0xf7, 0x4f, 0x0f, 0x09, 0x1e, 0x5f, 0xf9, 0x8e, 0x13, 0xe9,
+	0x1e, 0xe2, 0x65, 0xfa, 0xae, 0xa6, 0xef, 0x20, 0x12, 0x80, 0x41, 0x2f, 0x45, 0xe4, 0x18, 0x28,
+	0x80, 0x42, 0x48, 0xec, 0x7c, 0xf1, 0x1e, 0x67, 0xd8, 0x79, 0x70, 0x91, 0x76, 0x1e, 0x86, 0x46,
+	0x34, 0xc6, 0x9b, 0x92, 0xca, 0xbe, 0x9b, 0xd7, 0x29, 0x3a, 0x62, 0x68, 0x2a, 0x57, 0x52, 0x27,
+	0x57, 0xde, 0x04, 0x4b, 0x29, 0x36, 0x01, 0x90, 0x0c, 0xa6, 0x31, 0xf5, 0x73, 0x74, 0xc4, 0x72,
+	0xcb, 0xa9, 0x43, 0x59, 0xf9, 0x36, 0xc7, 0x5e, 0x1f, 0xed, 0xbe, 0x66, 0xd9, 0xc1, 0x4a, 0x22,
+	0x5b, 0x27, 0xc5, 0x08, 0x4f, 0x97, 0x42, 0x1d, 0x51, 0xd8, 0x44, 0x71, 0xf1, 0x18, 0x47, 0x01,
+	0xb6, 0xc0, 0x0c, 0xd6, 0x7b, 0x8e, 0xeb, 0x79, 0x1d, 0x36, 0x4a, 0x54, 0xb1, 0x7f, 0x25, 0xea,
+	0xfc, 0x3d, 0xee, 0xc3, 0x12, 0xba, 0x33, 0xa1, 0x96, 0x70, 0x44, 0x86, 0x37, 0xc1, 0xec, 0x00,
+	0x19, 0xc8, 0xc3, 0xc8, 0x11, 0x41, 0x26, 0x53, 0x5b, 0xda, 0xbb, 0xc2, 0x29, 0x88, 0x32, 0x33,
+	0x88, 0x2a, 0xe0, 0xdb, 0xa0, 0x68, 0x3a, 0x9a, 0xb7, 0x23, 0x62, 0xe4, 0x45, 0x8b, 0x1d, 0x8f,
+	0x71, 0x9b, 0x7a, 0x04, 0x01, 0x80, 0x39, 0x94, 0xe0, 0x3b, 0xa0, 0xa4, 0xfb, 0x98, 0xb8, 0xb6,
+	0x18, 0x1e, 0x74, 0xfb, 0xf1, 0xe1, 0x6d, 0xe6, 0x12, 0x8c, 0x2f, 0xea, 0xa1, 0x08, 0x3f, 0x01,
+	0x8b, 0xa4, 0xe7, 0x21, 0xdc, 0x73, 0xfb, 0x46, 0x27, 0x8e, 0x09, 0xef, 0xf6, 0xd7, 0x12, 0xb1,
+	0xee, 0x07, 0xde, 0x09, 0x70, 0xe6, 0x49, 0x9a, 0xa1, 0x79, 0x95, 0xd7, 0x69, 0xbe, 0x35, 0xa9,
+	0x2f, 0xb9, 0xe8, 0x76, 0xb7, 0x4a, 0x00, 0x30, 0xdf, 0x0e, 0xe5, 0xbd, 0x82, 0x40, 0x29, 0x1a,
+	0x0c, 0xfe, 0x1b, 0x80, 0x81, 0xdf, 0xed, 0x5b, 0x7a, 0x67, 0x07, 0xf1, 0xf2, 0x58, 0x52, 0x0b,
+	0x5c, 0xf3, 0x3e, 0xda, 0x87, 0xcb, 0xa0, 0x80, 0x2d, 0xd3, 0xd1, 0x88, 0xef, 0xf1, 0xd7, 0x5b,
+	0x49, 0x0d, 0x15, 0x94, 0x30, 0x36, 0xc2, 0x58, 0x33, 0x83, 0xf6, 0x3a, 0x10, 0x95, 0x0f, 0xc1,
+	0x7c, 0xea, 0xaa, 0xe8, 0x10, 0x3a, 0x1e, 0x79, 0x98, 0x1d, 0xe7, 0x19, 0x35, 0x10, 0xc7, 0x4f,
+	0xa5, 0x38, 0x60, 0x26, 0xb6, 0xeb, 0xb0, 0xc2, 0x9a, 0x0a, 0xdb, 0x22, 0x36, 0x62, 0x45, 0x92,
+	0xfa, 0x47, 0x34, 0xf4, 0x61, 0xd2, 0xed, 0x5b, 0x8e, 0x61, 0x39, 0x66, 0x67, 0x5b, 0xd3, 0x83,
+	0x97, 0x41, 0x49, 0x9d, 0x0d, 0xd4, 0xb7, 0x98, 0x16, 0xce, 0x81, 0xc9, 0x5d, 0xad, 0xef, 0x23,
+	0x71, 0x4e, 0xb9, 0xa0, 0xdc, 0x06, 0x20, 0x64, 0x08, 0xf5, 0x19, 0x78, 0xae, 0xbb, 0x2d, 0xe6,
+	0xe1, 0x02, 0xbc, 0x00, 0x66, 0x04, 0x76, 0x96, 0x33, 0xf0, 0x09, 0x16, 0x13, 0x94, 0xb8, 0x72,
+	0x83, 0xe9, 0x94, 0x55, 0x50, 0x8c, 0x70, 0x65, 0x58, 0x14, 0xa4, 0xb0, 0x28, 0x28, 0xb7, 0xc0,
+	0xd9, 0x70, 0xd3, 0xc4, 0xa1, 0xa6, 0x38, 0xf9, 0xba, 0x8e, 0x70, 0xd0, 0x84, 0x07, 0x62, 0x14,
+	0xf4, 0x4c, 0x0c, 0xf4, 0xf5, 0xc7, 0x53, 0x20, 0xbb, 0x89, 0x4d, 0xf8, 0x00, 0x94, 0x62, 0x3f,
+	0x37, 0x54, 0x12, 0x7c, 0x4b, 0x3c, 0xec, 0xe5, 0x4b, 0xe3, 0xed, 0xc3, 0x9c, 0xb6, 0x00, 0x08,
+	0x9f, 0x62, 0xf0, 0xc2, 0xe8, 0xa8, 0x91, 0x17, 0x9c, 0xbc, 0x36, 0xde, 0x49, 0x04, 0xfe, 0x00,
+	0x4c, 0x89, 0x37, 0x08, 0xac, 0xa6, 0x0c, 0x88, 0x3d, 0x5a, 0xe4, 0xd5, 0x31, 0x1e, 0x22, 0xde,
+	0x1d, 0x30, 0x29, 0x48, 0x92, 0xe2, 0x1b, 0x39, 0x1b, 0xf2, 0xca, 0xa1, 0x76, 0x11, 0xe9, 0x23,
+	0x30, 0x1d, 0x74, 0xed, 0x30, 0x65, 0xe2, 0x44, 0x9f, 0x2f, 0x2b, 0xe3, 0x5c, 0x42, 0x14, 0xc3,
+	0x6e, 0x32, 0x0d, 0xc5, 0x91, 0x26, 0x54, 0x5e, 0x1b, 0xef, 0x14, 0xd9, 0x9e, 0x61, 0x1f, 0x96,
+	0xba, 0x3d, 0xc9, 0xf6, 0x4d, 0x5e, 0x1b, 0xef, 0x24, 0x02, 0xeb, 0x60, 0x36, 0xde, 0xa5, 0xc0,
+	0xcb, 0xa3, 0xe3, 0x52, 0x9b, 0x1c, 0xb9, 0x76, 0xb4, 0xa3, 0x98, 0xe4, 0x21, 0x28, 0x46, 0x6e,
+	0x7f, 0x98, 0x92, 0xd9, 0x68, 0xdb, 0x20, 0x5f, 0x3c, 0xc2, 0x2b, 0x5c, 0x40, 0xfc, 0xee, 0x4c,
+	0x5b, 0x40, 0xea, 0xcd, 0x2b, 0xd7, 0x8e, 0x76, 0xe4, 0x93, 0xc8, 0x93, 0x9f, 0xd3, 0x66, 0xa2,
+	0xf5, 0xee, 0xf3, 0x57, 0x15, 0xe9, 0xc5, 0xab, 0x8a, 0xf4, 0xe7, 0xab, 0x8a, 0xf4, 0xe4, 0x75,
+	0x65, 0xe2, 0xc5, 0xeb, 0xca, 0xc4, 0x6f, 0xaf, 0x2b, 0x13, 0x0f, 0x2f, 0x45, 0xba, 0x92, 0xb6,
+	0x8b, 0xed, 0x2d, 0xf6, 0x7b, 0x61, 0xb4, 0x74, 0xb3, 0xce, 0xa4, 0x9b, 0x67, 0x0d, 0xff, 0xff,
+	0xfe, 0x1a, 0x00, 0x1d, 0xaa, 0xdd, 0x28, 0x56, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// create a new will
+	CreateWill(ctx context.Context, in *MsgCreateWillRequest, opts ...grpc.CallOption) (*MsgCreateWillResponse, error)
+	// checkin into a will
+	CheckIn(ctx context.Context, in *MsgCheckInRequest, opts ...grpc.CallOption) (*MsgCheckInResponse, error)
+	// make a claim
+	Claim(ctx context.Context, in *MsgClaimRequest, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	// top up the escrow of a will
+	FundWill(ctx context.Context, in *MsgFundWillRequest, opts ...grpc.CallOption) (*MsgFundWillResponse, error)
+	// update a live will
+	UpdateWill(ctx context.Context, in *MsgUpdateWillRequest, opts ...grpc.CallOption) (*MsgUpdateWillResponse, error)
+	// cancel a live will and release its escrow
+	CancelWill(ctx context.Context, in *MsgCancelWillRequest, opts ...grpc.CallOption) (*MsgCancelWillResponse, error)
+	// withdraw the coins that vested from a vesting component
+	WithdrawVested(ctx context.Context, in *MsgWithdrawVestedRequest, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error)
+	// execute a due will for a bounty
+	ExecuteWill(ctx context.Context, in *MsgExecuteWillRequest, opts ...grpc.CallOption) (*MsgExecuteWillResponse, error)
+	// send a check-in, claim or status query to another chain over the will port
+	SendWillPacket(ctx context.Context, in *MsgSendWillPacketRequest, opts ...grpc.CallOption) (*MsgSendWillPacketResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateWill(ctx context.Context, in *MsgCreateWillRequest, opts ...grpc.CallOption) (*MsgCreateWillResponse, error) {
+	out := new(MsgCreateWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/CreateWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CheckIn(ctx context.Context, in *MsgCheckInRequest, opts ...grpc.CallOption) (*MsgCheckInResponse, error) {
+	out := new(MsgCheckInResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaimRequest, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundWill(ctx context.Context, in *MsgFundWillRequest, opts ...grpc.CallOption) (*MsgFundWillResponse, error) {
+	out := new(MsgFundWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/FundWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWill(ctx context.Context, in *MsgUpdateWillRequest, opts ...grpc.CallOption) (*MsgUpdateWillResponse, error) {
+	out := new(MsgUpdateWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/UpdateWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWill(ctx context.Context, in *MsgCancelWillRequest, opts ...grpc.CallOption) (*MsgCancelWillResponse, error) {
+	out := new(MsgCancelWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/CancelWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawVested(ctx context.Context, in *MsgWithdrawVestedRequest, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error) {
+	out := new(MsgWithdrawVestedResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/WithdrawVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteWill(ctx context.Context, in *MsgExecuteWillRequest, opts ...grpc.CallOption) (*MsgExecuteWillResponse, error) {
+	out := new(MsgExecuteWillResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/ExecuteWill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendWillPacket(ctx context.Context, in *MsgSendWillPacketRequest, opts ...grpc.CallOption) (*MsgSendWillPacketResponse, error) {
+	out := new(MsgSendWillPacketResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.will.Msg/SendWillPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// create a new will
+	CreateWill(context.Context, *MsgCreateWillRequest) (*MsgCreateWillResponse, error)
+	// checkin into a will
+	CheckIn(context.Context, *MsgCheckInRequest) (*MsgCheckInResponse, error)
+	// make a claim
+	Claim(context.Context, *MsgClaimRequest) (*MsgClaimResponse, error)
+	// top up the escrow of a will
+	FundWill(context.Context, *MsgFundWillRequest) (*MsgFundWillResponse, error)
+	// update a live will
+	UpdateWill(context.Context, *MsgUpdateWillRequest) (*MsgUpdateWillResponse, error)
+	// cancel a live will and release its escrow
+	CancelWill(context.Context, *MsgCancelWillRequest) (*MsgCancelWillResponse, error)
+	// withdraw the coins that vested from a vesting component
+	WithdrawVested(context.Context, *MsgWithdrawVestedRequest) (*MsgWithdrawVestedResponse, error)
+	// execute a due will for a bounty
+	ExecuteWill(context.Context, *MsgExecuteWillRequest) (*MsgExecuteWillResponse, error)
+	// send a check-in, claim or status query to another chain over the will port
+	SendWillPacket(context.Context, *MsgSendWillPacketRequest) (*MsgSendWillPacketResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct{}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func (*UnimplementedMsgServer) CreateWill(ctx context.Context, req *MsgCreateWillRequest) (*MsgCreateWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWill not implemented")
+}
+
+func (*UnimplementedMsgServer) CheckIn(ctx context.Context, req *MsgCheckInRequest) (*MsgCheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaimRequest) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+
+func (*UnimplementedMsgServer) FundWill(ctx context.Context, req *MsgFundWillRequest) (*MsgFundWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundWill not implemented")
+}
+
+func (*UnimplementedMsgServer) UpdateWill(ctx context.Context, req *MsgUpdateWillRequest) (*MsgUpdateWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWill not implemented")
+}
+
+func (*UnimplementedMsgServer) CancelWill(ctx context.Context, req *MsgCancelWillRequest) (*MsgCancelWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWill not implemented")
+}
+
+func (*UnimplementedMsgServer) WithdrawVested(ctx context.Context, req *MsgWithdrawVestedRequest) (*MsgWithdrawVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawVested not implemented")
+}
+
+func (*UnimplementedMsgServer) ExecuteWill(ctx context.Context, req *MsgExecuteWillRequest) (*MsgExecuteWillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteWill not implemented")
+}
+
+func (*UnimplementedMsgServer) SendWillPacket(ctx context.Context, req *MsgSendWillPacketRequest) (*MsgSendWillPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWillPacket not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/CreateWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateWill(ctx, req.(*MsgCreateWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CheckIn(ctx, req.(*MsgCheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/FundWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundWill(ctx, req.(*MsgFundWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/UpdateWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWill(ctx, req.(*MsgUpdateWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/CancelWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWill(ctx, req.(*MsgCancelWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawVestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/WithdrawVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawVested(ctx, req.(*MsgWithdrawVestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteWill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteWillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteWill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/ExecuteWill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteWill(ctx, req.(*MsgExecuteWillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendWillPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendWillPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendWillPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.will.Msg/SendWillPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendWillPacket(ctx, req.(*MsgSendWillPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmwasm.will.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateWill",
+			Handler:    _Msg_CreateWill_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _Msg_CheckIn_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+		{
+			MethodName: "FundWill",
+			Handler:    _Msg_FundWill_Handler,
+		},
+		{
+			MethodName: "UpdateWill",
+			Handler:    _Msg_UpdateWill_Handler,
+		},
+		{
+			MethodName: "CancelWill",
+			Handler:    _Msg_CancelWill_Handler,
+		},
+		{
+			MethodName: "WithdrawVested",
+			Handler:    _Msg_WithdrawVested_Handler,
+		},
+		{
+			MethodName: "ExecuteWill",
+			Handler:    _Msg_ExecuteWill_Handler,
+		},
+		{
+			MethodName: "SendWillPacket",
+			Handler:    _Msg_SendWillPacket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmwasm/will/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteChannels) > 0 {
+		for iNdEx := len(m.RemoteChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoteChannels[iNdEx])
+			copy(dAtA[i:], m.RemoteChannels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemoteChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ExecutionMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InactivityDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.TriggerTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InactivityWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InactivityWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCheckInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCheckInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCheckInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCheckInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCheckInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCheckInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TriggerTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVestedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVestedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVestedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteWillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteWillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteWillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteWillResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteWillResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteWillResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounty) > 0 {
+		for iNdEx := len(m.Bounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendWillPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendWillPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendWillPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendWillPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendWillPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendWillPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimType != nil {
+		{
+			size := m.ClaimType.Size()
+			i -= size
+			if _, err := m.ClaimType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest_SchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest_SchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SchnorrClaim != nil {
+		{
+			size, err := m.SchnorrClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest_PedersenClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest_PedersenClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PedersenClaim != nil {
+		{
+			size, err := m.PedersenClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest_GnarkClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest_GnarkClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GnarkClaim != nil {
+		{
+			size, err := m.GnarkClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest_CustomClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest_CustomClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CustomClaim != nil {
+		{
+			size, err := m.CustomClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRequest_ThresholdSchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRequest_ThresholdSchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ThresholdSchnorrClaim != nil {
+		{
+			size, err := m.ThresholdSchnorrClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchnorrClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdSchnorrClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSchnorrClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSchnorrClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signers) > 0 {
+		dAtA15 := make([]byte, len(m.Signers)*10)
+		var j14 int
+		for _, num := range m.Signers {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTx(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PedersenClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PedersenClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PedersenClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlindingFactor) > 0 {
+		i -= len(m.BlindingFactor)
+		copy(dAtA[i:], m.BlindingFactor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlindingFactor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GnarkClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnarkClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GnarkClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicInputs) > 0 {
+		i -= len(m.PublicInputs)
+		copy(dAtA[i:], m.PublicInputs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicInputs)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.InactivityWindow != 0 {
+		n += 1 + sovTx(uint64(m.InactivityWindow))
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityDuration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionMode != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionMode))
+	}
+	if len(m.RemoteChannels) > 0 {
+		for _, s := range m.RemoteChannels {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCheckInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCheckInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawVestedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteWillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteWillResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounty) > 0 {
+		for _, e := range m.Bounty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendWillPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendWillPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClaimType != nil {
+		n += m.ClaimType.Size()
+	}
+	return n
+}
+
+func (m *MsgClaimRequest_SchnorrClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchnorrClaim != nil {
+		l = m.SchnorrClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRequest_PedersenClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PedersenClaim != nil {
+		l = m.PedersenClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRequest_GnarkClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GnarkClaim != nil {
+		l = m.GnarkClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRequest_CustomClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CustomClaim != nil {
+		l = m.CustomClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRequest_ThresholdSchnorrClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdSchnorrClaim != nil {
+		l = m.ThresholdSchnorrClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SchnorrClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdSchnorrClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		l = 0
+		for _, e := range m.Signers {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *PedersenClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlindingFactor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *GnarkClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicInputs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateWillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, &ExecutionComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityWindow", wireType)
+			}
+			m.InactivityWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InactivityDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Condition == nil {
+				m.Condition = &WasmCondition{}
+			}
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+			}
+			m.ExecutionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionMode |= ExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteChannels = append(m.RemoteChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateWillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCheckInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCheckInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCheckInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCheckInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCheckInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgFundWillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundWillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgFundWillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundWillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgUpdateWillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, &ExecutionComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWillResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,15 +5741,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWillResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1982,11 +5777,62 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2013,7 +5859,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2039,7 +5888,122 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelWillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgCancelWillResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2062,12 +6026,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelWillResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2090,7 +6088,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawVestedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2113,15 +6111,15 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateWillRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawVestedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawVestedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2149,11 +6147,11 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2181,11 +6179,11 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2213,30 +6211,62 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgWithdrawVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2263,8 +6293,8 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, &ExecutionComponent{})
-			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2290,7 +6320,7 @@ func (m *MsgCreateWillRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteWillRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,15 +6343,15 @@ func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateWillResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteWillRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteWillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2349,11 +6379,11 @@ func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2381,45 +6411,64 @@ func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgExecuteWillResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteWillResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteWillResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2429,43 +6478,26 @@ func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Beneficiary = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Bounty = append(m.Bounty, types.Coin{})
+			if err := m.Bounty[len(m.Bounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2488,7 +6520,7 @@ func (m *MsgCreateWillResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSendWillPacketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,15 +6543,15 @@ func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCheckInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendWillPacketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCheckInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendWillPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,11 +6579,11 @@ func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,13 +6611,13 @@ func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2595,11 +6627,26 @@ func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2622,7 +6669,7 @@ func (m *MsgCheckInRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MsgCheckInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendWillPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2645,37 +6692,17 @@ func (m *MsgCheckInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCheckInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendWillPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCheckInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendWillPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Status = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2685,7 +6712,7 @@ func (m *MsgCheckInResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2942,6 +6969,76 @@ func (m *MsgClaimRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClaimType = &MsgClaimRequest_GnarkClaim{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CustomClaim{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ClaimType = &MsgClaimRequest_CustomClaim{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSchnorrClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ThresholdSchnorrClaim{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ClaimType = &MsgClaimRequest_ThresholdSchnorrClaim{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3115,6 +7212,167 @@ func (m *SchnorrClaim) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *ThresholdSchnorrClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSchnorrClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSchnorrClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Signers = append(m.Signers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Signers) == 0 {
+					m.Signers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Signers = append(m.Signers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *PedersenClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3387,6 +7645,91 @@ func (m *GnarkClaim) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *CustomClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,11 +9,16 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,6 +26,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -29,6 +35,112 @@ var (
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DistributionRemainder selects what happens to the remainder of a
+// distribution.
+type DistributionRemainder int32
+
+const (
+	// the remainder goes to the first beneficiary
+	DistributionRemainder_DISTRIBUTION_REMAINDER_FIRST_BENEFICIARY DistributionRemainder = 0
+	// the remainder is burned
+	DistributionRemainder_DISTRIBUTION_REMAINDER_BURN DistributionRemainder = 1
+)
+
+var DistributionRemainder_name = map[int32]string{
+	0: "DISTRIBUTION_REMAINDER_FIRST_BENEFICIARY",
+	1: "DISTRIBUTION_REMAINDER_BURN",
+}
+
+var DistributionRemainder_value = map[string]int32{
+	"DISTRIBUTION_REMAINDER_FIRST_BENEFICIARY": 0,
+	"DISTRIBUTION_REMAINDER_BURN":              1,
+}
+
+func (x DistributionRemainder) String() string {
+	return proto.EnumName(DistributionRemainder_name, int32(x))
+}
+
+func (DistributionRemainder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{0}
+}
+
+// ExecutionMode selects what happens to the other components of a will when
+// one fails as the will fires.
+type ExecutionMode int32
+
+const (
+	// the components that succeed keep their effects, a failed component and
+	// the components depending on it do nothing
+	ExecutionMode_EXECUTION_MODE_BEST_EFFORT ExecutionMode = 0
+	// the components only keep their effects if every one of them succeeds,
+	// otherwise none does and the escrow is returned to the creator
+	ExecutionMode_EXECUTION_MODE_ATOMIC ExecutionMode = 1
+)
+
+var ExecutionMode_name = map[int32]string{
+	0: "EXECUTION_MODE_BEST_EFFORT",
+	1: "EXECUTION_MODE_ATOMIC",
+}
+
+var ExecutionMode_value = map[string]int32{
+	"EXECUTION_MODE_BEST_EFFORT": 0,
+	"EXECUTION_MODE_ATOMIC":      1,
+}
+
+func (x ExecutionMode) String() string {
+	return proto.EnumName(ExecutionMode_name, int32(x))
+}
+
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{1}
+}
+
+// ConditionOperator compares the value a condition selects from a query
+// result with the condition value. Ordering operators compare numbers,
+// equality compares numbers by value and anything else as JSON.
+type ConditionOperator int32
+
+const (
+	// the selected value equals the condition value
+	ConditionOperator_CONDITION_OPERATOR_EQUAL ConditionOperator = 0
+	// the selected value differs from the condition value
+	ConditionOperator_CONDITION_OPERATOR_NOT_EQUAL ConditionOperator = 1
+	// the selected value is below the condition value
+	ConditionOperator_CONDITION_OPERATOR_LESS ConditionOperator = 2
+	// the selected value is at most the condition value
+	ConditionOperator_CONDITION_OPERATOR_LESS_OR_EQUAL ConditionOperator = 3
+	// the selected value is above the condition value
+	ConditionOperator_CONDITION_OPERATOR_GREATER ConditionOperator = 4
+	// the selected value is at least the condition value
+	ConditionOperator_CONDITION_OPERATOR_GREATER_OR_EQUAL ConditionOperator = 5
+)
+
+var ConditionOperator_name = map[int32]string{
+	0: "CONDITION_OPERATOR_EQUAL",
+	1: "CONDITION_OPERATOR_NOT_EQUAL",
+	2: "CONDITION_OPERATOR_LESS",
+	3: "CONDITION_OPERATOR_LESS_OR_EQUAL",
+	4: "CONDITION_OPERATOR_GREATER",
+	5: "CONDITION_OPERATOR_GREATER_OR_EQUAL",
+}
+
+var ConditionOperator_value = map[string]int32{
+	"CONDITION_OPERATOR_EQUAL":            0,
+	"CONDITION_OPERATOR_NOT_EQUAL":        1,
+	"CONDITION_OPERATOR_LESS":             2,
+	"CONDITION_OPERATOR_LESS_OR_EQUAL":    3,
+	"CONDITION_OPERATOR_GREATER":          4,
+	"CONDITION_OPERATOR_GREATER_OR_EQUAL": 5,
+}
+
+func (x ConditionOperator) String() string {
+	return proto.EnumName(ConditionOperator_name, int32(x))
+}
+
+func (ConditionOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{2}
+}
+
 // ExecutionComponent defines a single actionable component within a will.
 type ExecutionComponent struct {
 	// component_type enables the inclusion of different types of execution
@@ -46,9 +158,15 @@ type ExecutionComponent struct {
 	//	*ExecutionComponent_Contract
 	//	*ExecutionComponent_IbcMsg
 	//	*ExecutionComponent_IbcSend
+	//	*ExecutionComponent_Distribution
+	//	*ExecutionComponent_Vesting
+	//	*ExecutionComponent_Ica
 	ComponentType isExecutionComponent_ComponentType `protobuf_oneof:"component_type"`
 	// output type
 	OutputType *ComponentOutput `protobuf:"bytes,9,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
+	// ids of earlier components of the will that must execute successfully for
+	// this one to run when the will fires, it is skipped otherwise
+	DependsOn []string `protobuf:"bytes,12,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 }
 
 func (m *ExecutionComponent) Reset()         { *m = ExecutionComponent{} }
@@ -111,12 +229,24 @@ type ExecutionComponent_IbcMsg struct {
 type ExecutionComponent_IbcSend struct {
 	IbcSend *IBCSendComponent `protobuf:"bytes,8,opt,name=ibc_send,json=ibcSend,proto3,oneof" json:"ibc_send,omitempty"`
 }
+type ExecutionComponent_Distribution struct {
+	Distribution *DistributionComponent `protobuf:"bytes,10,opt,name=distribution,proto3,oneof" json:"distribution,omitempty"`
+}
+type ExecutionComponent_Vesting struct {
+	Vesting *VestingComponent `protobuf:"bytes,11,opt,name=vesting,proto3,oneof" json:"vesting,omitempty"`
+}
+type ExecutionComponent_Ica struct {
+	Ica *ICAComponent `protobuf:"bytes,13,opt,name=ica,proto3,oneof" json:"ica,omitempty"`
+}
 
-func (*ExecutionComponent_Transfer) isExecutionComponent_ComponentType() {}
-func (*ExecutionComponent_Claim) isExecutionComponent_ComponentType()    {}
-func (*ExecutionComponent_Contract) isExecutionComponent_ComponentType() {}
-func (*ExecutionComponent_IbcMsg) isExecutionComponent_ComponentType()   {}
-func (*ExecutionComponent_IbcSend) isExecutionComponent_ComponentType()  {}
+func (*ExecutionComponent_Transfer) isExecutionComponent_ComponentType()     {}
+func (*ExecutionComponent_Claim) isExecutionComponent_ComponentType()        {}
+func (*ExecutionComponent_Contract) isExecutionComponent_ComponentType()     {}
+func (*ExecutionComponent_IbcMsg) isExecutionComponent_ComponentType()       {}
+func (*ExecutionComponent_IbcSend) isExecutionComponent_ComponentType()      {}
+func (*ExecutionComponent_Distribution) isExecutionComponent_ComponentType() {}
+func (*ExecutionComponent_Vesting) isExecutionComponent_ComponentType()      {}
+func (*ExecutionComponent_Ica) isExecutionComponent_ComponentType()          {}
 
 func (m *ExecutionComponent) GetComponentType() isExecutionComponent_ComponentType {
 	if m != nil {
@@ -160,6 +290,27 @@ func (m *ExecutionComponent) GetIbcSend() *IBCSendComponent {
 	return nil
 }
 
+func (m *ExecutionComponent) GetDistribution() *DistributionComponent {
+	if x, ok := m.GetComponentType().(*ExecutionComponent_Distribution); ok {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (m *ExecutionComponent) GetVesting() *VestingComponent {
+	if x, ok := m.GetComponentType().(*ExecutionComponent_Vesting); ok {
+		return x.Vesting
+	}
+	return nil
+}
+
+func (m *ExecutionComponent) GetIca() *ICAComponent {
+	if x, ok := m.GetComponentType().(*ExecutionComponent_Ica); ok {
+		return x.Ica
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ExecutionComponent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -168,6 +319,9 @@ func (*ExecutionComponent) XXX_OneofWrappers() []interface{} {
 		(*ExecutionComponent_Contract)(nil),
 		(*ExecutionComponent_IbcMsg)(nil),
 		(*ExecutionComponent_IbcSend)(nil),
+		(*ExecutionComponent_Distribution)(nil),
+		(*ExecutionComponent_Vesting)(nil),
+		(*ExecutionComponent_Ica)(nil),
 	}
 }
 
@@ -176,7 +330,6 @@ type ComponentOutput struct {
 	// types of outputs
 	//
 	// Types that are valid to be assigned to OutputType:
-	//
 	//	*ComponentOutput_OutputTransfer
 	//	*ComponentOutput_OutputContractCall
 	//	*ComponentOutput_OutputIbcContractCall
@@ -440,7 +593,6 @@ type ClaimAccessControl struct {
 	// type of access
 	//
 	// Types that are valid to be assigned to AccessType:
-	//
 	//	*ClaimAccessControl_Public
 	//	*ClaimAccessControl_Private
 	AccessType isClaimAccessControl_AccessType `protobuf_oneof:"access_type"`
@@ -536,11 +688,24 @@ type ClaimComponent struct {
 	// access for private or public calls
 	//
 	// Types that are valid to be assigned to SchemeType:
-	//
 	//	*ClaimComponent_Pedersen
 	//	*ClaimComponent_Schnorr
 	//	*ClaimComponent_Gnark
+	//	*ClaimComponent_Custom
+	//	*ClaimComponent_ThresholdSchnorr
 	SchemeType isClaimComponent_SchemeType `protobuf_oneof:"scheme_type"`
+	// window bounds how long the component accepts claims once its will fires,
+	// without a window it accepts claims forever
+	Window *ClaimWindow `protobuf:"bytes,7,opt,name=window,proto3" json:"window,omitempty"`
+	// fallback is the output run instead of the component output when the
+	// window closes before the component is claimed
+	Fallback *ComponentOutput `protobuf:"bytes,8,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// lapse_height is the block height the window closes at, set by the chain
+	// when the will fires
+	LapseHeight int64 `protobuf:"varint,9,opt,name=lapse_height,json=lapseHeight,proto3" json:"lapse_height,omitempty"`
+	// lapse_time is the block time the window closes at, set by the chain when
+	// the will fires
+	LapseTime *time.Time `protobuf:"bytes,10,opt,name=lapse_time,json=lapseTime,proto3,stdtime" json:"lapse_time,omitempty"`
 }
 
 func (m *ClaimComponent) Reset()         { *m = ClaimComponent{} }
@@ -597,10 +762,18 @@ type ClaimComponent_Schnorr struct {
 type ClaimComponent_Gnark struct {
 	Gnark *GnarkZkSnark `protobuf:"bytes,4,opt,name=gnark,proto3,oneof" json:"gnark,omitempty"`
 }
+type ClaimComponent_Custom struct {
+	Custom *CustomClaimScheme `protobuf:"bytes,5,opt,name=custom,proto3,oneof" json:"custom,omitempty"`
+}
+type ClaimComponent_ThresholdSchnorr struct {
+	ThresholdSchnorr *ThresholdSchnorr `protobuf:"bytes,6,opt,name=threshold_schnorr,json=thresholdSchnorr,proto3,oneof" json:"threshold_schnorr,omitempty"`
+}
 
-func (*ClaimComponent_Pedersen) isClaimComponent_SchemeType() {}
-func (*ClaimComponent_Schnorr) isClaimComponent_SchemeType()  {}
-func (*ClaimComponent_Gnark) isClaimComponent_SchemeType()    {}
+func (*ClaimComponent_Pedersen) isClaimComponent_SchemeType()         {}
+func (*ClaimComponent_Schnorr) isClaimComponent_SchemeType()          {}
+func (*ClaimComponent_Gnark) isClaimComponent_SchemeType()            {}
+func (*ClaimComponent_Custom) isClaimComponent_SchemeType()           {}
+func (*ClaimComponent_ThresholdSchnorr) isClaimComponent_SchemeType() {}
 
 func (m *ClaimComponent) GetSchemeType() isClaimComponent_SchemeType {
 	if m != nil {
@@ -630,15 +803,78 @@ func (m *ClaimComponent) GetGnark() *GnarkZkSnark {
 	return nil
 }
 
+func (m *ClaimComponent) GetCustom() *CustomClaimScheme {
+	if x, ok := m.GetSchemeType().(*ClaimComponent_Custom); ok {
+		return x.Custom
+	}
+	return nil
+}
+
+func (m *ClaimComponent) GetThresholdSchnorr() *ThresholdSchnorr {
+	if x, ok := m.GetSchemeType().(*ClaimComponent_ThresholdSchnorr); ok {
+		return x.ThresholdSchnorr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ClaimComponent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ClaimComponent_Pedersen)(nil),
 		(*ClaimComponent_Schnorr)(nil),
 		(*ClaimComponent_Gnark)(nil),
+		(*ClaimComponent_Custom)(nil),
+		(*ClaimComponent_ThresholdSchnorr)(nil),
+	}
+}
+
+// ClaimWindow is the time a claim component accepts claims after its will
+// fires, measured in either blocks or time.
+type ClaimWindow struct {
+	// number of blocks the component accepts claims for
+	Blocks int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// time the component accepts claims for
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *ClaimWindow) Reset()         { *m = ClaimWindow{} }
+func (m *ClaimWindow) String() string { return proto.CompactTextString(m) }
+func (*ClaimWindow) ProtoMessage()    {}
+func (*ClaimWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{7}
+}
+
+func (m *ClaimWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ClaimWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
 }
 
+func (m *ClaimWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimWindow.Merge(m, src)
+}
+
+func (m *ClaimWindow) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ClaimWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimWindow proto.InternalMessageInfo
+
 // contract component
 type ContractComponent struct {
 	// contract address
@@ -651,7 +887,7 @@ func (m *ContractComponent) Reset()         { *m = ContractComponent{} }
 func (m *ContractComponent) String() string { return proto.CompactTextString(m) }
 func (*ContractComponent) ProtoMessage()    {}
 func (*ContractComponent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{7}
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{8}
 }
 
 func (m *ContractComponent) XXX_Unmarshal(b []byte) error {
@@ -687,21 +923,21 @@ var xxx_messageInfo_ContractComponent proto.InternalMessageInfo
 
 // for ibc output message, we could make this be contract, or IBC send...
 type IBCMsgComponent struct {
-	// contract address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// channel to be passed in the packet
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	// port id
-	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// data to be passed in the packet
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// retry resends the packet when it times out
+	Retry *RetryPolicy `protobuf:"bytes,4,opt,name=retry,proto3" json:"retry,omitempty"`
 }
 
 func (m *IBCMsgComponent) Reset()         { *m = IBCMsgComponent{} }
 func (m *IBCMsgComponent) String() string { return proto.CompactTextString(m) }
 func (*IBCMsgComponent) ProtoMessage()    {}
 func (*IBCMsgComponent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{8}
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{9}
 }
 
 func (m *IBCMsgComponent) XXX_Unmarshal(b []byte) error {
@@ -747,13 +983,17 @@ type IBCSendComponent struct {
 	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 	// amount to send over IBC
 	Amount *types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// memo passed along with the ICS-20 transfer
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// retry sends the transfer again when it times out
+	Retry *RetryPolicy `protobuf:"bytes,7,opt,name=retry,proto3" json:"retry,omitempty"`
 }
 
 func (m *IBCSendComponent) Reset()         { *m = IBCSendComponent{} }
 func (m *IBCSendComponent) String() string { return proto.CompactTextString(m) }
 func (*IBCSendComponent) ProtoMessage()    {}
 func (*IBCSendComponent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{9}
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{10}
 }
 
 func (m *IBCSendComponent) XXX_Unmarshal(b []byte) error {
@@ -787,30 +1027,33 @@ func (m *IBCSendComponent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IBCSendComponent proto.InternalMessageInfo
 
-// types of outputs for components
-type OutputTransfer struct {
-	// recipient
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// denom to send
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	// amout to send
-	Amount *types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (m *OutputTransfer) Reset()         { *m = OutputTransfer{} }
-func (m *OutputTransfer) String() string { return proto.CompactTextString(m) }
-func (*OutputTransfer) ProtoMessage()    {}
-func (*OutputTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{10}
+// ICAComponent executes messages on a remote chain through an interchain
+// account the will module owns for the creator. The account is registered on
+// the connection when the will is created, or reused when it exists.
+type ICAComponent struct {
+	// connection_id is the connection to the remote chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// msgs are the protobuf encodings of the google.protobuf.Any messages the
+	// interchain account executes, in order
+	Msgs [][]byte `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// memo passed along with the interchain account packet
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *ICAComponent) Reset()         { *m = ICAComponent{} }
+func (m *ICAComponent) String() string { return proto.CompactTextString(m) }
+func (*ICAComponent) ProtoMessage()    {}
+func (*ICAComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{11}
 }
 
-func (m *OutputTransfer) XXX_Unmarshal(b []byte) error {
+func (m *ICAComponent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *OutputTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ICAComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OutputTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ICAComponent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -821,42 +1064,44 @@ func (m *OutputTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 }
 
-func (m *OutputTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputTransfer.Merge(m, src)
+func (m *ICAComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAComponent.Merge(m, src)
 }
 
-func (m *OutputTransfer) XXX_Size() int {
+func (m *ICAComponent) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *OutputTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutputTransfer.DiscardUnknown(m)
+func (m *ICAComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAComponent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OutputTransfer proto.InternalMessageInfo
+var xxx_messageInfo_ICAComponent proto.InternalMessageInfo
 
-// output for contract call
-type OutputContractCall struct {
-	// contract address
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// data to be passed to the contract
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+// RetryPolicy tells how often the packet of a component is sent again when it
+// times out, and how long to wait before each attempt. A packet the receiving
+// chain acknowledges with an error is not sent again.
+type RetryPolicy struct {
+	// max_retries is how many times the packet is sent again at most
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// backoff_blocks is how many blocks after a timeout the packet is sent again
+	BackoffBlocks int64 `protobuf:"varint,2,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
 }
 
-func (m *OutputContractCall) Reset()         { *m = OutputContractCall{} }
-func (m *OutputContractCall) String() string { return proto.CompactTextString(m) }
-func (*OutputContractCall) ProtoMessage()    {}
-func (*OutputContractCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{11}
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{12}
 }
 
-func (m *OutputContractCall) XXX_Unmarshal(b []byte) error {
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *OutputContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OutputContractCall.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -867,44 +1112,50 @@ func (m *OutputContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *OutputContractCall) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputContractCall.Merge(m, src)
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
 }
 
-func (m *OutputContractCall) XXX_Size() int {
+func (m *RetryPolicy) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *OutputContractCall) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutputContractCall.DiscardUnknown(m)
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OutputContractCall proto.InternalMessageInfo
-
-// output for IBC contract call
-type OutputIBCContractCall struct {
-	// channel
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// IBC contract call payload
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	// address
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-}
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
 
-func (m *OutputIBCContractCall) Reset()         { *m = OutputIBCContractCall{} }
-func (m *OutputIBCContractCall) String() string { return proto.CompactTextString(m) }
-func (*OutputIBCContractCall) ProtoMessage()    {}
-func (*OutputIBCContractCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{12}
+// DistributionComponent splits assets between beneficiaries in proportion to
+// their weights. Each beneficiary receives the floor of its share, the
+// remainder is handled as the component chooses.
+type DistributionComponent struct {
+	// beneficiaries and their weights
+	Shares []DistributionShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares"`
+	// assets to split
+	//
+	// Types that are valid to be assigned to Source:
+	//	*DistributionComponent_Coins
+	//	*DistributionComponent_BalanceDenom
+	Source isDistributionComponent_Source `protobuf_oneof:"source"`
+	// what happens to the remainder left by rounding the shares down
+	Remainder DistributionRemainder `protobuf:"varint,4,opt,name=remainder,proto3,enum=cosmwasm.will.DistributionRemainder" json:"remainder,omitempty"`
+}
+
+func (m *DistributionComponent) Reset()         { *m = DistributionComponent{} }
+func (m *DistributionComponent) String() string { return proto.CompactTextString(m) }
+func (*DistributionComponent) ProtoMessage()    {}
+func (*DistributionComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{13}
 }
 
-func (m *OutputIBCContractCall) XXX_Unmarshal(b []byte) error {
+func (m *DistributionComponent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *OutputIBCContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OutputIBCContractCall.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionComponent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -915,90 +1166,88 @@ func (m *OutputIBCContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byt
 	}
 }
 
-func (m *OutputIBCContractCall) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputIBCContractCall.Merge(m, src)
+func (m *DistributionComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionComponent.Merge(m, src)
 }
 
-func (m *OutputIBCContractCall) XXX_Size() int {
+func (m *DistributionComponent) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *OutputIBCContractCall) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutputIBCContractCall.DiscardUnknown(m)
+func (m *DistributionComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionComponent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OutputIBCContractCall proto.InternalMessageInfo
+var xxx_messageInfo_DistributionComponent proto.InternalMessageInfo
 
-// output for IBC contract call
-type OutputIBCSend struct {
-	// channel
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// recipient
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// denom to send
-	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	// amout to send
-	Amount *types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+type isDistributionComponent_Source interface {
+	isDistributionComponent_Source()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *OutputIBCSend) Reset()         { *m = OutputIBCSend{} }
-func (m *OutputIBCSend) String() string { return proto.CompactTextString(m) }
-func (*OutputIBCSend) ProtoMessage()    {}
-func (*OutputIBCSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cec37ad7aa1ffe0b, []int{13}
+type DistributionComponent_Coins struct {
+	Coins *DistributionCoins `protobuf:"bytes,2,opt,name=coins,proto3,oneof" json:"coins,omitempty"`
 }
-
-func (m *OutputIBCSend) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type DistributionComponent_BalanceDenom struct {
+	BalanceDenom string `protobuf:"bytes,3,opt,name=balance_denom,json=balanceDenom,proto3,oneof" json:"balance_denom,omitempty"`
 }
 
-func (m *OutputIBCSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_OutputIBCSend.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (*DistributionComponent_Coins) isDistributionComponent_Source()        {}
+func (*DistributionComponent_BalanceDenom) isDistributionComponent_Source() {}
+
+func (m *DistributionComponent) GetSource() isDistributionComponent_Source {
+	if m != nil {
+		return m.Source
 	}
+	return nil
 }
 
-func (m *OutputIBCSend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputIBCSend.Merge(m, src)
+func (m *DistributionComponent) GetCoins() *DistributionCoins {
+	if x, ok := m.GetSource().(*DistributionComponent_Coins); ok {
+		return x.Coins
+	}
+	return nil
 }
 
-func (m *OutputIBCSend) XXX_Size() int {
-	return m.Size()
+func (m *DistributionComponent) GetBalanceDenom() string {
+	if x, ok := m.GetSource().(*DistributionComponent_BalanceDenom); ok {
+		return x.BalanceDenom
+	}
+	return ""
 }
 
-func (m *OutputIBCSend) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutputIBCSend.DiscardUnknown(m)
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DistributionComponent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DistributionComponent_Coins)(nil),
+		(*DistributionComponent_BalanceDenom)(nil),
+	}
 }
 
-var xxx_messageInfo_OutputIBCSend proto.InternalMessageInfo
-
-// output for emitting event
-type OutputEmit struct {
-	// event
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+// DistributionShare is a beneficiary of a distribution component.
+type DistributionShare struct {
+	// beneficiary address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight of the beneficiary, weights expressing percentages add up to 100
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *OutputEmit) Reset()         { *m = OutputEmit{} }
-func (m *OutputEmit) String() string { return proto.CompactTextString(m) }
-func (*OutputEmit) ProtoMessage()    {}
-func (*OutputEmit) Descriptor() ([]byte, []int) {
+func (m *DistributionShare) Reset()         { *m = DistributionShare{} }
+func (m *DistributionShare) String() string { return proto.CompactTextString(m) }
+func (*DistributionShare) ProtoMessage()    {}
+func (*DistributionShare) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{14}
 }
 
-func (m *OutputEmit) XXX_Unmarshal(b []byte) error {
+func (m *DistributionShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *OutputEmit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OutputEmit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1009,42 +1258,40 @@ func (m *OutputEmit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *OutputEmit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OutputEmit.Merge(m, src)
+func (m *DistributionShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionShare.Merge(m, src)
 }
 
-func (m *OutputEmit) XXX_Size() int {
+func (m *DistributionShare) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *OutputEmit) XXX_DiscardUnknown() {
-	xxx_messageInfo_OutputEmit.DiscardUnknown(m)
+func (m *DistributionShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OutputEmit proto.InternalMessageInfo
+var xxx_messageInfo_DistributionShare proto.InternalMessageInfo
 
-// CLAIM TYPES
-// SchnorrSignature is used for claims that require a Schnorr signature.
-type SchnorrSignature struct {
-	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+// DistributionCoins is a fixed set of coins to distribute.
+type DistributionCoins struct {
+	// coins to split
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *SchnorrSignature) Reset()         { *m = SchnorrSignature{} }
-func (m *SchnorrSignature) String() string { return proto.CompactTextString(m) }
-func (*SchnorrSignature) ProtoMessage()    {}
-func (*SchnorrSignature) Descriptor() ([]byte, []int) {
+func (m *DistributionCoins) Reset()         { *m = DistributionCoins{} }
+func (m *DistributionCoins) String() string { return proto.CompactTextString(m) }
+func (*DistributionCoins) ProtoMessage()    {}
+func (*DistributionCoins) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{15}
 }
 
-func (m *SchnorrSignature) XXX_Unmarshal(b []byte) error {
+func (m *DistributionCoins) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *SchnorrSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DistributionCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SchnorrSignature.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DistributionCoins.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1055,40 +1302,55 @@ func (m *SchnorrSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 }
 
-func (m *SchnorrSignature) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SchnorrSignature.Merge(m, src)
+func (m *DistributionCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionCoins.Merge(m, src)
 }
 
-func (m *SchnorrSignature) XXX_Size() int {
+func (m *DistributionCoins) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *SchnorrSignature) XXX_DiscardUnknown() {
-	xxx_messageInfo_SchnorrSignature.DiscardUnknown(m)
+func (m *DistributionCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionCoins.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SchnorrSignature proto.InternalMessageInfo
-
-// PedersenCommitment enables the use of a Pedersen commitment for claims.
-type PedersenCommitment struct {
-	Commitment       []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	TargetCommitment []byte `protobuf:"bytes,2,opt,name=target_commitment,json=targetCommitment,proto3" json:"target_commitment,omitempty"`
-}
+var xxx_messageInfo_DistributionCoins proto.InternalMessageInfo
 
-func (m *PedersenCommitment) Reset()         { *m = PedersenCommitment{} }
-func (m *PedersenCommitment) String() string { return proto.CompactTextString(m) }
-func (*PedersenCommitment) ProtoMessage()    {}
-func (*PedersenCommitment) Descriptor() ([]byte, []int) {
+// VestingComponent releases escrowed coins to a beneficiary on a schedule that
+// starts when the will fires. The beneficiary withdraws the coins that vested.
+type VestingComponent struct {
+	// beneficiary address
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// coins to vest, escrowed when the will is created
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// schedule the coins vest on
+	//
+	// Types that are valid to be assigned to Schedule:
+	//	*VestingComponent_Linear
+	//	*VestingComponent_Cliff
+	//	*VestingComponent_Periodic
+	Schedule isVestingComponent_Schedule `protobuf_oneof:"schedule"`
+	// start_height is the block height the schedule started at, set by the
+	// chain when the will fires
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// coins the beneficiary withdrew so far
+	Withdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=withdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn"`
+}
+
+func (m *VestingComponent) Reset()         { *m = VestingComponent{} }
+func (m *VestingComponent) String() string { return proto.CompactTextString(m) }
+func (*VestingComponent) ProtoMessage()    {}
+func (*VestingComponent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{16}
 }
 
-func (m *PedersenCommitment) XXX_Unmarshal(b []byte) error {
+func (m *VestingComponent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *PedersenCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VestingComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PedersenCommitment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VestingComponent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1099,42 +1361,98 @@ func (m *PedersenCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 }
 
-func (m *PedersenCommitment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PedersenCommitment.Merge(m, src)
+func (m *VestingComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingComponent.Merge(m, src)
 }
 
-func (m *PedersenCommitment) XXX_Size() int {
+func (m *VestingComponent) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *PedersenCommitment) XXX_DiscardUnknown() {
-	xxx_messageInfo_PedersenCommitment.DiscardUnknown(m)
+func (m *VestingComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingComponent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PedersenCommitment proto.InternalMessageInfo
+var xxx_messageInfo_VestingComponent proto.InternalMessageInfo
 
-// GnarkZkSnark is for claims using zero-knowledge succinct non-interactive
-// arguments of knowledge.
-type GnarkZkSnark struct {
-	VerificationKey []byte `protobuf:"bytes,1,opt,name=verification_key,json=verificationKey,proto3" json:"verification_key,omitempty"`
-	PublicInputs    []byte `protobuf:"bytes,2,opt,name=public_inputs,json=publicInputs,proto3" json:"public_inputs,omitempty"`
-	Proof           []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+type isVestingComponent_Schedule interface {
+	isVestingComponent_Schedule()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *GnarkZkSnark) Reset()         { *m = GnarkZkSnark{} }
-func (m *GnarkZkSnark) String() string { return proto.CompactTextString(m) }
-func (*GnarkZkSnark) ProtoMessage()    {}
-func (*GnarkZkSnark) Descriptor() ([]byte, []int) {
+type VestingComponent_Linear struct {
+	Linear *LinearVesting `protobuf:"bytes,3,opt,name=linear,proto3,oneof" json:"linear,omitempty"`
+}
+type VestingComponent_Cliff struct {
+	Cliff *CliffVesting `protobuf:"bytes,4,opt,name=cliff,proto3,oneof" json:"cliff,omitempty"`
+}
+type VestingComponent_Periodic struct {
+	Periodic *PeriodicVesting `protobuf:"bytes,5,opt,name=periodic,proto3,oneof" json:"periodic,omitempty"`
+}
+
+func (*VestingComponent_Linear) isVestingComponent_Schedule()   {}
+func (*VestingComponent_Cliff) isVestingComponent_Schedule()    {}
+func (*VestingComponent_Periodic) isVestingComponent_Schedule() {}
+
+func (m *VestingComponent) GetSchedule() isVestingComponent_Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *VestingComponent) GetLinear() *LinearVesting {
+	if x, ok := m.GetSchedule().(*VestingComponent_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *VestingComponent) GetCliff() *CliffVesting {
+	if x, ok := m.GetSchedule().(*VestingComponent_Cliff); ok {
+		return x.Cliff
+	}
+	return nil
+}
+
+func (m *VestingComponent) GetPeriodic() *PeriodicVesting {
+	if x, ok := m.GetSchedule().(*VestingComponent_Periodic); ok {
+		return x.Periodic
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VestingComponent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VestingComponent_Linear)(nil),
+		(*VestingComponent_Cliff)(nil),
+		(*VestingComponent_Periodic)(nil),
+	}
+}
+
+// LinearVesting vests coins evenly over a number of blocks.
+type LinearVesting struct {
+	// number of blocks until everything vested
+	Blocks int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *LinearVesting) Reset()         { *m = LinearVesting{} }
+func (m *LinearVesting) String() string { return proto.CompactTextString(m) }
+func (*LinearVesting) ProtoMessage()    {}
+func (*LinearVesting) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{17}
 }
 
-func (m *GnarkZkSnark) XXX_Unmarshal(b []byte) error {
+func (m *LinearVesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *GnarkZkSnark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LinearVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GnarkZkSnark.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LinearVesting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1145,45 +1463,44 @@ func (m *GnarkZkSnark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 }
 
-func (m *GnarkZkSnark) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GnarkZkSnark.Merge(m, src)
+func (m *LinearVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinearVesting.Merge(m, src)
 }
 
-func (m *GnarkZkSnark) XXX_Size() int {
+func (m *LinearVesting) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *GnarkZkSnark) XXX_DiscardUnknown() {
-	xxx_messageInfo_GnarkZkSnark.DiscardUnknown(m)
+func (m *LinearVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinearVesting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GnarkZkSnark proto.InternalMessageInfo
+var xxx_messageInfo_LinearVesting proto.InternalMessageInfo
 
-// Will represents the entire structure of a will.
-type Will struct {
-	ID          string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator     string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Name        string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Beneficiary string                `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
-	Height      int64                 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	Status      string                `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Components  []*ExecutionComponent `protobuf:"bytes,7,rep,name=components,proto3" json:"components,omitempty"`
+// CliffVesting vests coins evenly over a number of blocks, but nothing
+// before the cliff. At the cliff everything that vested linearly until then
+// is released at once.
+type CliffVesting struct {
+	// number of blocks until the cliff
+	CliffBlocks int64 `protobuf:"varint,1,opt,name=cliff_blocks,json=cliffBlocks,proto3" json:"cliff_blocks,omitempty"`
+	// number of blocks until everything vested
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (m *Will) Reset()         { *m = Will{} }
-func (m *Will) String() string { return proto.CompactTextString(m) }
-func (*Will) ProtoMessage()    {}
-func (*Will) Descriptor() ([]byte, []int) {
+func (m *CliffVesting) Reset()         { *m = CliffVesting{} }
+func (m *CliffVesting) String() string { return proto.CompactTextString(m) }
+func (*CliffVesting) ProtoMessage()    {}
+func (*CliffVesting) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{18}
 }
 
-func (m *Will) XXX_Unmarshal(b []byte) error {
+func (m *CliffVesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *Will) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CliffVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Will.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CliffVesting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1194,40 +1511,40 @@ func (m *Will) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *Will) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Will.Merge(m, src)
+func (m *CliffVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CliffVesting.Merge(m, src)
 }
 
-func (m *Will) XXX_Size() int {
+func (m *CliffVesting) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *Will) XXX_DiscardUnknown() {
-	xxx_messageInfo_Will.DiscardUnknown(m)
+func (m *CliffVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_CliffVesting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Will proto.InternalMessageInfo
+var xxx_messageInfo_CliffVesting proto.InternalMessageInfo
 
-// type to hold wills
-type Wills struct {
-	// the set of wills to return
-	Wills []*Will `protobuf:"bytes,1,rep,name=wills,proto3" json:"wills,omitempty"`
+// PeriodicVesting vests coins in tranches.
+type PeriodicVesting struct {
+	// consecutive periods, whose amounts add up to the vesting amount
+	Periods []VestingPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
 }
 
-func (m *Wills) Reset()         { *m = Wills{} }
-func (m *Wills) String() string { return proto.CompactTextString(m) }
-func (*Wills) ProtoMessage()    {}
-func (*Wills) Descriptor() ([]byte, []int) {
+func (m *PeriodicVesting) Reset()         { *m = PeriodicVesting{} }
+func (m *PeriodicVesting) String() string { return proto.CompactTextString(m) }
+func (*PeriodicVesting) ProtoMessage()    {}
+func (*PeriodicVesting) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{19}
 }
 
-func (m *Wills) XXX_Unmarshal(b []byte) error {
+func (m *PeriodicVesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *Wills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PeriodicVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Wills.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PeriodicVesting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1238,39 +1555,42 @@ func (m *Wills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *Wills) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Wills.Merge(m, src)
+func (m *PeriodicVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicVesting.Merge(m, src)
 }
 
-func (m *Wills) XXX_Size() int {
+func (m *PeriodicVesting) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *Wills) XXX_DiscardUnknown() {
-	xxx_messageInfo_Wills.DiscardUnknown(m)
+func (m *PeriodicVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicVesting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Wills proto.InternalMessageInfo
+var xxx_messageInfo_PeriodicVesting proto.InternalMessageInfo
 
-// WillIds represents a list of will IDs.
-type WillIds struct {
-	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+// VestingPeriod is a tranche of a periodic vesting schedule.
+type VestingPeriod struct {
+	// length of the period in blocks, its coins vest at its end
+	Blocks int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// coins vesting at the end of the period
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *WillIds) Reset()         { *m = WillIds{} }
-func (m *WillIds) String() string { return proto.CompactTextString(m) }
-func (*WillIds) ProtoMessage()    {}
-func (*WillIds) Descriptor() ([]byte, []int) {
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cec37ad7aa1ffe0b, []int{20}
 }
 
-func (m *WillIds) XXX_Unmarshal(b []byte) error {
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *WillIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WillIds.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1281,3039 +1601,10072 @@ func (m *WillIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 }
 
-func (m *WillIds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WillIds.Merge(m, src)
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
 }
 
-func (m *WillIds) XXX_Size() int {
+func (m *VestingPeriod) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *WillIds) XXX_DiscardUnknown() {
-	xxx_messageInfo_WillIds.DiscardUnknown(m)
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WillIds proto.InternalMessageInfo
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ExecutionComponent)(nil), "cosmwasm.will.ExecutionComponent")
-	proto.RegisterType((*ComponentOutput)(nil), "cosmwasm.will.ComponentOutput")
-	proto.RegisterType((*TransferComponent)(nil), "cosmwasm.will.TransferComponent")
-	proto.RegisterType((*ClaimAccessPublic)(nil), "cosmwasm.will.ClaimAccessPublic")
-	proto.RegisterType((*ClaimAccessPrivate)(nil), "cosmwasm.will.ClaimAccessPrivate")
-	proto.RegisterType((*ClaimAccessControl)(nil), "cosmwasm.will.ClaimAccessControl")
-	proto.RegisterType((*ClaimComponent)(nil), "cosmwasm.will.ClaimComponent")
-	proto.RegisterType((*ContractComponent)(nil), "cosmwasm.will.ContractComponent")
-	proto.RegisterType((*IBCMsgComponent)(nil), "cosmwasm.will.IBCMsgComponent")
-	proto.RegisterType((*IBCSendComponent)(nil), "cosmwasm.will.IBCSendComponent")
-	proto.RegisterType((*OutputTransfer)(nil), "cosmwasm.will.OutputTransfer")
-	proto.RegisterType((*OutputContractCall)(nil), "cosmwasm.will.OutputContractCall")
-	proto.RegisterType((*OutputIBCContractCall)(nil), "cosmwasm.will.OutputIBCContractCall")
-	proto.RegisterType((*OutputIBCSend)(nil), "cosmwasm.will.OutputIBCSend")
-	proto.RegisterType((*OutputEmit)(nil), "cosmwasm.will.OutputEmit")
-	proto.RegisterType((*SchnorrSignature)(nil), "cosmwasm.will.SchnorrSignature")
-	proto.RegisterType((*PedersenCommitment)(nil), "cosmwasm.will.PedersenCommitment")
-	proto.RegisterType((*GnarkZkSnark)(nil), "cosmwasm.will.GnarkZkSnark")
-	proto.RegisterType((*Will)(nil), "cosmwasm.will.Will")
-	proto.RegisterType((*Wills)(nil), "cosmwasm.will.Wills")
-	proto.RegisterType((*WillIds)(nil), "cosmwasm.will.WillIds")
+// types of outputs for components
+type OutputTransfer struct {
+	// recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom to send
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amout to send
+	Amount *types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/will/types.proto", fileDescriptor_cec37ad7aa1ffe0b) }
-
-var fileDescriptor_cec37ad7aa1ffe0b = []byte{
-	// 1294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x49, 0x8f, 0x1b, 0xc5,
-	0x17, 0x77, 0x7b, 0x1d, 0x3f, 0xcf, 0x5a, 0x59, 0xfe, 0x9d, 0xcd, 0x9e, 0x7f, 0x03, 0x51, 0x20,
-	0x92, 0xad, 0x49, 0xe0, 0x40, 0x48, 0x88, 0xa6, 0x9d, 0x80, 0x2d, 0x14, 0x08, 0x3d, 0x41, 0x91,
-	0x72, 0x19, 0x95, 0xbb, 0x6b, 0x3c, 0xa5, 0x74, 0x77, 0x59, 0x5d, 0xe5, 0x24, 0xf3, 0x1d, 0x38,
-	0x70, 0xe4, 0x84, 0x38, 0xce, 0x91, 0x1b, 0x5f, 0x21, 0xc7, 0x48, 0x5c, 0x38, 0x59, 0xe0, 0x1c,
-	0xe0, 0x63, 0xa0, 0x5a, 0xba, 0xa7, 0xbd, 0xcc, 0x28, 0x87, 0x5c, 0x66, 0xfa, 0x2d, 0xbf, 0xdf,
-	0xab, 0x7a, 0xaf, 0xde, 0xab, 0x32, 0x5c, 0xf2, 0x19, 0x8f, 0x5e, 0x62, 0x1e, 0x75, 0x5e, 0xd2,
-	0x30, 0xec, 0x88, 0xa3, 0x11, 0xe1, 0xed, 0x51, 0xc2, 0x04, 0x43, 0x6b, 0xa9, 0xa9, 0x2d, 0x4d,
-	0x97, 0xcf, 0x0f, 0xd9, 0x90, 0x29, 0x4b, 0x47, 0x7e, 0x69, 0xa7, 0xcb, 0x4d, 0xe9, 0xc4, 0x78,
-	0x67, 0x80, 0x39, 0xe9, 0xbc, 0xd8, 0x19, 0x10, 0x81, 0x77, 0x3a, 0x3e, 0xa3, 0xb1, 0xb1, 0x6f,
-	0xe1, 0x88, 0xc6, 0xac, 0xa3, 0xfe, 0x6a, 0x95, 0xf3, 0x47, 0x09, 0xd0, 0xc3, 0x57, 0xc4, 0x1f,
-	0x0b, 0xca, 0xe2, 0x2e, 0x8b, 0x46, 0x2c, 0x26, 0xb1, 0x40, 0x08, 0xca, 0x31, 0x8e, 0x88, 0x6d,
-	0x6d, 0x5b, 0x37, 0xea, 0x9e, 0xfa, 0x46, 0xeb, 0x50, 0xa4, 0x81, 0x5d, 0x54, 0x9a, 0x22, 0x0d,
-	0xd0, 0x45, 0xa8, 0x72, 0x81, 0xc5, 0x98, 0xdb, 0x25, 0xa5, 0x33, 0x12, 0xfa, 0x12, 0x56, 0x44,
-	0x82, 0x63, 0x7e, 0x40, 0x12, 0xbb, 0xbc, 0x6d, 0xdd, 0x68, 0xdc, 0xda, 0x6e, 0xcf, 0xac, 0xbe,
-	0xfd, 0xc4, 0x98, 0xb3, 0x78, 0xbd, 0x82, 0x97, 0x61, 0xd0, 0x67, 0x50, 0xf1, 0x43, 0x4c, 0x23,
-	0xbb, 0xa2, 0xc0, 0xd7, 0xe6, 0xc0, 0x5d, 0x69, 0xcb, 0x23, 0xb5, 0xb7, 0x0c, 0xeb, 0xb3, 0x58,
-	0x24, 0xd8, 0x17, 0x76, 0x75, 0x69, 0xd8, 0xae, 0x31, 0xcf, 0x84, 0x4d, 0x31, 0xe8, 0x73, 0xa8,
-	0xd1, 0x81, 0xbf, 0x1f, 0xf1, 0xa1, 0x5d, 0x53, 0xf0, 0xe6, 0x1c, 0xbc, 0xef, 0x76, 0x1f, 0xf1,
-	0x61, 0x1e, 0x5c, 0xa5, 0x03, 0xff, 0x11, 0x1f, 0xa2, 0xbb, 0xb0, 0x22, 0xa1, 0x9c, 0xc4, 0x81,
-	0xbd, 0xa2, 0xb0, 0xad, 0x45, 0xec, 0x1e, 0x89, 0x83, 0x3c, 0x58, 0x46, 0x93, 0x3a, 0x74, 0x1f,
-	0x1a, 0x6c, 0x2c, 0x46, 0x63, 0xb1, 0x2f, 0x0b, 0x6e, 0xd7, 0x97, 0x06, 0xcf, 0x90, 0xdf, 0x29,
-	0x57, 0x0f, 0x34, 0xe4, 0xc9, 0xd1, 0x88, 0xb8, 0x9b, 0xb0, 0xee, 0xa7, 0x66, 0xc5, 0xe1, 0x1c,
-	0x97, 0x60, 0x63, 0x0e, 0x81, 0x7a, 0xb0, 0x91, 0x86, 0x49, 0xab, 0x63, 0x2d, 0x4d, 0xb0, 0xf6,
-	0x4f, 0x6b, 0xd4, 0x2b, 0x78, 0xeb, 0x6c, 0x46, 0x83, 0x7e, 0x80, 0xf3, 0x86, 0x29, 0x4d, 0xde,
-	0xbe, 0x8f, 0xc3, 0x50, 0x1d, 0x8d, 0xc6, 0xad, 0xff, 0x2f, 0xa5, 0xcb, 0x72, 0x8f, 0xc3, 0xb0,
-	0x57, 0xf0, 0x10, 0x5b, 0xd0, 0xa2, 0x7d, 0xb0, 0x0d, 0xad, 0x4c, 0xe6, 0x2c, 0x75, 0x49, 0x51,
-	0x7f, 0xb8, 0x94, 0xba, 0xef, 0x76, 0xe7, 0xd8, 0x2f, 0x68, 0x9e, 0xfe, 0xc0, 0x9f, 0x09, 0xf0,
-	0x55, 0x96, 0x81, 0xac, 0x5a, 0xfa, 0x7c, 0x5e, 0x3d, 0x8d, 0x57, 0xd6, 0xa7, 0x57, 0xf0, 0xd6,
-	0x32, 0x3e, 0x55, 0xb0, 0xbb, 0x59, 0xc1, 0x48, 0x44, 0x85, 0x39, 0xa6, 0x97, 0x96, 0x72, 0x3c,
-	0x8c, 0xa8, 0xac, 0xb5, 0xa9, 0x96, 0x94, 0xdc, 0xb5, 0x99, 0x72, 0x3b, 0x21, 0x6c, 0x2d, 0xb4,
-	0x83, 0x6c, 0x35, 0xc1, 0x4c, 0xf3, 0x15, 0x05, 0x43, 0xe7, 0xa1, 0x12, 0x90, 0x98, 0x45, 0xa6,
-	0xfb, 0xb4, 0x80, 0x76, 0xa0, 0x8a, 0x23, 0x36, 0x8e, 0x85, 0x49, 0x8f, 0x5e, 0x02, 0xe3, 0x6d,
-	0xd9, 0xff, 0x6d, 0xd3, 0xff, 0xed, 0x2e, 0xa3, 0xb1, 0x67, 0x1c, 0x9d, 0x73, 0xb0, 0xa5, 0xfa,
-	0x67, 0xd7, 0xf7, 0x09, 0xe7, 0x8f, 0xc7, 0x83, 0x90, 0xfa, 0xce, 0x2e, 0xa0, 0xbc, 0x32, 0xa1,
-	0x2f, 0xb0, 0x20, 0xe8, 0x26, 0xd4, 0x71, 0x10, 0x24, 0x84, 0x73, 0xc2, 0x6d, 0x6b, 0xbb, 0x74,
-	0xa3, 0xee, 0xae, 0x4d, 0x27, 0xad, 0xfa, 0x6e, 0xaa, 0xf4, 0x4e, 0xec, 0xce, 0x2f, 0xd6, 0x0c,
-	0x87, 0x4a, 0x3b, 0x0b, 0xd1, 0x1d, 0xa8, 0x8e, 0x54, 0x0c, 0x73, 0xd4, 0xb6, 0x97, 0xf5, 0x72,
-	0x7e, 0x2d, 0xb2, 0xa9, 0x34, 0x02, 0xdd, 0x83, 0xda, 0x48, 0x2f, 0xe5, 0x94, 0x83, 0xb5, 0xb8,
-	0x66, 0xd9, 0x55, 0x06, 0x23, 0xd3, 0x8c, 0x95, 0x4d, 0xa7, 0xf9, 0xe7, 0x22, 0xac, 0xcf, 0x4e,
-	0x0e, 0xf4, 0x00, 0xaa, 0xda, 0xc3, 0x2c, 0xee, 0x0c, 0x7e, 0xb3, 0x1f, 0xb7, 0xfe, 0x7a, 0xd2,
-	0x2a, 0x1c, 0xff, 0xf3, 0xdb, 0x27, 0x96, 0x67, 0xb0, 0xe8, 0x3e, 0xac, 0x8c, 0x48, 0x40, 0x12,
-	0x4e, 0xe2, 0x53, 0xd6, 0xf9, 0xd8, 0x98, 0xbb, 0x2c, 0x8a, 0xa8, 0x88, 0xcc, 0xdc, 0x49, 0x41,
-	0xe8, 0x0b, 0xa8, 0x71, 0xff, 0x30, 0x66, 0x49, 0x62, 0xca, 0x38, 0x3f, 0x3b, 0xf6, 0xb4, 0x75,
-	0x8f, 0x0e, 0x63, 0x2c, 0xc6, 0x89, 0xda, 0xa5, 0x41, 0xa0, 0xdb, 0x50, 0x19, 0xc6, 0x38, 0x79,
-	0x6e, 0x0e, 0xf2, 0x95, 0x39, 0xe8, 0xd7, 0xd2, 0xf6, 0xec, 0xf9, 0x9e, 0xfc, 0x27, 0x27, 0xa5,
-	0xf2, 0x95, 0xa9, 0xe1, 0xfe, 0x21, 0x89, 0x88, 0x4e, 0xcd, 0x2e, 0x6c, 0x2d, 0x4c, 0x46, 0x64,
-	0x43, 0xcd, 0x54, 0xd7, 0x1c, 0xc3, 0x54, 0x94, 0x57, 0x43, 0x80, 0x05, 0x56, 0x9b, 0x5d, 0xf5,
-	0xd4, 0xb7, 0x93, 0xc0, 0xc6, 0xdc, 0x74, 0x3c, 0x83, 0xc0, 0x86, 0x9a, 0x7f, 0x88, 0xe3, 0x98,
-	0x84, 0xe6, 0x38, 0xa7, 0x22, 0xfa, 0x1f, 0xd4, 0x46, 0x2c, 0x11, 0xfb, 0x34, 0x48, 0xaf, 0x14,
-	0x29, 0xf6, 0x83, 0x2c, 0x66, 0x39, 0x17, 0xf3, 0xd8, 0x82, 0xcd, 0xf9, 0xb1, 0xfa, 0x7e, 0xa3,
-	0x66, 0x5d, 0x57, 0x5e, 0xde, 0x75, 0x95, 0x77, 0xed, 0x3a, 0x0e, 0xeb, 0xb3, 0x43, 0xf5, 0x8c,
-	0x75, 0xbe, 0xb7, 0x56, 0xef, 0x01, 0x5a, 0x1c, 0xbd, 0x67, 0x27, 0x68, 0x84, 0x8f, 0x42, 0x86,
-	0x03, 0x53, 0xda, 0x54, 0x74, 0x08, 0x5c, 0x58, 0x3a, 0x69, 0xf3, 0x39, 0xb5, 0x66, 0x73, 0x7a,
-	0x2a, 0x59, 0x7e, 0x01, 0xa5, 0x99, 0x05, 0x38, 0x3f, 0x5a, 0xb0, 0x36, 0x33, 0x79, 0xcf, 0xe6,
-	0x4f, 0x59, 0x8a, 0xa7, 0xe4, 0xaf, 0xb4, 0x3c, 0x7f, 0xe5, 0x77, 0xcd, 0xdf, 0x75, 0x80, 0x93,
-	0x19, 0x2e, 0x03, 0x46, 0x84, 0x73, 0x3c, 0x4c, 0xdf, 0x44, 0xa9, 0xe8, 0x50, 0xd8, 0x9c, 0xef,
-	0x50, 0x74, 0x0d, 0x40, 0x4f, 0xb1, 0xfd, 0xe7, 0xe4, 0x48, 0x01, 0x56, 0xbd, 0xba, 0xd6, 0x7c,
-	0x43, 0x8e, 0xd0, 0x55, 0xa8, 0xf3, 0xd4, 0xd7, 0xe4, 0xe7, 0x44, 0x91, 0x0f, 0x55, 0x9a, 0x0d,
-	0x85, 0x01, 0x2d, 0x0e, 0x13, 0xd4, 0x04, 0xf0, 0x33, 0xc9, 0x04, 0xcb, 0x69, 0xd0, 0x4d, 0xd8,
-	0x12, 0x38, 0x19, 0x12, 0x79, 0x5d, 0x67, 0x6e, 0x3a, 0xea, 0xa6, 0x36, 0x9c, 0x90, 0x39, 0x02,
-	0x56, 0xf3, 0x43, 0x03, 0x7d, 0x0c, 0x9b, 0x2f, 0x48, 0x42, 0x0f, 0xa8, 0x8f, 0xe5, 0x0b, 0x31,
-	0xb7, 0x9f, 0x8d, 0xbc, 0x5e, 0xee, 0xea, 0x03, 0x58, 0x33, 0x9b, 0xa6, 0xf1, 0x68, 0x2c, 0xb8,
-	0x89, 0xb1, 0xaa, 0x95, 0x7d, 0xa5, 0x93, 0xe5, 0x19, 0x25, 0x8c, 0x1d, 0xa8, 0xad, 0xad, 0x7a,
-	0x5a, 0x70, 0x7e, 0x2f, 0x42, 0xf9, 0x29, 0x0d, 0x43, 0x74, 0x51, 0xbd, 0x31, 0x55, 0x86, 0xdd,
-	0xea, 0x74, 0xd2, 0x2a, 0xf6, 0x1f, 0xa8, 0xb7, 0xe6, 0x47, 0x50, 0xf3, 0x13, 0x82, 0x05, 0x4b,
-	0x74, 0xbd, 0xdd, 0xc6, 0x74, 0xd2, 0xaa, 0x75, 0xb5, 0xca, 0x4b, 0x6d, 0xe8, 0xaa, 0x79, 0xb6,
-	0xaa, 0xbc, 0xb9, 0x2b, 0xd3, 0x49, 0xab, 0xfc, 0x2d, 0x8e, 0x88, 0x79, 0xc0, 0xee, 0x40, 0x63,
-	0x40, 0x62, 0x72, 0x40, 0x7d, 0x8a, 0x93, 0x23, 0xdd, 0xd5, 0xee, 0xc6, 0x74, 0xd2, 0x6a, 0xb8,
-	0x27, 0x6a, 0x2f, 0xef, 0x83, 0x1c, 0xa8, 0x1e, 0x12, 0x3a, 0x3c, 0xd4, 0xcd, 0x5e, 0x72, 0x61,
-	0x3a, 0x69, 0x55, 0x7b, 0x4a, 0xe3, 0x19, 0x8b, 0xf4, 0x31, 0xef, 0xe0, 0xaa, 0x62, 0x54, 0x3e,
-	0x7b, 0x4a, 0x93, 0xbd, 0x89, 0xbf, 0x57, 0x35, 0xd2, 0x43, 0x8a, 0xdb, 0xb5, 0xed, 0xd2, 0x92,
-	0x7b, 0x62, 0xf1, 0x19, 0xee, 0xae, 0x4f, 0x27, 0x2d, 0xc8, 0x44, 0xee, 0xe5, 0x48, 0xee, 0x94,
-	0xff, 0xfd, 0xb5, 0x65, 0x39, 0xf7, 0xa0, 0x22, 0x13, 0xc7, 0xd1, 0xa7, 0x50, 0x91, 0x2c, 0xfa,
-	0xaa, 0x6e, 0xdc, 0x3a, 0x37, 0x47, 0x2e, 0x9d, 0xdc, 0xfa, 0x74, 0xd2, 0xd2, 0xee, 0x9e, 0x76,
-	0x76, 0xae, 0x40, 0x4d, 0xca, 0xfd, 0x80, 0xa3, 0x4d, 0x28, 0xd1, 0xc0, 0xdc, 0xf4, 0x9e, 0xfc,
-	0x74, 0x7b, 0xaf, 0xff, 0x6e, 0x16, 0x8e, 0xa7, 0x4d, 0xeb, 0xf5, 0xb4, 0x69, 0xbd, 0x99, 0x36,
-	0xad, 0xbf, 0xa6, 0x4d, 0xeb, 0xa7, 0xb7, 0xcd, 0xc2, 0x9b, 0xb7, 0xcd, 0xc2, 0x9f, 0x6f, 0x9b,
-	0x85, 0x67, 0xd7, 0x87, 0x54, 0x1c, 0x8e, 0x07, 0x6d, 0x9f, 0x45, 0x9d, 0x2e, 0xe3, 0xd1, 0x53,
-	0xf5, 0xdb, 0x05, 0xf3, 0x28, 0xe8, 0xbc, 0xca, 0xfd, 0x86, 0x19, 0x54, 0xd5, 0x8f, 0x8d, 0xdb,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xda, 0xfd, 0x3a, 0xe1, 0x0c, 0x00, 0x00,
+func (m *OutputTransfer) Reset()         { *m = OutputTransfer{} }
+func (m *OutputTransfer) String() string { return proto.CompactTextString(m) }
+func (*OutputTransfer) ProtoMessage()    {}
+func (*OutputTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{21}
 }
 
-func (this *ExecutionComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ExecutionComponent)
-	if !ok {
-		that2, ok := that.(ExecutionComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if that1.ComponentType == nil {
-		if this.ComponentType != nil {
-			return false
-		}
-	} else if this.ComponentType == nil {
-		return false
-	} else if !this.ComponentType.Equal(that1.ComponentType) {
-		return false
-	}
-	if !this.OutputType.Equal(that1.OutputType) {
-		return false
-	}
-	return true
+func (m *OutputTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (this *ExecutionComponent_Transfer) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ExecutionComponent_Transfer)
-	if !ok {
-		that2, ok := that.(ExecutionComponent_Transfer)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *OutputTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Transfer.Equal(that1.Transfer) {
-		return false
-	}
-	return true
 }
 
-func (this *ExecutionComponent_Claim) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputTransfer.Merge(m, src)
+}
 
-	that1, ok := that.(*ExecutionComponent_Claim)
-	if !ok {
-		that2, ok := that.(ExecutionComponent_Claim)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Claim.Equal(that1.Claim) {
-		return false
-	}
-	return true
+func (m *OutputTransfer) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ExecutionComponent_Contract) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ExecutionComponent_Contract)
-	if !ok {
-		that2, ok := that.(ExecutionComponent_Contract)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Contract.Equal(that1.Contract) {
-		return false
-	}
-	return true
+func (m *OutputTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputTransfer.DiscardUnknown(m)
 }
 
-func (this *ExecutionComponent_IbcMsg) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_OutputTransfer proto.InternalMessageInfo
 
-	that1, ok := that.(*ExecutionComponent_IbcMsg)
-	if !ok {
-		that2, ok := that.(ExecutionComponent_IbcMsg)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.IbcMsg.Equal(that1.IbcMsg) {
-		return false
-	}
-	return true
+// output for contract call
+type OutputContractCall struct {
+	// contract address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// data to be passed to the contract
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (this *ExecutionComponent_IbcSend) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ExecutionComponent_IbcSend)
-	if !ok {
-		that2, ok := that.(ExecutionComponent_IbcSend)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.IbcSend.Equal(that1.IbcSend) {
-		return false
-	}
-	return true
+func (m *OutputContractCall) Reset()         { *m = OutputContractCall{} }
+func (m *OutputContractCall) String() string { return proto.CompactTextString(m) }
+func (*OutputContractCall) ProtoMessage()    {}
+func (*OutputContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{22}
 }
 
-func (this *ComponentOutput) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*ComponentOutput)
-	if !ok {
-		that2, ok := that.(ComponentOutput)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.OutputType == nil {
-		if this.OutputType != nil {
-			return false
+func (m *OutputContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.OutputType == nil {
-		return false
-	} else if !this.OutputType.Equal(that1.OutputType) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
 
-func (this *ComponentOutput_OutputTransfer) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputContractCall.Merge(m, src)
+}
 
-	that1, ok := that.(*ComponentOutput_OutputTransfer)
-	if !ok {
-		that2, ok := that.(ComponentOutput_OutputTransfer)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OutputTransfer.Equal(that1.OutputTransfer) {
-		return false
-	}
-	return true
+func (m *OutputContractCall) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ComponentOutput_OutputContractCall) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputContractCall.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ComponentOutput_OutputContractCall)
-	if !ok {
-		that2, ok := that.(ComponentOutput_OutputContractCall)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OutputContractCall.Equal(that1.OutputContractCall) {
-		return false
-	}
-	return true
+var xxx_messageInfo_OutputContractCall proto.InternalMessageInfo
+
+// output for IBC contract call
+type OutputIBCContractCall struct {
+	// channel
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// IBC contract call payload
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// address
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (this *ComponentOutput_OutputIbcContractCall) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCContractCall) Reset()         { *m = OutputIBCContractCall{} }
+func (m *OutputIBCContractCall) String() string { return proto.CompactTextString(m) }
+func (*OutputIBCContractCall) ProtoMessage()    {}
+func (*OutputIBCContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{23}
+}
 
-	that1, ok := that.(*ComponentOutput_OutputIbcContractCall)
-	if !ok {
-		that2, ok := that.(ComponentOutput_OutputIbcContractCall)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *OutputIBCContractCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OutputIBCContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputIBCContractCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OutputIbcContractCall.Equal(that1.OutputIbcContractCall) {
-		return false
-	}
-	return true
 }
 
-func (this *ComponentOutput_OutputIbcSend) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputIBCContractCall.Merge(m, src)
+}
 
-	that1, ok := that.(*ComponentOutput_OutputIbcSend)
-	if !ok {
-		that2, ok := that.(ComponentOutput_OutputIbcSend)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OutputIbcSend.Equal(that1.OutputIbcSend) {
-		return false
-	}
-	return true
+func (m *OutputIBCContractCall) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ComponentOutput_OutputEmit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputIBCContractCall.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ComponentOutput_OutputEmit)
-	if !ok {
-		that2, ok := that.(ComponentOutput_OutputEmit)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.OutputEmit.Equal(that1.OutputEmit) {
-		return false
-	}
-	return true
+var xxx_messageInfo_OutputIBCContractCall proto.InternalMessageInfo
+
+// output for IBC contract call
+type OutputIBCSend struct {
+	// channel
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// denom to send
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amout to send
+	Amount *types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// memo passed along with the ICS-20 transfer
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (this *TransferComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCSend) Reset()         { *m = OutputIBCSend{} }
+func (m *OutputIBCSend) String() string { return proto.CompactTextString(m) }
+func (*OutputIBCSend) ProtoMessage()    {}
+func (*OutputIBCSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{24}
+}
 
-	that1, ok := that.(*TransferComponent)
-	if !ok {
-		that2, ok := that.(TransferComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *OutputIBCSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OutputIBCSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputIBCSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.To != that1.To {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
 
-func (this *ClaimAccessPublic) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputIBCSend.Merge(m, src)
+}
 
-	that1, ok := that.(*ClaimAccessPublic)
-	if !ok {
-		that2, ok := that.(ClaimAccessPublic)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+func (m *OutputIBCSend) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ClaimAccessPrivate) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputIBCSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputIBCSend.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ClaimAccessPrivate)
-	if !ok {
-		that2, ok := that.(ClaimAccessPrivate)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Addresses) != len(that1.Addresses) {
-		return false
-	}
-	for i := range this.Addresses {
-		if this.Addresses[i] != that1.Addresses[i] {
-			return false
-		}
-	}
-	return true
+var xxx_messageInfo_OutputIBCSend proto.InternalMessageInfo
+
+// output for emitting event
+type OutputEmit struct {
+	// event
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (this *ClaimAccessControl) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputEmit) Reset()         { *m = OutputEmit{} }
+func (m *OutputEmit) String() string { return proto.CompactTextString(m) }
+func (*OutputEmit) ProtoMessage()    {}
+func (*OutputEmit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{25}
+}
 
-	that1, ok := that.(*ClaimAccessControl)
-	if !ok {
-		that2, ok := that.(ClaimAccessControl)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.AccessType == nil {
-		if this.AccessType != nil {
-			return false
+func (m *OutputEmit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OutputEmit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputEmit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.AccessType == nil {
-		return false
-	} else if !this.AccessType.Equal(that1.AccessType) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
 
-func (this *ClaimAccessControl_Public) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputEmit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputEmit.Merge(m, src)
+}
 
-	that1, ok := that.(*ClaimAccessControl_Public)
-	if !ok {
-		that2, ok := that.(ClaimAccessControl_Public)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Public.Equal(that1.Public) {
-		return false
-	}
-	return true
+func (m *OutputEmit) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *ClaimAccessControl_Private) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *OutputEmit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputEmit.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ClaimAccessControl_Private)
-	if !ok {
-		that2, ok := that.(ClaimAccessControl_Private)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_OutputEmit proto.InternalMessageInfo
+
+// CLAIM TYPES
+// SchnorrSignature is used for claims that require a Schnorr signature.
+// Claims are signed over the claim digest of the chain ID, will ID, component
+// ID and claimer address with the key the component commits to.
+type SchnorrSignature struct {
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *SchnorrSignature) Reset()         { *m = SchnorrSignature{} }
+func (m *SchnorrSignature) String() string { return proto.CompactTextString(m) }
+func (*SchnorrSignature) ProtoMessage()    {}
+func (*SchnorrSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{26}
+}
+
+func (m *SchnorrSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SchnorrSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchnorrSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Private.Equal(that1.Private) {
-		return false
-	}
-	return true
 }
 
-func (this *ClaimComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ClaimComponent)
-	if !ok {
-		that2, ok := that.(ClaimComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Access.Equal(&that1.Access) {
-		return false
-	}
-	if that1.SchemeType == nil {
-		if this.SchemeType != nil {
-			return false
-		}
-	} else if this.SchemeType == nil {
-		return false
-	} else if !this.SchemeType.Equal(that1.SchemeType) {
-		return false
-	}
-	return true
+func (m *SchnorrSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchnorrSignature.Merge(m, src)
 }
 
-func (this *ClaimComponent_Pedersen) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SchnorrSignature) XXX_Size() int {
+	return m.Size()
+}
 
-	that1, ok := that.(*ClaimComponent_Pedersen)
-	if !ok {
-		that2, ok := that.(ClaimComponent_Pedersen)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Pedersen.Equal(that1.Pedersen) {
-		return false
-	}
-	return true
+func (m *SchnorrSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchnorrSignature.DiscardUnknown(m)
 }
 
-func (this *ClaimComponent_Schnorr) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_SchnorrSignature proto.InternalMessageInfo
 
-	that1, ok := that.(*ClaimComponent_Schnorr)
-	if !ok {
-		that2, ok := that.(ClaimComponent_Schnorr)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Schnorr.Equal(that1.Schnorr) {
-		return false
-	}
-	return true
+// ThresholdSchnorr is used for claims that a threshold of guardians must
+// approve. Guardians sign the threshold claim digest with the MuSig aggregate
+// key of the signing guardians, together in one claim or in several claims
+// that the chain collects until enough guardians approved.
+type ThresholdSchnorr struct {
+	// The 32 byte edwards25519 public keys of the guardians, in the order the
+	// key aggregation coefficients are computed over.
+	PublicKeys [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	// The number of distinct guardians that must approve before the component
+	// is claimed.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (this *ClaimComponent_Gnark) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ClaimComponent_Gnark)
-	if !ok {
-		that2, ok := that.(ClaimComponent_Gnark)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Gnark.Equal(that1.Gnark) {
-		return false
-	}
-	return true
+func (m *ThresholdSchnorr) Reset()         { *m = ThresholdSchnorr{} }
+func (m *ThresholdSchnorr) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSchnorr) ProtoMessage()    {}
+func (*ThresholdSchnorr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{27}
 }
 
-func (this *ContractComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ThresholdSchnorr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*ContractComponent)
-	if !ok {
-		that2, ok := that.(ContractComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ThresholdSchnorr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSchnorr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
-	}
-	return true
 }
 
-func (this *IBCMsgComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ThresholdSchnorr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSchnorr.Merge(m, src)
+}
 
-	that1, ok := that.(*IBCMsgComponent)
-	if !ok {
-		that2, ok := that.(IBCMsgComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Channel != that1.Channel {
-		return false
-	}
-	if this.PortId != that1.PortId {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
-	}
-	return true
+func (m *ThresholdSchnorr) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *IBCSendComponent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ThresholdSchnorr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSchnorr.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*IBCSendComponent)
-	if !ok {
-		that2, ok := that.(IBCSendComponent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Channel != that1.Channel {
-		return false
-	}
-	if this.PortId != that1.PortId {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
+var xxx_messageInfo_ThresholdSchnorr proto.InternalMessageInfo
+
+// PedersenCommitment enables the use of a Pedersen commitment for claims.
+// Claims open the commitment, it is computed with the fixed generator H of
+// the pedersen scheme.
+type PedersenCommitment struct {
+	Commitment       []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	TargetCommitment []byte `protobuf:"bytes,2,opt,name=target_commitment,json=targetCommitment,proto3" json:"target_commitment,omitempty"`
+	// bind_claimer commits to the value bound to the claimer's address, so only
+	// that claimer can use the opening.
+	BindClaimer bool `protobuf:"varint,3,opt,name=bind_claimer,json=bindClaimer,proto3" json:"bind_claimer,omitempty"`
 }
 
-func (this *OutputTransfer) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PedersenCommitment) Reset()         { *m = PedersenCommitment{} }
+func (m *PedersenCommitment) String() string { return proto.CompactTextString(m) }
+func (*PedersenCommitment) ProtoMessage()    {}
+func (*PedersenCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{28}
+}
 
-	that1, ok := that.(*OutputTransfer)
-	if !ok {
-		that2, ok := that.(OutputTransfer)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *PedersenCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PedersenCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PedersenCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
 
-func (this *OutputContractCall) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PedersenCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PedersenCommitment.Merge(m, src)
+}
 
-	that1, ok := that.(*OutputContractCall)
-	if !ok {
-		that2, ok := that.(OutputContractCall)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if !bytes.Equal(this.Payload, that1.Payload) {
-		return false
-	}
-	return true
+func (m *PedersenCommitment) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *OutputIBCContractCall) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PedersenCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PedersenCommitment.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*OutputIBCContractCall)
-	if !ok {
-		that2, ok := that.(OutputIBCContractCall)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Channel != that1.Channel {
-		return false
-	}
-	if !bytes.Equal(this.Payload, that1.Payload) {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	return true
+var xxx_messageInfo_PedersenCommitment proto.InternalMessageInfo
+
+// GnarkZkSnark is for claims using zero-knowledge succinct non-interactive
+// arguments of knowledge.
+type GnarkZkSnark struct {
+	VerificationKey []byte `protobuf:"bytes,1,opt,name=verification_key,json=verificationKey,proto3" json:"verification_key,omitempty"`
+	// Public inputs the proof is verified against, every one the verification
+	// key takes but the last, which binds the proof to the claimer's address.
+	PublicInputs []byte `protobuf:"bytes,2,opt,name=public_inputs,json=publicInputs,proto3" json:"public_inputs,omitempty"`
+	Proof        []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (this *OutputIBCSend) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *GnarkZkSnark) Reset()         { *m = GnarkZkSnark{} }
+func (m *GnarkZkSnark) String() string { return proto.CompactTextString(m) }
+func (*GnarkZkSnark) ProtoMessage()    {}
+func (*GnarkZkSnark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{29}
+}
 
-	that1, ok := that.(*OutputIBCSend)
-	if !ok {
-		that2, ok := that.(OutputIBCSend)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GnarkZkSnark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GnarkZkSnark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnarkZkSnark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Channel != that1.Channel {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !this.Amount.Equal(that1.Amount) {
-		return false
-	}
-	return true
 }
 
-func (this *OutputEmit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *GnarkZkSnark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnarkZkSnark.Merge(m, src)
+}
 
-	that1, ok := that.(*OutputEmit)
-	if !ok {
-		that2, ok := that.(OutputEmit)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Message != that1.Message {
-		return false
-	}
-	return true
+func (m *GnarkZkSnark) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *SchnorrSignature) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *GnarkZkSnark) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnarkZkSnark.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SchnorrSignature)
-	if !ok {
-		that2, ok := that.(SchnorrSignature)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
-		return false
-	}
-	if !bytes.Equal(this.Signature, that1.Signature) {
-		return false
-	}
-	if this.Message != that1.Message {
-		return false
-	}
-	return true
+var xxx_messageInfo_GnarkZkSnark proto.InternalMessageInfo
+
+// CustomClaimScheme holds the component data of a claim scheme that is
+// registered with the will keeper at app wiring rather than built in.
+type CustomClaimScheme struct {
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (this *PedersenCommitment) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CustomClaimScheme) Reset()         { *m = CustomClaimScheme{} }
+func (m *CustomClaimScheme) String() string { return proto.CompactTextString(m) }
+func (*CustomClaimScheme) ProtoMessage()    {}
+func (*CustomClaimScheme) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{30}
+}
 
-	that1, ok := that.(*PedersenCommitment)
-	if !ok {
-		that2, ok := that.(PedersenCommitment)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CustomClaimScheme) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CustomClaimScheme) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomClaimScheme.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Commitment, that1.Commitment) {
-		return false
-	}
-	if !bytes.Equal(this.TargetCommitment, that1.TargetCommitment) {
-		return false
-	}
-	return true
 }
 
-func (this *GnarkZkSnark) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CustomClaimScheme) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomClaimScheme.Merge(m, src)
+}
 
-	that1, ok := that.(*GnarkZkSnark)
-	if !ok {
-		that2, ok := that.(GnarkZkSnark)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.VerificationKey, that1.VerificationKey) {
-		return false
-	}
-	if !bytes.Equal(this.PublicInputs, that1.PublicInputs) {
-		return false
-	}
-	if !bytes.Equal(this.Proof, that1.Proof) {
-		return false
-	}
-	return true
+func (m *CustomClaimScheme) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *Will) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CustomClaimScheme) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomClaimScheme.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Will)
-	if !ok {
-		that2, ok := that.(Will)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.Beneficiary != that1.Beneficiary {
-		return false
-	}
-	if this.Height != that1.Height {
-		return false
-	}
-	if this.Status != that1.Status {
-		return false
-	}
-	if len(this.Components) != len(that1.Components) {
-		return false
-	}
-	for i := range this.Components {
-		if !this.Components[i].Equal(that1.Components[i]) {
-			return false
-		}
-	}
-	return true
-}
+var xxx_messageInfo_CustomClaimScheme proto.InternalMessageInfo
 
-func (this *Wills) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// Will represents the entire structure of a will.
+type Will struct {
+	ID               string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator          string                `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name             string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Beneficiary      string                `protobuf:"bytes,4,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Height           int64                 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Status           string                `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Components       []*ExecutionComponent `protobuf:"bytes,7,rep,name=components,proto3" json:"components,omitempty"`
+	InactivityWindow int64                 `protobuf:"varint,8,opt,name=inactivity_window,json=inactivityWindow,proto3" json:"inactivity_window,omitempty"`
+	// forward by, zero disables check-ins
+	LastCheckIn        int64         `protobuf:"varint,9,opt,name=last_check_in,json=lastCheckIn,proto3" json:"last_check_in,omitempty"`
+	TriggerTime        *time.Time    `protobuf:"bytes,10,opt,name=trigger_time,json=triggerTime,proto3,stdtime" json:"trigger_time,omitempty"`
+	InactivityDuration time.Duration `protobuf:"bytes,11,opt,name=inactivity_duration,json=inactivityDuration,proto3,stdduration" json:"inactivity_duration"`
+	// check-ins of wills with a trigger time
+	Condition      *WasmCondition `protobuf:"bytes,12,opt,name=condition,proto3" json:"condition,omitempty"`
+	ExecutionMode  ExecutionMode  `protobuf:"varint,13,opt,name=execution_mode,json=executionMode,proto3,enum=cosmwasm.will.ExecutionMode" json:"execution_mode,omitempty"`
+	RemoteChannels []string       `protobuf:"bytes,14,rep,name=remote_channels,json=remoteChannels,proto3" json:"remote_channels,omitempty"`
+}
 
-	that1, ok := that.(*Wills)
-	if !ok {
-		that2, ok := that.(Wills)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Wills) != len(that1.Wills) {
-		return false
-	}
-	for i := range this.Wills {
-		if !this.Wills[i].Equal(that1.Wills[i]) {
-			return false
-		}
-	}
-	return true
+func (m *Will) Reset()         { *m = Will{} }
+func (m *Will) String() string { return proto.CompactTextString(m) }
+func (*Will) ProtoMessage()    {}
+func (*Will) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{31}
 }
 
-func (this *WillIds) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Will) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*WillIds)
-	if !ok {
-		that2, ok := that.(WillIds)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Ids) != len(that1.Ids) {
-		return false
-	}
-	for i := range this.Ids {
-		if this.Ids[i] != that1.Ids[i] {
-			return false
+func (m *Will) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Will.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
 
-func (m *ExecutionComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Will) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Will.Merge(m, src)
 }
 
-func (m *ExecutionComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Will) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ExecutionComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OutputType != nil {
-		{
-			size, err := m.OutputType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.ComponentType != nil {
-		{
-			size := m.ComponentType.Size()
-			i -= size
-			if _, err := m.ComponentType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Will) XXX_DiscardUnknown() {
+	xxx_messageInfo_Will.DiscardUnknown(m)
 }
 
-func (m *ExecutionComponent_Transfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Will proto.InternalMessageInfo
+
+// ExecutionReceipt records what a component did when its will fired.
+type ExecutionReceipt struct {
+	WillId      string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// index is the position of the component in the will
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// status is the status of the component after the will fired: executed,
+	// active, vesting, pending, failed, skipped or reverted
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// error explains why the component failed or was skipped
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used is the gas the component used
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// data is the response data of a contract call
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// height is the block height the will fired at
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ExecutionReceipt) Reset()         { *m = ExecutionReceipt{} }
+func (m *ExecutionReceipt) String() string { return proto.CompactTextString(m) }
+func (*ExecutionReceipt) ProtoMessage()    {}
+func (*ExecutionReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{32}
+}
+
+func (m *ExecutionReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ExecutionComponent_Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Transfer != nil {
-		{
-			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *ExecutionReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ExecutionComponent_Claim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutionReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionReceipt.Merge(m, src)
 }
 
-func (m *ExecutionComponent_Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Claim != nil {
-		{
-			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	return len(dAtA) - i, nil
+func (m *ExecutionReceipt) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ExecutionComponent_Contract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutionReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionReceipt.DiscardUnknown(m)
 }
 
-func (m *ExecutionComponent_Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ExecutionReceipt proto.InternalMessageInfo
+
+// PendingPacket links an IBC packet a will component sent to the component,
+// until the packet is acknowledged or times out.
+type PendingPacket struct {
+	// channel is the source channel of the packet
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sequence is the sequence of the packet on its source channel
+	Sequence    uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	WillId      string `protobuf:"bytes,3,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,4,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// settled_status is the status the component moves to once the packet is
+	// acknowledged, a packet that fails or times out moves it to failed
+	SettledStatus string `protobuf:"bytes,5,opt,name=settled_status,json=settledStatus,proto3" json:"settled_status,omitempty"`
+	// attempt counts the times the packet was sent again after a timeout
+	Attempt uint32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
-func (m *ExecutionComponent_IbcMsg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingPacket) Reset()         { *m = PendingPacket{} }
+func (m *PendingPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingPacket) ProtoMessage()    {}
+func (*PendingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{33}
 }
 
-func (m *ExecutionComponent_IbcMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.IbcMsg != nil {
-		{
-			size, err := m.IbcMsg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *PendingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PendingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ExecutionComponent_IbcSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacket.Merge(m, src)
 }
 
-func (m *ExecutionComponent_IbcSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.IbcSend != nil {
-		{
-			size, err := m.IbcSend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	return len(dAtA) - i, nil
+func (m *PendingPacket) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ComponentOutput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PendingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacket.DiscardUnknown(m)
 }
 
-func (m *ComponentOutput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PendingPacket proto.InternalMessageInfo
+
+// PacketRetry schedules the packet of a component that timed out to be sent
+// again.
+type PacketRetry struct {
+	WillId      string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	ComponentId string `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// height is the block height the packet is sent again at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// attempt counts the times the packet was sent again, this one included
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
-func (m *ComponentOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OutputType != nil {
-		{
-			size := m.OutputType.Size()
-			i -= size
-			if _, err := m.OutputType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *PacketRetry) Reset()         { *m = PacketRetry{} }
+func (m *PacketRetry) String() string { return proto.CompactTextString(m) }
+func (*PacketRetry) ProtoMessage()    {}
+func (*PacketRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{34}
 }
 
-func (m *ComponentOutput_OutputTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PacketRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ComponentOutput_OutputTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OutputTransfer != nil {
-		{
-			size, err := m.OutputTransfer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *PacketRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ComponentOutput_OutputContractCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PacketRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketRetry.Merge(m, src)
 }
 
-func (m *ComponentOutput_OutputContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OutputContractCall != nil {
-		{
-			size, err := m.OutputContractCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *PacketRetry) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ComponentOutput_OutputIbcContractCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PacketRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketRetry.DiscardUnknown(m)
 }
 
-func (m *ComponentOutput_OutputIbcContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OutputIbcContractCall != nil {
-		{
-			size, err := m.OutputIbcContractCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+var xxx_messageInfo_PacketRetry proto.InternalMessageInfo
+
+// WasmCondition triggers a will when the JSON result of a smart query of a
+// wasm contract compares true against a value.
+type WasmCondition struct {
+	// contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// JSON smart query message
+	QueryMsg []byte `protobuf:"bytes,2,opt,name=query_msg,json=queryMsg,proto3" json:"query_msg,omitempty"`
+	// dot separated path to the value compared within the query result, array
+	// elements are selected by index, empty compares the whole result
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// comparison of the selected value with the condition value
+	Operator ConditionOperator `protobuf:"varint,4,opt,name=operator,proto3,enum=cosmwasm.will.ConditionOperator" json:"operator,omitempty"`
+	// JSON value compared against, numbers may also be given as strings
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// number of blocks between evaluations, zero evaluates every block
+	Interval int64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// gas the query may use, zero uses the max_condition_gas param
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// next_check_height is the block height the condition is evaluated at
+	// next, set by the chain
+	NextCheckHeight int64 `protobuf:"varint,8,opt,name=next_check_height,json=nextCheckHeight,proto3" json:"next_check_height,omitempty"`
+}
+
+func (m *WasmCondition) Reset()         { *m = WasmCondition{} }
+func (m *WasmCondition) String() string { return proto.CompactTextString(m) }
+func (*WasmCondition) ProtoMessage()    {}
+func (*WasmCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{35}
+}
+
+func (m *WasmCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WasmCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ComponentOutput_OutputIbcSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WasmCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmCondition.Merge(m, src)
 }
 
-func (m *ComponentOutput_OutputIbcSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OutputIbcSend != nil {
-		{
-			size, err := m.OutputIbcSend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func (m *WasmCondition) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ComponentOutput_OutputEmit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WasmCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmCondition.DiscardUnknown(m)
 }
 
-func (m *ComponentOutput_OutputEmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OutputEmit != nil {
-		{
-			size, err := m.OutputEmit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_WasmCondition proto.InternalMessageInfo
+
+// type to hold wills
+type Wills struct {
+	// the set of wills to return
+	Wills []*Will `protobuf:"bytes,1,rep,name=wills,proto3" json:"wills,omitempty"`
 }
 
-func (m *TransferComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Wills) Reset()         { *m = Wills{} }
+func (m *Wills) String() string { return proto.CompactTextString(m) }
+func (*Wills) ProtoMessage()    {}
+func (*Wills) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{36}
 }
 
-func (m *TransferComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Wills) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *TransferComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *Wills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Wills.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ClaimAccessPublic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Wills) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Wills.Merge(m, src)
 }
 
-func (m *ClaimAccessPublic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Wills) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ClaimAccessPublic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Wills) XXX_DiscardUnknown() {
+	xxx_messageInfo_Wills.DiscardUnknown(m)
 }
 
-func (m *ClaimAccessPrivate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_Wills proto.InternalMessageInfo
+
+// WillEscrow tracks the assets a will holds in the will module account.
+type WillEscrow struct {
+	// id of the will the assets are held for
+	WillId string `protobuf:"bytes,1,opt,name=will_id,json=willId,proto3" json:"will_id,omitempty"`
+	// coins still held for the will's components
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// creation deposit held for the will, returned once it fires or is
+	// cancelled
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (m *ClaimAccessPrivate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillEscrow) Reset()         { *m = WillEscrow{} }
+func (m *WillEscrow) String() string { return proto.CompactTextString(m) }
+func (*WillEscrow) ProtoMessage()    {}
+func (*WillEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{37}
 }
 
-func (m *ClaimAccessPrivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ClaimAccessControl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ClaimAccessControl) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ClaimAccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AccessType != nil {
-		{
-			size := m.AccessType.Size()
-			i -= size
-			if _, err := m.AccessType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *WillEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ClaimAccessControl_Public) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillEscrow.Merge(m, src)
 }
 
-func (m *ClaimAccessControl_Public) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Public != nil {
-		{
-			size, err := m.Public.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *WillEscrow) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ClaimAccessControl_Private) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillEscrow.DiscardUnknown(m)
 }
 
-func (m *ClaimAccessControl_Private) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Private != nil {
-		{
-			size, err := m.Private.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_WillEscrow proto.InternalMessageInfo
+
+// WillIds represents a list of will IDs.
+type WillIds struct {
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *ClaimComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *WillIds) Reset()         { *m = WillIds{} }
+func (m *WillIds) String() string { return proto.CompactTextString(m) }
+func (*WillIds) ProtoMessage()    {}
+func (*WillIds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cec37ad7aa1ffe0b, []int{38}
 }
 
-func (m *ClaimComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillIds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ClaimComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SchemeType != nil {
-		{
-			size := m.SchemeType.Size()
-			i -= size
-			if _, err := m.SchemeType.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	{
-		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+func (m *WillIds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WillIds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ClaimComponent_Pedersen) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimComponent_Pedersen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Pedersen != nil {
-		{
-			size, err := m.Pedersen.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *WillIds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WillIds.Merge(m, src)
 }
 
-func (m *ClaimComponent_Schnorr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WillIds) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ClaimComponent_Schnorr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Schnorr != nil {
-		{
-			size, err := m.Schnorr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
+func (m *WillIds) XXX_DiscardUnknown() {
+	xxx_messageInfo_WillIds.DiscardUnknown(m)
 }
 
-func (m *ClaimComponent_Gnark) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_WillIds proto.InternalMessageInfo
 
-func (m *ClaimComponent_Gnark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Gnark != nil {
-		{
-			size, err := m.Gnark.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("cosmwasm.will.DistributionRemainder", DistributionRemainder_name, DistributionRemainder_value)
+	proto.RegisterEnum("cosmwasm.will.ExecutionMode", ExecutionMode_name, ExecutionMode_value)
+	proto.RegisterEnum("cosmwasm.will.ConditionOperator", ConditionOperator_name, ConditionOperator_value)
+	proto.RegisterType((*ExecutionComponent)(nil), "cosmwasm.will.ExecutionComponent")
+	proto.RegisterType((*ComponentOutput)(nil), "cosmwasm.will.ComponentOutput")
+	proto.RegisterType((*TransferComponent)(nil), "cosmwasm.will.TransferComponent")
+	proto.RegisterType((*ClaimAccessPublic)(nil), "cosmwasm.will.ClaimAccessPublic")
+	proto.RegisterType((*ClaimAccessPrivate)(nil), "cosmwasm.will.ClaimAccessPrivate")
+	proto.RegisterType((*ClaimAccessControl)(nil), "cosmwasm.will.ClaimAccessControl")
+	proto.RegisterType((*ClaimComponent)(nil), "cosmwasm.will.ClaimComponent")
+	proto.RegisterType((*ClaimWindow)(nil), "cosmwasm.will.ClaimWindow")
+	proto.RegisterType((*ContractComponent)(nil), "cosmwasm.will.ContractComponent")
+	proto.RegisterType((*IBCMsgComponent)(nil), "cosmwasm.will.IBCMsgComponent")
+	proto.RegisterType((*IBCSendComponent)(nil), "cosmwasm.will.IBCSendComponent")
+	proto.RegisterType((*ICAComponent)(nil), "cosmwasm.will.ICAComponent")
+	proto.RegisterType((*RetryPolicy)(nil), "cosmwasm.will.RetryPolicy")
+	proto.RegisterType((*DistributionComponent)(nil), "cosmwasm.will.DistributionComponent")
+	proto.RegisterType((*DistributionShare)(nil), "cosmwasm.will.DistributionShare")
+	proto.RegisterType((*DistributionCoins)(nil), "cosmwasm.will.DistributionCoins")
+	proto.RegisterType((*VestingComponent)(nil), "cosmwasm.will.VestingComponent")
+	proto.RegisterType((*LinearVesting)(nil), "cosmwasm.will.LinearVesting")
+	proto.RegisterType((*CliffVesting)(nil), "cosmwasm.will.CliffVesting")
+	proto.RegisterType((*PeriodicVesting)(nil), "cosmwasm.will.PeriodicVesting")
+	proto.RegisterType((*VestingPeriod)(nil), "cosmwasm.will.VestingPeriod")
+	proto.RegisterType((*OutputTransfer)(nil), "cosmwasm.will.OutputTransfer")
+	proto.RegisterType((*OutputContractCall)(nil), "cosmwasm.will.OutputContractCall")
+	proto.RegisterType((*OutputIBCContractCall)(nil), "cosmwasm.will.OutputIBCContractCall")
+	proto.RegisterType((*OutputIBCSend)(nil), "cosmwasm.will.OutputIBCSend")
+	proto.RegisterType((*OutputEmit)(nil), "cosmwasm.will.OutputEmit")
+	proto.RegisterType((*SchnorrSignature)(nil), "cosmwasm.will.SchnorrSignature")
+	proto.RegisterType((*ThresholdSchnorr)(nil), "cosmwasm.will.ThresholdSchnorr")
+	proto.RegisterType((*PedersenCommitment)(nil), "cosmwasm.will.PedersenCommitment")
+	proto.RegisterType((*GnarkZkSnark)(nil), "cosmwasm.will.GnarkZkSnark")
+	proto.RegisterType((*CustomClaimScheme)(nil), "cosmwasm.will.CustomClaimScheme")
+	proto.RegisterType((*Will)(nil), "cosmwasm.will.Will")
+	proto.RegisterType((*ExecutionReceipt)(nil), "cosmwasm.will.ExecutionReceipt")
+	proto.RegisterType((*PendingPacket)(nil), "cosmwasm.will.PendingPacket")
+	proto.RegisterType((*PacketRetry)(nil), "cosmwasm.will.PacketRetry")
+	proto.RegisterType((*WasmCondition)(nil), "cosmwasm.will.WasmCondition")
+	proto.RegisterType((*Wills)(nil), "cosmwasm.will.Wills")
+	proto.RegisterType((*WillEscrow)(nil), "cosmwasm.will.WillEscrow")
+	proto.RegisterType((*WillIds)(nil), "cosmwasm.will.WillIds")
 }
 
-func (m *ContractComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("cosmwasm/will/types.proto", fileDescriptor_cec37ad7aa1ffe0b) }
 
-func (m *ContractComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_cec37ad7aa1ffe0b = []byte{
+	// 2825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4b, 0x73, 0x23, 0x47,
+	0xd9, 0xb2, 0xde, 0x9f, 0x1e, 0x96, 0x7b, 0x1f, 0x99, 0x7d, 0xc4, 0x72, 0x26, 0xd9, 0x64, 0xd9,
+	0x80, 0xcd, 0x6e, 0x08, 0x05, 0x79, 0xb0, 0x65, 0xc9, 0xda, 0x58, 0x61, 0xd7, 0xde, 0xb4, 0xb5,
+	0x59, 0x08, 0x87, 0xa9, 0xd1, 0x4c, 0x5b, 0xee, 0x78, 0x1e, 0xca, 0xf4, 0xc8, 0x5e, 0x17, 0xc5,
+	0x8d, 0x13, 0x5c, 0xc2, 0x81, 0x2a, 0x4e, 0x54, 0x8e, 0x29, 0x8a, 0x03, 0xbf, 0x81, 0x53, 0x6e,
+	0xe4, 0x44, 0xc1, 0xc5, 0x01, 0xe5, 0x00, 0x27, 0xce, 0x70, 0xa3, 0xfa, 0x31, 0xa3, 0x19, 0x3d,
+	0x9c, 0xa5, 0x60, 0xb9, 0xd8, 0xd3, 0xdf, 0xbb, 0xbf, 0xfe, 0xfa, 0x7b, 0xb4, 0xe0, 0x8a, 0xe5,
+	0x33, 0xf7, 0xc4, 0x64, 0xee, 0xe6, 0x09, 0x75, 0x9c, 0xcd, 0xf0, 0x74, 0x48, 0xd8, 0xc6, 0x30,
+	0xf0, 0x43, 0x1f, 0xd5, 0x22, 0xd4, 0x06, 0x47, 0x5d, 0xbd, 0x38, 0xf0, 0x07, 0xbe, 0xc0, 0x6c,
+	0xf2, 0x2f, 0x49, 0x74, 0x75, 0x8d, 0x13, 0xf9, 0x6c, 0xb3, 0x6f, 0x32, 0xb2, 0x79, 0x7c, 0xbb,
+	0x4f, 0x42, 0xf3, 0xf6, 0xa6, 0xe5, 0x53, 0x4f, 0xe1, 0x57, 0x4d, 0x97, 0x7a, 0xfe, 0xa6, 0xf8,
+	0x1b, 0xb1, 0x0c, 0x7c, 0x7f, 0xe0, 0x90, 0x4d, 0xb1, 0xea, 0x8f, 0x0e, 0x36, 0xed, 0x51, 0x60,
+	0x86, 0xd4, 0x8f, 0x58, 0x9a, 0xd3, 0xf8, 0x90, 0xba, 0x84, 0x85, 0xa6, 0x3b, 0x94, 0x04, 0xfa,
+	0x6f, 0xf3, 0x80, 0x3a, 0x4f, 0x88, 0x35, 0xe2, 0x4c, 0x6d, 0xdf, 0x1d, 0xfa, 0x1e, 0xf1, 0x42,
+	0x84, 0x20, 0xe7, 0x99, 0x2e, 0xd1, 0x32, 0xeb, 0x99, 0x9b, 0x65, 0x2c, 0xbe, 0x51, 0x1d, 0x96,
+	0xa9, 0xad, 0x2d, 0x0b, 0xc8, 0x32, 0xb5, 0xd1, 0x65, 0x28, 0xb0, 0xd0, 0x0c, 0x47, 0x4c, 0xcb,
+	0x0a, 0x98, 0x5a, 0xa1, 0xef, 0x41, 0x29, 0x0c, 0x4c, 0x8f, 0x1d, 0x90, 0x40, 0xcb, 0xad, 0x67,
+	0x6e, 0x56, 0xee, 0xac, 0x6f, 0xa4, 0xb6, 0xbf, 0xd1, 0x53, 0xe8, 0x58, 0xdf, 0xce, 0x12, 0x8e,
+	0x79, 0xd0, 0xeb, 0x90, 0xb7, 0x1c, 0x93, 0xba, 0x5a, 0x5e, 0x30, 0x3f, 0x3f, 0xc5, 0xdc, 0xe6,
+	0xb8, 0x24, 0xa7, 0xa4, 0xe6, 0x6a, 0x2d, 0xdf, 0x0b, 0x03, 0xd3, 0x0a, 0xb5, 0xc2, 0x5c, 0xb5,
+	0x6d, 0x85, 0x4e, 0xa9, 0x8d, 0x78, 0xd0, 0x77, 0xa1, 0x48, 0xfb, 0x96, 0xe1, 0xb2, 0x81, 0x56,
+	0x14, 0xec, 0x6b, 0x53, 0xec, 0xdd, 0x56, 0xfb, 0x01, 0x1b, 0x24, 0x99, 0x0b, 0xb4, 0x6f, 0x3d,
+	0x60, 0x03, 0xf4, 0x16, 0x94, 0x38, 0x2b, 0x23, 0x9e, 0xad, 0x95, 0x04, 0x6f, 0x73, 0x96, 0x77,
+	0x9f, 0x78, 0x76, 0x92, 0x99, 0x6b, 0xe3, 0x30, 0xf4, 0x2e, 0x54, 0x6d, 0xca, 0xc2, 0x80, 0xf6,
+	0xc5, 0x21, 0x68, 0x20, 0x24, 0xbc, 0x34, 0x25, 0x61, 0x3b, 0x41, 0x92, 0x14, 0x93, 0xe2, 0x45,
+	0x6f, 0x42, 0xf1, 0x98, 0xb0, 0x90, 0x7a, 0x03, 0xad, 0x32, 0xd7, 0x90, 0xf7, 0x25, 0x36, 0x65,
+	0x88, 0xe2, 0x40, 0x9b, 0x90, 0xa5, 0x96, 0xa9, 0xd5, 0x04, 0xe3, 0xb5, 0xe9, 0x1d, 0xb4, 0xb7,
+	0x92, 0x4c, 0x9c, 0x12, 0xdd, 0x85, 0x8a, 0x3f, 0x0a, 0x87, 0xa3, 0xd0, 0xe0, 0xb1, 0xae, 0x95,
+	0xe7, 0xba, 0x2d, 0xe6, 0xda, 0x13, 0xa4, 0x18, 0x24, 0x4b, 0xef, 0x74, 0x48, 0xd0, 0xf3, 0x00,
+	0x36, 0x19, 0x12, 0xcf, 0x66, 0x86, 0xef, 0x69, 0xd5, 0xf5, 0xec, 0xcd, 0x32, 0x2e, 0x2b, 0xc8,
+	0x9e, 0xd7, 0x6a, 0x40, 0xdd, 0x8a, 0xb8, 0x85, 0x0a, 0xfd, 0xd3, 0x2c, 0xac, 0x4c, 0x09, 0x44,
+	0x3b, 0xb0, 0x12, 0x59, 0x11, 0x85, 0x5d, 0x66, 0x6e, 0xe4, 0x48, 0xfa, 0x28, 0xf8, 0x76, 0x96,
+	0x70, 0xdd, 0x4f, 0x41, 0xd0, 0x23, 0xb8, 0xa8, 0x24, 0x45, 0x51, 0x61, 0x58, 0xa6, 0xe3, 0x88,
+	0x98, 0xaf, 0xdc, 0x79, 0x61, 0xae, 0xb8, 0x38, 0xa8, 0x4c, 0xc7, 0xd9, 0x59, 0xc2, 0xc8, 0x9f,
+	0x81, 0x22, 0x03, 0x34, 0x25, 0x96, 0x47, 0x49, 0x5a, 0x74, 0x76, 0xee, 0x61, 0x4b, 0xd1, 0xdd,
+	0x56, 0x7b, 0x4a, 0xfa, 0x25, 0x29, 0xa7, 0xdb, 0xb7, 0x52, 0x0a, 0xee, 0xc1, 0x4a, 0x42, 0x81,
+	0x08, 0x43, 0x79, 0xf1, 0xae, 0x2f, 0x92, 0xcb, 0x03, 0x6f, 0x67, 0x09, 0xd7, 0x62, 0x79, 0x22,
+	0x12, 0xdf, 0x8a, 0xcf, 0x93, 0xb8, 0x34, 0x54, 0xf7, 0xef, 0xca, 0x5c, 0x19, 0x1d, 0x97, 0xf2,
+	0x30, 0x00, 0x3f, 0x5e, 0xb5, 0x6a, 0xa9, 0x68, 0xd0, 0x1d, 0x58, 0x9d, 0xb9, 0xe7, 0x3c, 0x87,
+	0x84, 0xbe, 0xca, 0x2a, 0xcb, 0xa1, 0x8f, 0x2e, 0x42, 0xde, 0x26, 0x9e, 0xef, 0xaa, 0xb4, 0x22,
+	0x17, 0xe8, 0x36, 0x14, 0x4c, 0xd7, 0x1f, 0x79, 0xa1, 0x96, 0x4d, 0x98, 0xe0, 0xb3, 0x0d, 0x9e,
+	0x19, 0x37, 0x54, 0x66, 0xdc, 0x68, 0xfb, 0xd4, 0xc3, 0x8a, 0x50, 0xbf, 0x00, 0xab, 0x22, 0x31,
+	0x6c, 0x59, 0x16, 0x61, 0xec, 0xe1, 0xa8, 0xef, 0x50, 0x4b, 0xdf, 0x02, 0x94, 0x04, 0x06, 0xf4,
+	0xd8, 0x0c, 0x09, 0x7a, 0x15, 0xca, 0xa6, 0x6d, 0x07, 0x84, 0x31, 0xc2, 0xb4, 0x0c, 0x8f, 0xb9,
+	0x56, 0x6d, 0x7c, 0xd6, 0x2c, 0x6f, 0x45, 0x40, 0x3c, 0xc1, 0xeb, 0xbf, 0xce, 0xa4, 0x64, 0x08,
+	0xb7, 0xfb, 0x0e, 0x7a, 0x03, 0x0a, 0x43, 0xa1, 0x43, 0xcb, 0xcc, 0x4f, 0x35, 0xd3, 0xb6, 0xf0,
+	0x6c, 0x21, 0x39, 0xd0, 0xdb, 0x50, 0x1c, 0x4a, 0x53, 0x16, 0x04, 0xd6, 0xac, 0xcd, 0xfc, 0x96,
+	0x2a, 0x1e, 0xee, 0x66, 0x53, 0xe0, 0xa4, 0x9b, 0xff, 0x99, 0x83, 0x7a, 0x3a, 0x25, 0xa2, 0x6d,
+	0x28, 0x48, 0x0a, 0x2d, 0xf3, 0x55, 0xf2, 0xd5, 0x7e, 0x5a, 0xe5, 0xcf, 0xce, 0x9a, 0x4b, 0x9f,
+	0xfe, 0xed, 0x77, 0xb7, 0x32, 0x58, 0xf1, 0xa2, 0xbb, 0x50, 0x1a, 0x12, 0x9b, 0x04, 0x8c, 0x78,
+	0x0b, 0xec, 0x7c, 0xa8, 0xd0, 0x6d, 0xdf, 0x75, 0x69, 0xe8, 0xaa, 0x84, 0x1a, 0x31, 0xf1, 0x5c,
+	0xc4, 0xac, 0x43, 0xcf, 0x0f, 0x02, 0x2d, 0x3b, 0x37, 0x17, 0xed, 0x4b, 0xec, 0x3e, 0x1d, 0x78,
+	0x66, 0x38, 0x0a, 0xc4, 0x2e, 0x15, 0x07, 0x7a, 0x0d, 0xf2, 0x03, 0xcf, 0x0c, 0x8e, 0xb4, 0xdc,
+	0xdc, 0x6c, 0xf4, 0x0e, 0xc7, 0x7d, 0x70, 0xb4, 0xcf, 0xff, 0xf1, 0x12, 0x20, 0x68, 0xf9, 0xa9,
+	0x58, 0x23, 0x16, 0xfa, 0x51, 0xe9, 0x98, 0x39, 0x15, 0x81, 0x14, 0xdb, 0xdf, 0xb7, 0x0e, 0x89,
+	0xcb, 0x35, 0x2a, 0x0e, 0xb4, 0x0b, 0xab, 0xe1, 0x61, 0x40, 0xd8, 0xa1, 0xef, 0xd8, 0x46, 0x64,
+	0x77, 0x61, 0xae, 0xdd, 0xbd, 0x88, 0x4e, 0x6d, 0x60, 0x67, 0x09, 0x37, 0xc2, 0x29, 0x18, 0xba,
+	0x03, 0x85, 0x13, 0xea, 0xd9, 0xfe, 0x89, 0xaa, 0x26, 0x57, 0xe7, 0x1d, 0xc2, 0x63, 0x41, 0x81,
+	0x15, 0x25, 0x7a, 0x03, 0x4a, 0x07, 0xa6, 0xe3, 0xf4, 0x4d, 0xeb, 0x48, 0x2b, 0x3d, 0x55, 0x32,
+	0x8d, 0xe9, 0xd1, 0x0b, 0x50, 0x75, 0xcc, 0x21, 0x23, 0xc6, 0x21, 0xa1, 0x83, 0xc3, 0x50, 0x24,
+	0xe3, 0x2c, 0xae, 0x08, 0xd8, 0x8e, 0x00, 0xa1, 0xbb, 0x00, 0x92, 0x84, 0x37, 0x01, 0xaa, 0xcc,
+	0x5c, 0xdd, 0x90, 0x1d, 0xc2, 0x46, 0xd4, 0x21, 0x6c, 0xf4, 0xa2, 0x0e, 0xa1, 0x95, 0xfb, 0xf8,
+	0x8b, 0x66, 0x06, 0x97, 0x05, 0x0f, 0x87, 0xf2, 0xd0, 0x63, 0xc2, 0x6f, 0x32, 0xf4, 0x0e, 0xa0,
+	0x92, 0xd8, 0x05, 0xef, 0x07, 0xfa, 0x8e, 0x6f, 0x1d, 0xc9, 0xb0, 0xcb, 0x62, 0xb5, 0xe2, 0x81,
+	0x14, 0x75, 0x25, 0x2a, 0x90, 0xae, 0xcc, 0x28, 0xdd, 0x56, 0x04, 0xad, 0x12, 0x0f, 0xc4, 0x5f,
+	0x71, 0xbd, 0x31, 0x93, 0xbe, 0x05, 0xab, 0x33, 0xa5, 0x1b, 0x69, 0x50, 0x54, 0xb7, 0x54, 0xa5,
+	0x93, 0x68, 0xc9, 0x7b, 0x17, 0xdb, 0x0c, 0x4d, 0xa1, 0xab, 0x8a, 0xc5, 0xb7, 0xfe, 0xb3, 0x0c,
+	0xac, 0x4c, 0xd5, 0x6f, 0x2e, 0xc1, 0x3a, 0x34, 0x3d, 0x8f, 0x38, 0x91, 0x04, 0xb5, 0x44, 0xcf,
+	0x41, 0x71, 0xe8, 0x07, 0xa1, 0x11, 0xb7, 0x3b, 0x05, 0xbe, 0xec, 0xda, 0xb1, 0xe8, 0xec, 0x44,
+	0x34, 0xfa, 0x26, 0xe4, 0x03, 0x12, 0x06, 0xa7, 0x5a, 0x6e, 0xee, 0x39, 0x63, 0x8e, 0x7b, 0xe8,
+	0x3b, 0xd4, 0x3a, 0xc5, 0x92, 0x50, 0xff, 0x47, 0x06, 0x1a, 0xd3, 0x0d, 0xc1, 0x39, 0xfb, 0x49,
+	0xd8, 0xb9, 0xbc, 0xd0, 0xce, 0x6c, 0xca, 0xce, 0x38, 0xad, 0xe6, 0xe6, 0xa7, 0xd5, 0xfc, 0x53,
+	0xa6, 0x55, 0xbe, 0x61, 0x97, 0xb8, 0xbe, 0xb8, 0x08, 0x65, 0x2c, 0xbe, 0x27, 0x1b, 0x2e, 0x3e,
+	0xed, 0x86, 0x7f, 0x04, 0xd5, 0x64, 0xfb, 0x80, 0x5e, 0x84, 0x9a, 0xe5, 0x7b, 0x1e, 0xb1, 0xf8,
+	0xf1, 0x72, 0xeb, 0xe5, 0x8e, 0xab, 0x13, 0xa0, 0xf4, 0xb5, 0xcb, 0x06, 0x4c, 0x5b, 0x5e, 0xcf,
+	0x72, 0x5f, 0xf3, 0xef, 0xd8, 0x9c, 0xec, 0xc4, 0x1c, 0xfd, 0x11, 0x54, 0x12, 0x2a, 0x51, 0x13,
+	0x2a, 0xae, 0xf9, 0xc4, 0xe0, 0x8a, 0x29, 0x91, 0xbe, 0xac, 0x61, 0x70, 0xcd, 0x27, 0x58, 0x42,
+	0xd0, 0x0d, 0xa8, 0xf3, 0x0b, 0xe3, 0x1f, 0x1c, 0x18, 0x2a, 0x5c, 0x97, 0x45, 0xb8, 0xd6, 0x14,
+	0xb4, 0x25, 0x80, 0xfa, 0xcf, 0x97, 0xe1, 0xd2, 0xdc, 0x9e, 0x0b, 0xb5, 0xa1, 0xc0, 0x0e, 0xcd,
+	0x40, 0x15, 0x8f, 0xd9, 0x2c, 0x93, 0xe4, 0xda, 0xe7, 0x84, 0xa9, 0xec, 0x2a, 0x59, 0xd1, 0x77,
+	0x20, 0xcf, 0x3b, 0x7b, 0xa6, 0x6e, 0xc4, 0xfa, 0xb9, 0xdd, 0x1e, 0xf5, 0x98, 0xe8, 0x73, 0xf9,
+	0x07, 0xba, 0x01, 0xb5, 0xbe, 0xe9, 0x98, 0x9e, 0x45, 0x0c, 0x79, 0xc6, 0xc2, 0x19, 0xbc, 0x13,
+	0x54, 0xe0, 0x6d, 0x71, 0xd8, 0x2d, 0x28, 0x07, 0xc4, 0x35, 0xa9, 0x67, 0xab, 0x36, 0xbc, 0x7e,
+	0x6e, 0x4b, 0x89, 0x23, 0x5a, 0x3c, 0x61, 0x6b, 0x95, 0xa0, 0xc0, 0xfc, 0x51, 0x60, 0x11, 0xbd,
+	0x03, 0xab, 0x33, 0xdb, 0x3a, 0x27, 0x64, 0x2f, 0x43, 0xe1, 0x44, 0xa6, 0x21, 0xbe, 0xbd, 0x1c,
+	0x56, 0x2b, 0xfd, 0x27, 0xb0, 0x3a, 0xb3, 0x33, 0x74, 0x18, 0x87, 0xa5, 0xf4, 0xe7, 0xe2, 0xb0,
+	0x6c, 0xbd, 0xce, 0x1d, 0xf9, 0x9b, 0x2f, 0x9a, 0x37, 0x07, 0x34, 0x3c, 0x1c, 0xf5, 0x37, 0x2c,
+	0xdf, 0xdd, 0x54, 0x43, 0x93, 0xfc, 0xf7, 0x0d, 0x66, 0x1f, 0xa9, 0xc1, 0x4b, 0x08, 0x8f, 0x4a,
+	0x9a, 0x6c, 0x12, 0xfe, 0x98, 0x85, 0xc6, 0x74, 0x03, 0x3c, 0xd3, 0x92, 0x4c, 0xcc, 0x59, 0x7e,
+	0xb6, 0xe6, 0xa0, 0x6f, 0x43, 0xc1, 0xa1, 0x1e, 0x31, 0xa3, 0xfa, 0x38, 0xdd, 0xad, 0xdd, 0x17,
+	0x48, 0x65, 0x30, 0x2f, 0x55, 0x92, 0x9a, 0xd7, 0x46, 0xcb, 0xa1, 0x07, 0x07, 0x0b, 0x6a, 0x63,
+	0x9b, 0xe3, 0x26, 0x5c, 0x92, 0x96, 0xcf, 0x28, 0x43, 0x12, 0x50, 0xdf, 0xa6, 0x96, 0x96, 0x9f,
+	0x5b, 0x5b, 0x1e, 0x2a, 0xf4, 0x84, 0x35, 0xe6, 0xe0, 0xd5, 0x85, 0x85, 0x66, 0x10, 0x46, 0xd5,
+	0xa5, 0x20, 0xab, 0x8b, 0x80, 0xa9, 0xea, 0xe2, 0x41, 0xf9, 0x84, 0x86, 0x87, 0x76, 0x60, 0x9e,
+	0x78, 0x5a, 0xf1, 0x19, 0xb9, 0x6e, 0xa2, 0xa2, 0x05, 0x50, 0xe2, 0xc5, 0xc8, 0x1e, 0x39, 0x44,
+	0x7f, 0x05, 0x6a, 0x29, 0x67, 0x2d, 0xaa, 0x45, 0x7a, 0x17, 0xaa, 0x49, 0xf7, 0xf0, 0x7d, 0x09,
+	0xf7, 0x18, 0x29, 0xea, 0x8a, 0x80, 0xc9, 0x44, 0x90, 0x10, 0xb5, 0x9c, 0x12, 0xd5, 0x83, 0x95,
+	0x29, 0x8f, 0xa1, 0x2d, 0x28, 0x4a, 0x8f, 0x45, 0xa9, 0xe1, 0xfa, 0xfc, 0xe9, 0x4b, 0xf2, 0x25,
+	0xd3, 0x42, 0xc4, 0xa7, 0xff, 0x22, 0x03, 0xb5, 0x14, 0xd5, 0xc2, 0xb2, 0xfa, 0x7f, 0x8b, 0x53,
+	0x9d, 0x41, 0x3d, 0x3d, 0x3a, 0x9d, 0x73, 0xf3, 0xff, 0x67, 0x0d, 0xfd, 0x0e, 0xa0, 0xd9, 0x01,
+	0xeb, 0xfc, 0x2a, 0x39, 0x34, 0x4f, 0x1d, 0xdf, 0xb4, 0x55, 0xe1, 0x8f, 0x96, 0x3a, 0x81, 0x4b,
+	0x73, 0xe7, 0xa9, 0x73, 0x1a, 0x80, 0x85, 0xc2, 0x92, 0x06, 0x64, 0x53, 0x06, 0xe8, 0x9f, 0x64,
+	0xa0, 0x96, 0x9a, 0xaf, 0xce, 0x97, 0x1f, 0x49, 0x59, 0x5e, 0xe0, 0xbf, 0xec, 0x7c, 0xff, 0xe5,
+	0xfe, 0xd3, 0xca, 0x9d, 0x4f, 0x94, 0xca, 0x97, 0x01, 0x26, 0xd3, 0x1b, 0x37, 0xc2, 0x25, 0x8c,
+	0x99, 0x83, 0xe8, 0x99, 0x27, 0x5a, 0xea, 0x14, 0x1a, 0xd3, 0xbd, 0x39, 0x1f, 0xd5, 0xe5, 0xfc,
+	0x62, 0x1c, 0x91, 0x53, 0xc1, 0x50, 0xc5, 0x65, 0x09, 0xf9, 0x3e, 0x39, 0x45, 0xd7, 0xa1, 0xcc,
+	0x22, 0x5a, 0xe5, 0xb3, 0x09, 0x20, 0xa9, 0x2a, 0x9b, 0x56, 0xf5, 0x1e, 0x34, 0xa6, 0xdb, 0x69,
+	0x5e, 0xc2, 0x27, 0xaa, 0xe4, 0x55, 0xaa, 0x62, 0x88, 0x75, 0x31, 0xae, 0x2c, 0xee, 0xb7, 0x85,
+	0xb2, 0x1a, 0x9e, 0x00, 0xf4, 0x9f, 0x66, 0x00, 0xcd, 0x8e, 0x26, 0x68, 0x0d, 0xc0, 0x8a, 0x57,
+	0x6a, 0x03, 0x09, 0x08, 0x7a, 0x15, 0x56, 0x43, 0x33, 0x18, 0x90, 0xd0, 0x98, 0x00, 0xd5, 0x4e,
+	0x1a, 0x12, 0x91, 0x10, 0xf6, 0x02, 0x54, 0xfb, 0xd4, 0xb3, 0x0d, 0xf1, 0xf4, 0x44, 0x64, 0x02,
+	0x2f, 0xe1, 0x0a, 0x87, 0xb5, 0x25, 0x48, 0x0f, 0xa1, 0x9a, 0x9c, 0x52, 0xd0, 0xd7, 0xa0, 0x71,
+	0x4c, 0x02, 0x7a, 0x40, 0x2d, 0xd1, 0xd5, 0x26, 0xdc, 0xb8, 0x92, 0x84, 0x73, 0x67, 0xbe, 0x08,
+	0x35, 0xe5, 0x00, 0xea, 0x0d, 0x47, 0x21, 0x53, 0x66, 0x54, 0x25, 0xb0, 0x2b, 0x60, 0x3c, 0x52,
+	0x86, 0x81, 0xef, 0x1f, 0xa8, 0x66, 0x54, 0x2e, 0xf4, 0xbb, 0xb0, 0x3a, 0x33, 0xe5, 0x88, 0x97,
+	0x3a, 0xf1, 0xa5, 0x0e, 0x5a, 0xad, 0xe6, 0x76, 0xca, 0x7f, 0x28, 0x40, 0xee, 0x31, 0x75, 0x1c,
+	0x74, 0x59, 0x3c, 0xf7, 0x09, 0x86, 0x56, 0x61, 0x7c, 0xd6, 0x5c, 0xee, 0x6e, 0x8b, 0x67, 0xbf,
+	0x1b, 0x50, 0xb4, 0x02, 0x62, 0x86, 0x7e, 0x20, 0x63, 0xb7, 0x55, 0x19, 0x9f, 0x35, 0x8b, 0x6d,
+	0x09, 0xc2, 0x11, 0x0e, 0x5d, 0x57, 0x2f, 0x88, 0xe2, 0xbc, 0x5b, 0xa5, 0xf1, 0x59, 0x33, 0xb7,
+	0x6b, 0xba, 0x44, 0xbd, 0x25, 0xde, 0x86, 0x4a, 0x9f, 0x78, 0xe4, 0x80, 0x5a, 0xd4, 0x54, 0xad,
+	0x73, 0xb9, 0xb5, 0x32, 0x3e, 0x6b, 0x56, 0x5a, 0x13, 0x30, 0x4e, 0xd2, 0x20, 0x1d, 0x0a, 0xaa,
+	0xf8, 0xf0, 0x90, 0xce, 0xb6, 0x60, 0x7c, 0xd6, 0x2c, 0xc8, 0xda, 0x83, 0x15, 0x86, 0xd3, 0xa8,
+	0x27, 0x49, 0xd1, 0xb0, 0x4a, 0x9a, 0x7d, 0x01, 0x89, 0x9f, 0x27, 0xdf, 0x13, 0x71, 0x20, 0x8b,
+	0x3f, 0x53, 0x85, 0x6a, 0x7a, 0xb2, 0x9d, 0x7d, 0x11, 0x6d, 0xd5, 0xc7, 0x67, 0x4d, 0x88, 0x97,
+	0x0c, 0x27, 0x84, 0xa0, 0x2d, 0x58, 0xa5, 0x9e, 0x69, 0x85, 0xf4, 0x98, 0x86, 0xa7, 0x86, 0x1a,
+	0xfb, 0x4a, 0xc2, 0xca, 0x8b, 0xe3, 0xb3, 0x66, 0xa3, 0x1b, 0x23, 0xd5, 0xc0, 0xd7, 0xa0, 0x53,
+	0x10, 0xf4, 0x1a, 0xd4, 0x1c, 0x93, 0x85, 0x86, 0x75, 0x48, 0xac, 0x23, 0x83, 0x7a, 0x72, 0x7e,
+	0x93, 0x2e, 0xb9, 0x6f, 0xb2, 0xb0, 0xcd, 0xe1, 0x5d, 0x8f, 0x0f, 0x74, 0xf1, 0x02, 0x61, 0xa8,
+	0x86, 0x01, 0x1d, 0x0c, 0x48, 0xf0, 0xb4, 0x23, 0xdd, 0x05, 0x2e, 0xaf, 0x27, 0x79, 0x38, 0x54,
+	0x4c, 0x78, 0x95, 0x70, 0x02, 0x40, 0x1f, 0xc2, 0x85, 0xc4, 0x5e, 0xe2, 0xc1, 0xad, 0xf2, 0x55,
+	0x83, 0xdb, 0x1a, 0xaf, 0x31, 0xe3, 0xb3, 0x26, 0x9a, 0x6c, 0x36, 0xc2, 0x89, 0x71, 0x0e, 0xd1,
+	0x19, 0x38, 0xea, 0x42, 0xd9, 0xf2, 0x3d, 0x9b, 0x0a, 0x0d, 0xd5, 0xb9, 0x3d, 0xd0, 0x63, 0x93,
+	0xb9, 0xed, 0x88, 0x46, 0xbe, 0xd3, 0xc4, 0x4b, 0x3c, 0xe1, 0x46, 0xef, 0x43, 0x9d, 0x44, 0x87,
+	0x66, 0xb8, 0xbe, 0x4d, 0xc4, 0x33, 0x66, 0xfd, 0xce, 0xf5, 0x45, 0x27, 0xfb, 0xc0, 0xb7, 0x49,
+	0x6b, 0x75, 0x7c, 0xd6, 0xac, 0xa5, 0x40, 0xb8, 0x46, 0x92, 0x4b, 0xf4, 0x26, 0xac, 0x04, 0xc4,
+	0xf5, 0x43, 0x62, 0xa8, 0xdc, 0xcd, 0xb4, 0xba, 0x78, 0x32, 0x42, 0xe3, 0xb3, 0x66, 0x1d, 0x0b,
+	0x54, 0x5b, 0x61, 0x70, 0x3d, 0x48, 0xad, 0xdf, 0xc8, 0xfd, 0xfd, 0x93, 0x66, 0x46, 0xff, 0x73,
+	0x06, 0x1a, 0xb1, 0x0e, 0x4c, 0x2c, 0x42, 0x87, 0x21, 0x1f, 0xdd, 0xb8, 0x3d, 0x93, 0xe1, 0xa7,
+	0xc0, 0x97, 0x5d, 0x5b, 0x74, 0x24, 0xf1, 0x9b, 0x67, 0x3c, 0x80, 0x56, 0x62, 0x98, 0x9c, 0xee,
+	0x78, 0x7f, 0xfe, 0x44, 0xdc, 0xad, 0x1a, 0x96, 0x8b, 0xc4, 0x73, 0x7c, 0x2e, 0xf5, 0x1c, 0x7f,
+	0x11, 0xf2, 0x24, 0x08, 0xfc, 0x40, 0x55, 0x02, 0xb9, 0x40, 0x57, 0xa0, 0x34, 0x30, 0x99, 0x31,
+	0x62, 0xc4, 0x16, 0x77, 0x25, 0x87, 0x8b, 0x03, 0x93, 0x3d, 0x62, 0x64, 0x32, 0xe4, 0x16, 0x13,
+	0x43, 0xee, 0xe5, 0xf8, 0xf2, 0x95, 0x64, 0x13, 0x22, 0x57, 0xfa, 0xef, 0x33, 0x50, 0x7b, 0x48,
+	0x3c, 0x9b, 0xb7, 0x2b, 0xa6, 0x75, 0x44, 0xce, 0x9b, 0xaa, 0xaf, 0x42, 0x89, 0x91, 0x8f, 0x46,
+	0xc4, 0xb3, 0x88, 0x1a, 0x0b, 0xe2, 0x75, 0xd2, 0x1d, 0xd9, 0x73, 0xdd, 0x91, 0x9b, 0x75, 0xc7,
+	0x0d, 0xa8, 0x33, 0x12, 0x86, 0x0e, 0xb1, 0x0d, 0xe5, 0x00, 0xb9, 0xd3, 0x9a, 0x82, 0xca, 0xfb,
+	0x2f, 0x6a, 0x6e, 0x18, 0x12, 0x77, 0x28, 0xbb, 0xd7, 0x1a, 0x8e, 0x96, 0xfa, 0x8f, 0xa1, 0x22,
+	0x8d, 0x17, 0x73, 0xe4, 0x7f, 0x75, 0x34, 0x13, 0x3f, 0x65, 0x93, 0x7e, 0x4a, 0x2a, 0xcf, 0xa5,
+	0x95, 0xff, 0x72, 0x19, 0x6a, 0xa9, 0x20, 0xe7, 0x7e, 0x8a, 0x7f, 0xc8, 0x90, 0x06, 0xc4, 0x6b,
+	0x74, 0x0d, 0xca, 0x1f, 0x8d, 0x48, 0x70, 0x2a, 0x7e, 0xa6, 0x90, 0x69, 0xbb, 0x24, 0x00, 0xfc,
+	0x67, 0x08, 0x04, 0xb9, 0xa1, 0x19, 0x1e, 0x46, 0xd3, 0x31, 0xff, 0xe6, 0x6d, 0xbf, 0x3f, 0x24,
+	0x81, 0x48, 0xd7, 0x72, 0x0a, 0x9c, 0xf3, 0xab, 0x88, 0x54, 0xbc, 0xa7, 0xe8, 0x70, 0xcc, 0xc1,
+	0x63, 0xe7, 0xd8, 0x74, 0x46, 0x24, 0x8a, 0x1d, 0xb1, 0xe0, 0x06, 0x52, 0x2f, 0x24, 0xc1, 0xb1,
+	0xe9, 0xa8, 0x41, 0x20, 0x5e, 0x73, 0x03, 0x79, 0x5c, 0x39, 0x94, 0x3f, 0x20, 0x17, 0xe5, 0x29,
+	0x0f, 0x4c, 0x76, 0x9f, 0xaf, 0xd1, 0x2d, 0x58, 0xf5, 0xc8, 0x93, 0x28, 0xc9, 0xa5, 0x02, 0x6a,
+	0x85, 0x23, 0x44, 0x5e, 0x93, 0x29, 0x5d, 0x7f, 0x1b, 0xf2, 0xbc, 0x0c, 0x31, 0xf4, 0x2d, 0xc8,
+	0x73, 0x3b, 0xa3, 0x96, 0xfa, 0xc2, 0x74, 0x82, 0xa0, 0x8e, 0xd3, 0x2a, 0x8f, 0xcf, 0x9a, 0x92,
+	0x1c, 0x4b, 0x62, 0xfd, 0x5f, 0x19, 0x00, 0x0e, 0xe8, 0x30, 0x2b, 0xf0, 0x4f, 0x16, 0x9f, 0xe9,
+	0xc1, 0x64, 0x0e, 0x7f, 0x36, 0x4d, 0xb4, 0x9a, 0xda, 0x3f, 0x84, 0xa2, 0x4d, 0x86, 0x3e, 0xa3,
+	0x3c, 0x32, 0x9e, 0x8d, 0xa6, 0x48, 0x81, 0x7e, 0x0d, 0x8a, 0x8f, 0xc5, 0xee, 0x18, 0x6a, 0x40,
+	0x96, 0xaa, 0x69, 0xa4, 0x8c, 0xf9, 0xe7, 0xad, 0x03, 0xb8, 0x34, 0x77, 0xee, 0x47, 0x5f, 0x87,
+	0x9b, 0xdb, 0xdd, 0xfd, 0x1e, 0xee, 0xb6, 0x1e, 0xf5, 0xba, 0x7b, 0xbb, 0x06, 0xee, 0x3c, 0xd8,
+	0xea, 0xee, 0x6e, 0x77, 0xb0, 0x71, 0xaf, 0x8b, 0xf7, 0x7b, 0x46, 0xab, 0xb3, 0xdb, 0xb9, 0xd7,
+	0x6d, 0x77, 0xb7, 0xf0, 0x0f, 0x1b, 0x4b, 0xa8, 0x09, 0xd7, 0x16, 0x50, 0xb7, 0x1e, 0xe1, 0xdd,
+	0x46, 0xe6, 0xd6, 0xbb, 0x90, 0x4e, 0xac, 0x68, 0x0d, 0xae, 0x76, 0x7e, 0xd0, 0x69, 0x4b, 0xf2,
+	0x07, 0x7b, 0xdb, 0x1d, 0xa3, 0xd5, 0xd9, 0xef, 0x19, 0x9d, 0x7b, 0xf7, 0xf6, 0x70, 0xaf, 0xb1,
+	0x84, 0xae, 0xc0, 0xa5, 0x29, 0xfc, 0x56, 0x6f, 0xef, 0x41, 0xb7, 0xdd, 0xc8, 0xdc, 0xfa, 0x22,
+	0x03, 0xab, 0x33, 0x61, 0x8a, 0xae, 0x83, 0xd6, 0xde, 0xdb, 0xdd, 0xee, 0x0a, 0x86, 0xbd, 0x87,
+	0x1d, 0xbc, 0xd5, 0xdb, 0xc3, 0x46, 0xe7, 0xbd, 0x47, 0x5b, 0xf7, 0x1b, 0x4b, 0x68, 0x1d, 0xae,
+	0xcf, 0xc1, 0xee, 0xee, 0xf5, 0x14, 0x45, 0x06, 0x5d, 0x83, 0xe7, 0xe6, 0x50, 0xdc, 0xef, 0xec,
+	0xef, 0x37, 0x96, 0xd1, 0x4b, 0xb0, 0xbe, 0x00, 0x69, 0xc4, 0x4a, 0xb2, 0x7c, 0x4f, 0x73, 0xa8,
+	0xde, 0xc1, 0x9d, 0xad, 0x5e, 0x07, 0x37, 0x72, 0xe8, 0x15, 0x78, 0x71, 0x31, 0x7e, 0x22, 0x28,
+	0xdf, 0xda, 0xf9, 0xec, 0xaf, 0x6b, 0x4b, 0x9f, 0x8e, 0xd7, 0x32, 0x9f, 0x8d, 0xd7, 0x32, 0x9f,
+	0x8f, 0xd7, 0x32, 0x7f, 0x19, 0xaf, 0x65, 0x3e, 0xfe, 0x72, 0x6d, 0xe9, 0xf3, 0x2f, 0xd7, 0x96,
+	0xfe, 0xf4, 0xe5, 0xda, 0xd2, 0x07, 0x2f, 0x27, 0x82, 0xa1, 0xed, 0x33, 0xf7, 0xb1, 0xf8, 0x9d,
+	0xd9, 0x64, 0xae, 0xbd, 0xf9, 0x24, 0xf1, 0x7b, 0x73, 0xbf, 0x20, 0x4a, 0xf3, 0x6b, 0xff, 0x1e,
+	0x00, 0xdf, 0xe8, 0x97, 0xf8, 0x8d, 0x1e, 0x00, 0x00,
 }
 
-func (m *ContractComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (this *ExecutionComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *IBCMsgComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *IBCMsgComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IBCMsgComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
+	that1, ok := that.(*ExecutionComponent)
+	if !ok {
+		that2, ok := that.(ExecutionComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
+	if this.Name != that1.Name {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if this.Id != that1.Id {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *IBCSendComponent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Status != that1.Status {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IBCSendComponent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IBCSendComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	if that1.ComponentType == nil {
+		if this.ComponentType != nil {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
+	} else if this.ComponentType == nil {
+		return false
+	} else if !this.ComponentType.Equal(that1.ComponentType) {
+		return false
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.OutputType.Equal(that1.OutputType) {
+		return false
 	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *OutputTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_Transfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OutputTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *OutputTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*ExecutionComponent_Transfer)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Transfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Transfer.Equal(that1.Transfer) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *OutputContractCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_Claim) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OutputContractCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *OutputContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*ExecutionComponent_Claim)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Claim)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OutputIBCContractCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Claim.Equal(that1.Claim) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
 
-func (m *OutputIBCContractCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (this *ExecutionComponent_Contract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *OutputIBCContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*ExecutionComponent_Contract)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Contract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Contract.Equal(that1.Contract) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *OutputIBCSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_IbcMsg) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OutputIBCSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *OutputIBCSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != nil {
-		{
-			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*ExecutionComponent_IbcMsg)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_IbcMsg)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
+	if !this.IbcMsg.Equal(that1.IbcMsg) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *OutputEmit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_IbcSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OutputEmit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *OutputEmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ExecutionComponent_IbcSend)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_IbcSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SchnorrSignature) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if !this.IbcSend.Equal(that1.IbcSend) {
+		return false
+	}
+	return true
 }
 
-func (m *SchnorrSignature) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (this *ExecutionComponent_Distribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SchnorrSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*ExecutionComponent_Distribution)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Distribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Distribution.Equal(that1.Distribution) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *PedersenCommitment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_Vesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PedersenCommitment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *PedersenCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TargetCommitment) > 0 {
-		i -= len(m.TargetCommitment)
-		copy(dAtA[i:], m.TargetCommitment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TargetCommitment)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*ExecutionComponent_Vesting)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Vesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !this.Vesting.Equal(that1.Vesting) {
+		return false
+	}
+	return true
 }
 
-func (m *GnarkZkSnark) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionComponent_Ica) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *GnarkZkSnark) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GnarkZkSnark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*ExecutionComponent_Ica)
+	if !ok {
+		that2, ok := that.(ExecutionComponent_Ica)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.PublicInputs) > 0 {
-		i -= len(m.PublicInputs)
-		copy(dAtA[i:], m.PublicInputs)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicInputs)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.VerificationKey) > 0 {
-		i -= len(m.VerificationKey)
-		copy(dAtA[i:], m.VerificationKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VerificationKey)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Ica.Equal(that1.Ica) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *Will) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ComponentOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Will) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Will) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Components) > 0 {
-		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	that1, ok := that.(*ComponentOutput)
+	if !ok {
+		that2, ok := that.(ComponentOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
+	if that1.OutputType == nil {
+		if this.OutputType != nil {
+			return false
+		}
+	} else if this.OutputType == nil {
+		return false
+	} else if !this.OutputType.Equal(that1.OutputType) {
+		return false
 	}
-	if len(m.Beneficiary) > 0 {
-		i -= len(m.Beneficiary)
-		copy(dAtA[i:], m.Beneficiary)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
-		i--
-		dAtA[i] = 0x22
+	return true
+}
+
+func (this *ComponentOutput_OutputTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+
+	that1, ok := that.(*ComponentOutput_OutputTransfer)
+	if !ok {
+		that2, ok := that.(ComponentOutput_OutputTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	if !this.OutputTransfer.Equal(that1.OutputTransfer) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *Wills) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ComponentOutput_OutputContractCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Wills) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Wills) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Wills) > 0 {
-		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ComponentOutput_OutputContractCall)
+	if !ok {
+		that2, ok := that.(ComponentOutput_OutputContractCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *WillIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if !this.OutputContractCall.Equal(that1.OutputContractCall) {
+		return false
+	}
+	return true
 }
 
-func (m *WillIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (this *ComponentOutput_OutputIbcContractCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *WillIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ComponentOutput_OutputIbcContractCall)
+	if !ok {
+		that2, ok := that.(ComponentOutput_OutputIbcContractCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if !this.OutputIbcContractCall.Equal(that1.OutputIbcContractCall) {
+		return false
+	}
+	return true
 }
 
-func (m *ExecutionComponent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ComponentOutput_OutputIbcSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ComponentOutput_OutputIbcSend)
+	if !ok {
+		that2, ok := that.(ComponentOutput_OutputIbcSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.ComponentType != nil {
-		n += m.ComponentType.Size()
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.OutputType != nil {
-		l = m.OutputType.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.OutputIbcSend.Equal(that1.OutputIbcSend) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *ExecutionComponent_Transfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Transfer != nil {
-		l = m.Transfer.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ComponentOutput_OutputEmit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ExecutionComponent_Claim) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ComponentOutput_OutputEmit)
+	if !ok {
+		that2, ok := that.(ComponentOutput_OutputEmit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Claim != nil {
-		l = m.Claim.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.OutputEmit.Equal(that1.OutputEmit) {
+		return false
+	}
+	return true
 }
 
-func (m *ExecutionComponent_Contract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *TransferComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ExecutionComponent_IbcMsg) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*TransferComponent)
+	if !ok {
+		that2, ok := that.(TransferComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.IbcMsg != nil {
-		l = m.IbcMsg.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *ExecutionComponent_IbcSend) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.To != that1.To {
+		return false
 	}
-	var l int
-	_ = l
-	if m.IbcSend != nil {
-		l = m.IbcSend.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	return n
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
 }
 
-func (m *ComponentOutput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutputType != nil {
-		n += m.OutputType.Size()
+func (this *ClaimAccessPublic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ComponentOutput_OutputTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimAccessPublic)
+	if !ok {
+		that2, ok := that.(ClaimAccessPublic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.OutputTransfer != nil {
-		l = m.OutputTransfer.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *ComponentOutput_OutputContractCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutputContractCall != nil {
-		l = m.OutputContractCall.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ClaimAccessPrivate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ComponentOutput_OutputIbcContractCall) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimAccessPrivate)
+	if !ok {
+		that2, ok := that.(ClaimAccessPrivate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.OutputIbcContractCall != nil {
-		l = m.OutputIbcContractCall.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *ComponentOutput_OutputIbcSend) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.OutputIbcSend != nil {
-		l = m.OutputIbcSend.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
 
-func (m *ComponentOutput_OutputEmit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OutputEmit != nil {
-		l = m.OutputEmit.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ClaimAccessControl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *TransferComponent) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimAccessControl)
+	if !ok {
+		that2, ok := that.(ClaimAccessControl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1.AccessType == nil {
+		if this.AccessType != nil {
+			return false
+		}
+	} else if this.AccessType == nil {
+		return false
+	} else if !this.AccessType.Equal(that1.AccessType) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *ClaimAccessPublic) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ClaimAccessControl_Public) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ClaimAccessPrivate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	that1, ok := that.(*ClaimAccessControl_Public)
+	if !ok {
+		that2, ok := that.(ClaimAccessControl_Public)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ClaimAccessControl) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.AccessType != nil {
-		n += m.AccessType.Size()
+	if !this.Public.Equal(that1.Public) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *ClaimAccessControl_Public) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Public != nil {
-		l = m.Public.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ClaimAccessControl_Private) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ClaimAccessControl_Private) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimAccessControl_Private)
+	if !ok {
+		that2, ok := that.(ClaimAccessControl_Private)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Private != nil {
-		l = m.Private.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.Private.Equal(that1.Private) {
+		return false
+	}
+	return true
 }
 
-func (m *ClaimComponent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Access.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.SchemeType != nil {
-		n += m.SchemeType.Size()
+func (this *ClaimComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *ClaimComponent_Pedersen) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimComponent)
+	if !ok {
+		that2, ok := that.(ClaimComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Pedersen != nil {
-		l = m.Pedersen.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *ClaimComponent_Schnorr) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Access.Equal(&that1.Access) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Schnorr != nil {
-		l = m.Schnorr.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1.SchemeType == nil {
+		if this.SchemeType != nil {
+			return false
+		}
+	} else if this.SchemeType == nil {
+		return false
+	} else if !this.SchemeType.Equal(that1.SchemeType) {
+		return false
 	}
-	return n
-}
-
-func (m *ClaimComponent_Gnark) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Window.Equal(that1.Window) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Gnark != nil {
-		l = m.Gnark.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Fallback.Equal(that1.Fallback) {
+		return false
 	}
-	return n
+	if this.LapseHeight != that1.LapseHeight {
+		return false
+	}
+	if that1.LapseTime == nil {
+		if this.LapseTime != nil {
+			return false
+		}
+	} else if !this.LapseTime.Equal(*that1.LapseTime) {
+		return false
+	}
+	return true
 }
 
-func (m *ContractComponent) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ClaimComponent_Pedersen) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ClaimComponent_Pedersen)
+	if !ok {
+		that2, ok := that.(ClaimComponent_Pedersen)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if !this.Pedersen.Equal(that1.Pedersen) {
+		return false
+	}
+	return true
 }
 
-func (m *IBCMsgComponent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ClaimComponent_Schnorr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ClaimComponent_Schnorr)
+	if !ok {
+		that2, ok := that.(ClaimComponent_Schnorr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Schnorr.Equal(that1.Schnorr) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *IBCSendComponent) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ClaimComponent_Gnark) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ClaimComponent_Gnark)
+	if !ok {
+		that2, ok := that.(ClaimComponent_Gnark)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Gnark.Equal(that1.Gnark) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *OutputTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ClaimComponent_Custom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *OutputContractCall) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ClaimComponent_Custom)
+	if !ok {
+		that2, ok := that.(ClaimComponent_Custom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Custom.Equal(that1.Custom) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *OutputIBCContractCall) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ClaimComponent_ThresholdSchnorr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ClaimComponent_ThresholdSchnorr)
+	if !ok {
+		that2, ok := that.(ClaimComponent_ThresholdSchnorr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.ThresholdSchnorr.Equal(that1.ThresholdSchnorr) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *OutputIBCSend) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ClaimWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ClaimWindow)
+	if !ok {
+		that2, ok := that.(ClaimWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Blocks != that1.Blocks {
+		return false
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Duration != that1.Duration {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *OutputEmit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (this *ContractComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *SchnorrSignature) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ContractComponent)
+	if !ok {
+		that2, ok := that.(ContractComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Address != that1.Address {
+		return false
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *PedersenCommitment) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *IBCMsgComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*IBCMsgComponent)
+	if !ok {
+		that2, ok := that.(IBCMsgComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.TargetCommitment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *GnarkZkSnark) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Channel != that1.Channel {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.VerificationKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.PortId != that1.PortId {
+		return false
 	}
-	l = len(m.PublicInputs)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Retry.Equal(that1.Retry) {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *Will) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (this *IBCSendComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*IBCSendComponent)
+	if !ok {
+		that2, ok := that.(IBCSendComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Beneficiary)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Address != that1.Address {
+		return false
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+	if this.Channel != that1.Channel {
+		return false
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.PortId != that1.PortId {
+		return false
 	}
-	if len(m.Components) > 0 {
-		for _, e := range m.Components {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Memo != that1.Memo {
+		return false
+	}
+	if !this.Retry.Equal(that1.Retry) {
+		return false
+	}
+	return true
+}
+
+func (this *ICAComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ICAComponent)
+	if !ok {
+		that2, ok := that.(ICAComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConnectionId != that1.ConnectionId {
+		return false
+	}
+	if len(this.Msgs) != len(that1.Msgs) {
+		return false
+	}
+	for i := range this.Msgs {
+		if !bytes.Equal(this.Msgs[i], that1.Msgs[i]) {
+			return false
+		}
+	}
+	if this.Memo != that1.Memo {
+		return false
+	}
+	return true
 }
 
-func (m *Wills) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *RetryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Wills) > 0 {
-		for _, e := range m.Wills {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*RetryPolicy)
+	if !ok {
+		that2, ok := that.(RetryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if this.BackoffBlocks != that1.BackoffBlocks {
+		return false
+	}
+	return true
+}
+
+func (this *DistributionComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionComponent)
+	if !ok {
+		that2, ok := that.(DistributionComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Shares) != len(that1.Shares) {
+		return false
+	}
+	for i := range this.Shares {
+		if !this.Shares[i].Equal(&that1.Shares[i]) {
+			return false
+		}
+	}
+	if that1.Source == nil {
+		if this.Source != nil {
+			return false
+		}
+	} else if this.Source == nil {
+		return false
+	} else if !this.Source.Equal(that1.Source) {
+		return false
+	}
+	if this.Remainder != that1.Remainder {
+		return false
+	}
+	return true
+}
+
+func (this *DistributionComponent_Coins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionComponent_Coins)
+	if !ok {
+		that2, ok := that.(DistributionComponent_Coins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Coins.Equal(that1.Coins) {
+		return false
+	}
+	return true
+}
+
+func (this *DistributionComponent_BalanceDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionComponent_BalanceDenom)
+	if !ok {
+		that2, ok := that.(DistributionComponent_BalanceDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BalanceDenom != that1.BalanceDenom {
+		return false
+	}
+	return true
+}
+
+func (this *DistributionShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionShare)
+	if !ok {
+		that2, ok := that.(DistributionShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	return true
+}
+
+func (this *DistributionCoins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionCoins)
+	if !ok {
+		that2, ok := that.(DistributionCoins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *VestingComponent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingComponent)
+	if !ok {
+		that2, ok := that.(VestingComponent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if that1.Schedule == nil {
+		if this.Schedule != nil {
+			return false
+		}
+	} else if this.Schedule == nil {
+		return false
+	} else if !this.Schedule.Equal(that1.Schedule) {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if len(this.Withdrawn) != len(that1.Withdrawn) {
+		return false
+	}
+	for i := range this.Withdrawn {
+		if !this.Withdrawn[i].Equal(&that1.Withdrawn[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *VestingComponent_Linear) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingComponent_Linear)
+	if !ok {
+		that2, ok := that.(VestingComponent_Linear)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Linear.Equal(that1.Linear) {
+		return false
+	}
+	return true
+}
+
+func (this *VestingComponent_Cliff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingComponent_Cliff)
+	if !ok {
+		that2, ok := that.(VestingComponent_Cliff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cliff.Equal(that1.Cliff) {
+		return false
+	}
+	return true
+}
+
+func (this *VestingComponent_Periodic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingComponent_Periodic)
+	if !ok {
+		that2, ok := that.(VestingComponent_Periodic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Periodic.Equal(that1.Periodic) {
+		return false
+	}
+	return true
+}
+
+func (this *LinearVesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LinearVesting)
+	if !ok {
+		that2, ok := that.(LinearVesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	return true
+}
+
+func (this *CliffVesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CliffVesting)
+	if !ok {
+		that2, ok := that.(CliffVesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CliffBlocks != that1.CliffBlocks {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	return true
+}
+
+func (this *PeriodicVesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PeriodicVesting)
+	if !ok {
+		that2, ok := that.(PeriodicVesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Periods) != len(that1.Periods) {
+		return false
+	}
+	for i := range this.Periods {
+		if !this.Periods[i].Equal(&that1.Periods[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *VestingPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingPeriod)
+	if !ok {
+		that2, ok := that.(VestingPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *OutputTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputTransfer)
+	if !ok {
+		that2, ok := that.(OutputTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+
+func (this *OutputContractCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputContractCall)
+	if !ok {
+		that2, ok := that.(OutputContractCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+
+func (this *OutputIBCContractCall) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputIBCContractCall)
+	if !ok {
+		that2, ok := that.(OutputIBCContractCall)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+
+func (this *OutputIBCSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputIBCSend)
+	if !ok {
+		that2, ok := that.(OutputIBCSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Memo != that1.Memo {
+		return false
+	}
+	return true
+}
+
+func (this *OutputEmit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputEmit)
+	if !ok {
+		that2, ok := that.(OutputEmit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
+}
+
+func (this *SchnorrSignature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchnorrSignature)
+	if !ok {
+		that2, ok := that.(SchnorrSignature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	return true
+}
+
+func (this *ThresholdSchnorr) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ThresholdSchnorr)
+	if !ok {
+		that2, ok := that.(ThresholdSchnorr)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PublicKeys) != len(that1.PublicKeys) {
+		return false
+	}
+	for i := range this.PublicKeys {
+		if !bytes.Equal(this.PublicKeys[i], that1.PublicKeys[i]) {
+			return false
+		}
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	return true
+}
+
+func (this *PedersenCommitment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PedersenCommitment)
+	if !ok {
+		that2, ok := that.(PedersenCommitment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Commitment, that1.Commitment) {
+		return false
+	}
+	if !bytes.Equal(this.TargetCommitment, that1.TargetCommitment) {
+		return false
+	}
+	if this.BindClaimer != that1.BindClaimer {
+		return false
+	}
+	return true
+}
+
+func (this *GnarkZkSnark) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GnarkZkSnark)
+	if !ok {
+		that2, ok := that.(GnarkZkSnark)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.VerificationKey, that1.VerificationKey) {
+		return false
+	}
+	if !bytes.Equal(this.PublicInputs, that1.PublicInputs) {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	return true
+}
+
+func (this *CustomClaimScheme) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CustomClaimScheme)
+	if !ok {
+		that2, ok := that.(CustomClaimScheme)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Scheme != that1.Scheme {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+
+func (this *Will) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Will)
+	if !ok {
+		that2, ok := that.(Will)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if len(this.Components) != len(that1.Components) {
+		return false
+	}
+	for i := range this.Components {
+		if !this.Components[i].Equal(that1.Components[i]) {
+			return false
+		}
+	}
+	if this.InactivityWindow != that1.InactivityWindow {
+		return false
+	}
+	if this.LastCheckIn != that1.LastCheckIn {
+		return false
+	}
+	if that1.TriggerTime == nil {
+		if this.TriggerTime != nil {
+			return false
+		}
+	} else if !this.TriggerTime.Equal(*that1.TriggerTime) {
+		return false
+	}
+	if this.InactivityDuration != that1.InactivityDuration {
+		return false
+	}
+	if !this.Condition.Equal(that1.Condition) {
+		return false
+	}
+	if this.ExecutionMode != that1.ExecutionMode {
+		return false
+	}
+	if len(this.RemoteChannels) != len(that1.RemoteChannels) {
+		return false
+	}
+	for i := range this.RemoteChannels {
+		if this.RemoteChannels[i] != that1.RemoteChannels[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *ExecutionReceipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecutionReceipt)
+	if !ok {
+		that2, ok := that.(ExecutionReceipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WillId != that1.WillId {
+		return false
+	}
+	if this.ComponentId != that1.ComponentId {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+
+func (this *PendingPacket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingPacket)
+	if !ok {
+		that2, ok := that.(PendingPacket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.WillId != that1.WillId {
+		return false
+	}
+	if this.ComponentId != that1.ComponentId {
+		return false
+	}
+	if this.SettledStatus != that1.SettledStatus {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	return true
+}
+
+func (this *PacketRetry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketRetry)
+	if !ok {
+		that2, ok := that.(PacketRetry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WillId != that1.WillId {
+		return false
+	}
+	if this.ComponentId != that1.ComponentId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	return true
+}
+
+func (this *WasmCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WasmCondition)
+	if !ok {
+		that2, ok := that.(WasmCondition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.QueryMsg, that1.QueryMsg) {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.NextCheckHeight != that1.NextCheckHeight {
+		return false
+	}
+	return true
+}
+
+func (this *Wills) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Wills)
+	if !ok {
+		that2, ok := that.(Wills)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Wills) != len(that1.Wills) {
+		return false
+	}
+	for i := range this.Wills {
+		if !this.Wills[i].Equal(that1.Wills[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *WillEscrow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WillEscrow)
+	if !ok {
+		that2, ok := that.(WillEscrow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WillId != that1.WillId {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *WillIds) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WillIds)
+	if !ok {
+		that2, ok := that.(WillIds)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Ids) != len(that1.Ids) {
+		return false
+	}
+	for i := range this.Ids {
+		if this.Ids[i] != that1.Ids[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ExecutionComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ComponentType != nil {
+		{
+			size := m.ComponentType.Size()
+			i -= size
+			if _, err := m.ComponentType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.OutputType != nil {
+		{
+			size, err := m.OutputType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Transfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Contract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_IbcMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_IbcMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcMsg != nil {
+		{
+			size, err := m.IbcMsg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_IbcSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_IbcSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IbcSend != nil {
+		{
+			size, err := m.IbcSend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Distribution != nil {
+		{
+			size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Vesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Vesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionComponent_Ica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionComponent_Ica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ica != nil {
+		{
+			size, err := m.Ica.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComponentOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutputType != nil {
+		{
+			size := m.OutputType.Size()
+			i -= size
+			if _, err := m.OutputType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput_OutputTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput_OutputTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutputTransfer != nil {
+		{
+			size, err := m.OutputTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput_OutputContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput_OutputContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutputContractCall != nil {
+		{
+			size, err := m.OutputContractCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput_OutputIbcContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput_OutputIbcContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutputIbcContractCall != nil {
+		{
+			size, err := m.OutputIbcContractCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput_OutputIbcSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput_OutputIbcSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutputIbcSend != nil {
+		{
+			size, err := m.OutputIbcSend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ComponentOutput_OutputEmit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComponentOutput_OutputEmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OutputEmit != nil {
+		{
+			size, err := m.OutputEmit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAccessPublic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAccessPublic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAccessPublic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAccessPrivate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAccessPrivate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAccessPrivate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimAccessControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccessType != nil {
+		{
+			size := m.AccessType.Size()
+			i -= size
+			if _, err := m.AccessType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAccessControl_Public) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAccessControl_Public) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Public != nil {
+		{
+			size, err := m.Public.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimAccessControl_Private) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimAccessControl_Private) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Private != nil {
+		{
+			size, err := m.Private.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LapseTime != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LapseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LapseTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintTypes(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LapseHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LapseHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Fallback != nil {
+		{
+			size, err := m.Fallback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SchemeType != nil {
+		{
+			size := m.SchemeType.Size()
+			i -= size
+			if _, err := m.SchemeType.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent_Pedersen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent_Pedersen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Pedersen != nil {
+		{
+			size, err := m.Pedersen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent_Schnorr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent_Schnorr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Schnorr != nil {
+		{
+			size, err := m.Schnorr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent_Gnark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent_Gnark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Gnark != nil {
+		{
+			size, err := m.Gnark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent_Custom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent_Custom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Custom != nil {
+		{
+			size, err := m.Custom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimComponent_ThresholdSchnorr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimComponent_ThresholdSchnorr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ThresholdSchnorr != nil {
+		{
+			size, err := m.ThresholdSchnorr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintTypes(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x12
+	if m.Blocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCMsgComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCMsgComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCMsgComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCSendComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCSendComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCSendComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Msgs[iNdEx])
+			copy(dAtA[i:], m.Msgs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Msgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remainder != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Remainder))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Source != nil {
+		{
+			size := m.Source.Size()
+			i -= size
+			if _, err := m.Source.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionComponent_Coins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionComponent_Coins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Coins != nil {
+		{
+			size, err := m.Coins.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionComponent_BalanceDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionComponent_BalanceDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.BalanceDenom)
+	copy(dAtA[i:], m.BalanceDenom)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.BalanceDenom)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawn) > 0 {
+		for iNdEx := len(m.Withdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Schedule != nil {
+		{
+			size := m.Schedule.Size()
+			i -= size
+			if _, err := m.Schedule.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingComponent_Linear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingComponent_Linear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Linear != nil {
+		{
+			size, err := m.Linear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingComponent_Cliff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingComponent_Cliff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cliff != nil {
+		{
+			size, err := m.Cliff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingComponent_Periodic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingComponent_Periodic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Periodic != nil {
+		{
+			size, err := m.Periodic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinearVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinearVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinearVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CliffVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CliffVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CliffVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CliffBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CliffBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputIBCContractCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputIBCContractCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputIBCContractCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputIBCSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputIBCSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputIBCSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputEmit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputEmit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputEmit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchnorrSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchnorrSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchnorrSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdSchnorr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSchnorr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSchnorr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PedersenCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PedersenCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PedersenCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BindClaimer {
+		i--
+		if m.BindClaimer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetCommitment) > 0 {
+		i -= len(m.TargetCommitment)
+		copy(dAtA[i:], m.TargetCommitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TargetCommitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GnarkZkSnark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnarkZkSnark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GnarkZkSnark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicInputs) > 0 {
+		i -= len(m.PublicInputs)
+		copy(dAtA[i:], m.PublicInputs)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicInputs)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationKey) > 0 {
+		i -= len(m.VerificationKey)
+		copy(dAtA[i:], m.VerificationKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VerificationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomClaimScheme) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomClaimScheme) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomClaimScheme) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scheme) > 0 {
+		i -= len(m.Scheme)
+		copy(dAtA[i:], m.Scheme)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Scheme)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Will) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Will) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Will) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteChannels) > 0 {
+		for iNdEx := len(m.RemoteChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoteChannels[iNdEx])
+			copy(dAtA[i:], m.RemoteChannels[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RemoteChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ExecutionMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionMode))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	n38, err38 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InactivityDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityDuration):])
+	if err38 != nil {
+		return 0, err38
+	}
+	i -= n38
+	i = encodeVarintTypes(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x5a
+	if m.TriggerTime != nil {
+		n39, err39 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TriggerTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime):])
+		if err39 != nil {
+			return 0, err39
+		}
+		i -= n39
+		i = encodeVarintTypes(dAtA, i, uint64(n39))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.LastCheckIn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastCheckIn))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InactivityWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InactivityWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Components[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SettledStatus) > 0 {
+		i -= len(m.SettledStatus)
+		copy(dAtA[i:], m.SettledStatus)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SettledStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ComponentId) > 0 {
+		i -= len(m.ComponentId)
+		copy(dAtA[i:], m.ComponentId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ComponentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextCheckHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextCheckHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Operator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QueryMsg) > 0 {
+		i -= len(m.QueryMsg)
+		copy(dAtA[i:], m.QueryMsg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.QueryMsg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Wills) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Wills) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Wills) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Wills) > 0 {
+		for iNdEx := len(m.Wills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WillId) > 0 {
+		i -= len(m.WillId)
+		copy(dAtA[i:], m.WillId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WillId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WillIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WillIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WillIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *ExecutionComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ComponentType != nil {
+		n += m.ComponentType.Size()
+	}
+	if m.OutputType != nil {
+		l = m.OutputType.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Transfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_IbcMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcMsg != nil {
+		l = m.IbcMsg.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_IbcSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcSend != nil {
+		l = m.IbcSend.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Distribution != nil {
+		l = m.Distribution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Vesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionComponent_Ica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ica != nil {
+		l = m.Ica.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ComponentOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputType != nil {
+		n += m.OutputType.Size()
+	}
+	return n
+}
+
+func (m *ComponentOutput_OutputTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputTransfer != nil {
+		l = m.OutputTransfer.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ComponentOutput_OutputContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputContractCall != nil {
+		l = m.OutputContractCall.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ComponentOutput_OutputIbcContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputIbcContractCall != nil {
+		l = m.OutputIbcContractCall.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ComponentOutput_OutputIbcSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputIbcSend != nil {
+		l = m.OutputIbcSend.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ComponentOutput_OutputEmit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutputEmit != nil {
+		l = m.OutputEmit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimAccessPublic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClaimAccessPrivate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimAccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != nil {
+		n += m.AccessType.Size()
+	}
+	return n
+}
+
+func (m *ClaimAccessControl_Public) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Public != nil {
+		l = m.Public.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimAccessControl_Private) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Private != nil {
+		l = m.Private.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Access.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SchemeType != nil {
+		n += m.SchemeType.Size()
+	}
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Fallback != nil {
+		l = m.Fallback.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LapseHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LapseHeight))
+	}
+	if m.LapseTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LapseTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent_Pedersen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pedersen != nil {
+		l = m.Pedersen.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent_Schnorr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schnorr != nil {
+		l = m.Schnorr.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent_Gnark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gnark != nil {
+		l = m.Gnark.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent_Custom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Custom != nil {
+		l = m.Custom.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimComponent_ThresholdSchnorr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdSchnorr != nil {
+		l = m.ThresholdSchnorr.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovTypes(uint64(m.Blocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCMsgComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCSendComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ICAComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, b := range m.Msgs {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovTypes(uint64(m.MaxRetries))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.BackoffBlocks))
+	}
+	return n
+}
+
+func (m *DistributionComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	if m.Remainder != 0 {
+		n += 1 + sovTypes(uint64(m.Remainder))
+	}
+	return n
+}
+
+func (m *DistributionComponent_Coins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Coins != nil {
+		l = m.Coins.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionComponent_BalanceDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BalanceDenom)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DistributionShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTypes(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *DistributionCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Schedule != nil {
+		n += m.Schedule.Size()
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if len(m.Withdrawn) > 0 {
+		for _, e := range m.Withdrawn {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingComponent_Linear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Linear != nil {
+		l = m.Linear.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingComponent_Cliff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cliff != nil {
+		l = m.Cliff.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingComponent_Periodic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periodic != nil {
+		l = m.Periodic.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LinearVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovTypes(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *CliffVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CliffBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.CliffBlocks))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovTypes(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovTypes(uint64(m.Blocks))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutputTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputIBCContractCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputIBCSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputEmit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SchnorrSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdSchnorr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *PedersenCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TargetCommitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BindClaimer {
+		n += 2
+	}
+	return n
+}
+
+func (m *GnarkZkSnark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PublicInputs)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomClaimScheme) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Will) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Components) > 0 {
+		for _, e := range m.Components {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.InactivityWindow != 0 {
+		n += 1 + sovTypes(uint64(m.InactivityWindow))
+	}
+	if m.LastCheckIn != 0 {
+		n += 1 + sovTypes(uint64(m.LastCheckIn))
+	}
+	if m.TriggerTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TriggerTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InactivityDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecutionMode != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutionMode))
+	}
+	if len(m.RemoteChannels) > 0 {
+		for _, s := range m.RemoteChannels {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SettledStatus)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *PacketRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ComponentId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *WasmCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.QueryMsg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovTypes(uint64(m.Operator))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.NextCheckHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextCheckHeight))
+	}
+	return n
+}
+
+func (m *Wills) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Wills) > 0 {
+		for _, e := range m.Wills {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WillEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WillId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WillIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransferComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Transfer{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClaimComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Claim{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ContractComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Contract{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IBCMsgComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_IbcMsg{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IBCSendComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_IbcSend{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputType == nil {
+				m.OutputType = &ComponentOutput{}
+			}
+			if err := m.OutputType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DistributionComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Distribution{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VestingComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Vesting{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ICAComponent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.ComponentType = &ExecutionComponent_Ica{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutputTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.OutputType = &ComponentOutput_OutputTransfer{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputContractCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutputContractCall{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.OutputType = &ComponentOutput_OutputContractCall{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIbcContractCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutputIBCContractCall{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.OutputType = &ComponentOutput_OutputIbcContractCall{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputIbcSend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutputIBCSend{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.OutputType = &ComponentOutput_OutputIbcSend{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputEmit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OutputEmit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.OutputType = &ComponentOutput_OutputEmit{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TransferComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimAccessPublic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAccessPublic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAccessPublic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimAccessPrivate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAccessPrivate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAccessPrivate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimAccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Public", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClaimAccessPublic{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AccessType = &ClaimAccessControl_Public{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Private", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ClaimAccessPrivate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AccessType = &ClaimAccessControl_Private{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pedersen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PedersenCommitment{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SchemeType = &ClaimComponent_Pedersen{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schnorr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SchnorrSignature{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SchemeType = &ClaimComponent_Schnorr{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gnark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GnarkZkSnark{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SchemeType = &ClaimComponent_Gnark{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CustomClaimScheme{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SchemeType = &ClaimComponent_Custom{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSchnorr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ThresholdSchnorr{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SchemeType = &ClaimComponent_ThresholdSchnorr{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &ClaimWindow{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fallback == nil {
+				m.Fallback = &ComponentOutput{}
+			}
+			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LapseHeight", wireType)
+			}
+			m.LapseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LapseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LapseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LapseTime == nil {
+				m.LapseTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LapseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ClaimWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCMsgComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCMsgComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryPolicy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCSendComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCSendComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryPolicy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ICAComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, make([]byte, postIndex-iNdEx))
+			copy(m.Msgs[len(m.Msgs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DistributionComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, DistributionShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DistributionCoins{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Source = &DistributionComponent_Coins{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = &DistributionComponent_BalanceDenom{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			m.Remainder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remainder |= DistributionRemainder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DistributionShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DistributionCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *VestingComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linear", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LinearVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &VestingComponent_Linear{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CliffVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &VestingComponent_Cliff{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periodic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PeriodicVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Schedule = &VestingComponent_Periodic{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawn = append(m.Withdrawn, types.Coin{})
+			if err := m.Withdrawn[len(m.Withdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *LinearVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinearVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinearVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CliffVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CliffVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CliffVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffBlocks", wireType)
+			}
+			m.CliffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PeriodicVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *WillIds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4336,17 +11689,36 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionComponent: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4356,27 +11728,80 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4404,11 +11829,11 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4436,11 +11861,11 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4467,17 +11892,69 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransferComponent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.ComponentType = &ExecutionComponent_Transfer{v}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OutputContractCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputContractCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4487,32 +11964,29 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClaimComponent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.ComponentType = &ExecutionComponent_Claim{v}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4522,32 +11996,82 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OutputIBCContractCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ContractComponent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ComponentType = &ExecutionComponent_Contract{v}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputIBCContractCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputIBCContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4557,32 +12081,29 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IBCMsgComponent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.ComponentType = &ExecutionComponent_IbcMsg{v}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4592,32 +12113,31 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IBCSendComponent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
-			m.ComponentType = &ExecutionComponent_IbcSend{v}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4627,27 +12147,23 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputType == nil {
-				m.OutputType = &ComponentOutput{}
-			}
-			if err := m.OutputType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4671,7 +12187,7 @@ func (m *ExecutionComponent) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
+func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4694,17 +12210,17 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentOutput: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutputIBCSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutputIBCSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4714,32 +12230,29 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OutputTransfer{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.OutputType = &ComponentOutput_OutputTransfer{v}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputContractCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4749,32 +12262,29 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OutputContractCall{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.OutputType = &ComponentOutput_OutputContractCall{v}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIbcContractCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4784,30 +12294,27 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OutputIBCContractCall{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.OutputType = &ComponentOutput_OutputIbcContractCall{v}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputIbcSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4834,17 +12341,18 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OutputIBCSend{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.OutputType = &ComponentOutput_OutputIbcSend{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputEmit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4854,26 +12362,106 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &OutputEmit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.OutputType = &ComponentOutput_OutputEmit{v}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *OutputEmit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputEmit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputEmit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4897,7 +12485,7 @@ func (m *ComponentOutput) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *TransferComponent) Unmarshal(dAtA []byte) error {
+func (m *SchnorrSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4920,17 +12508,17 @@ func (m *TransferComponent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferComponent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchnorrSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchnorrSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4940,29 +12528,31 @@ func (m *TransferComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4972,29 +12562,31 @@ func (m *TransferComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5004,27 +12596,23 @@ func (m *TransferComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5048,58 +12636,7 @@ func (m *TransferComponent) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ClaimAccessPublic) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimAccessPublic: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimAccessPublic: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *ClaimAccessPrivate) Unmarshal(dAtA []byte) error {
+func (m *ThresholdSchnorr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5122,17 +12659,17 @@ func (m *ClaimAccessPrivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimAccessPrivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThresholdSchnorr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimAccessPrivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThresholdSchnorr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5142,24 +12679,43 @@ func (m *ClaimAccessPrivate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5182,7 +12738,7 @@ func (m *ClaimAccessPrivate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
+func (m *PedersenCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,17 +12761,17 @@ func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimAccessControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: PedersenCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PedersenCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Public", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5225,32 +12781,31 @@ func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClaimAccessPublic{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
-			m.AccessType = &ClaimAccessControl_Public{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Private", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCommitment", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5260,27 +12815,46 @@ func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ClaimAccessPrivate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TargetCommitment = append(m.TargetCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.TargetCommitment == nil {
+				m.TargetCommitment = []byte{}
 			}
-			m.AccessType = &ClaimAccessControl_Private{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindClaimer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BindClaimer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5303,7 +12877,7 @@ func (m *ClaimAccessControl) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
+func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5326,17 +12900,17 @@ func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimComponent: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnarkZkSnark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnarkZkSnark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5346,65 +12920,31 @@ func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VerificationKey = append(m.VerificationKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerificationKey == nil {
+				m.VerificationKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pedersen", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &PedersenCommitment{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.SchemeType = &ClaimComponent_Pedersen{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schnorr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicInputs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5414,32 +12954,31 @@ func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SchnorrSignature{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicInputs = append(m.PublicInputs[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicInputs == nil {
+				m.PublicInputs = []byte{}
 			}
-			m.SchemeType = &ClaimComponent_Schnorr{v}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gnark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5449,26 +12988,25 @@ func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GnarkZkSnark{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
 			}
-			m.SchemeType = &ClaimComponent_Gnark{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5492,7 +13030,7 @@ func (m *ClaimComponent) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractComponent) Unmarshal(dAtA []byte) error {
+func (m *CustomClaimScheme) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5515,15 +13053,15 @@ func (m *ContractComponent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractComponent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomClaimScheme: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomClaimScheme: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5551,7 +13089,7 @@ func (m *ContractComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Scheme = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5609,7 +13147,7 @@ func (m *ContractComponent) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
+func (m *Will) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5632,15 +13170,15 @@ func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCMsgComponent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Will: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCMsgComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Will: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5668,11 +13206,11 @@ func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5700,11 +13238,11 @@ func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5732,96 +13270,11 @@ func (m *IBCMsgComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IBCSendComponent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCSendComponent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5849,13 +13302,13 @@ func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5865,27 +13318,14 @@ func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5913,13 +13353,13 @@ func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5929,29 +13369,31 @@ func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Components = append(m.Components, &ExecutionComponent{})
+			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityWindow", wireType)
 			}
-			var msglen int
+			m.InactivityWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5961,84 +13403,35 @@ func (m *IBCSendComponent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InactivityWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCheckIn", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastCheckIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCheckIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutputTransfer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6048,29 +13441,33 @@ func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.TriggerTime == nil {
+				m.TriggerTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TriggerTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6080,27 +13477,28 @@ func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InactivityDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6127,69 +13525,18 @@ func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
+			if m.Condition == nil {
+				m.Condition = &WasmCondition{}
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *OutputContractCall) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutputContractCall: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
 			}
-			var stringLen uint64
+			m.ExecutionMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6199,29 +13546,16 @@ func (m *OutputContractCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecutionMode |= ExecutionMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteChannels", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6231,25 +13565,23 @@ func (m *OutputContractCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.RemoteChannels = append(m.RemoteChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6273,7 +13605,7 @@ func (m *OutputContractCall) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *OutputIBCContractCall) Unmarshal(dAtA []byte) error {
+func (m *ExecutionReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6296,15 +13628,15 @@ func (m *OutputIBCContractCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutputIBCContractCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputIBCContractCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6332,45 +13664,11 @@ func (m *OutputIBCContractCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6398,64 +13696,13 @@ func (m *OutputIBCContractCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutputIBCSend: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputIBCSend: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6465,27 +13712,14 @@ func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6513,11 +13747,11 @@ func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6545,13 +13779,32 @@ func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6561,28 +13814,45 @@ func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Amount == nil {
-				m.Amount = &types.Coin{}
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6605,7 +13875,7 @@ func (m *OutputIBCSend) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *OutputEmit) Unmarshal(dAtA []byte) error {
+func (m *PendingPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6628,15 +13898,15 @@ func (m *OutputEmit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutputEmit: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputEmit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6664,64 +13934,13 @@ func (m *OutputEmit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *SchnorrSignature) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchnorrSignature: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchnorrSignature: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6731,31 +13950,16 @@ func (m *SchnorrSignature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6765,29 +13969,27 @@ func (m *SchnorrSignature) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6815,64 +14017,13 @@ func (m *SchnorrSignature) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *PedersenCommitment) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PedersenCommitment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PedersenCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledStatus", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6882,31 +14033,29 @@ func (m *PedersenCommitment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
-			}
+			m.SettledStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetCommitment", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var byteLen int
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6916,26 +14065,11 @@ func (m *PedersenCommitment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Attempt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetCommitment = append(m.TargetCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.TargetCommitment == nil {
-				m.TargetCommitment = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6958,7 +14092,7 @@ func (m *PedersenCommitment) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
+func (m *PacketRetry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6981,17 +14115,17 @@ func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnarkZkSnark: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketRetry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnarkZkSnark: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketRetry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7001,31 +14135,29 @@ func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationKey = append(m.VerificationKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.VerificationKey == nil {
-				m.VerificationKey = []byte{}
-			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicInputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7035,31 +14167,29 @@ func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicInputs = append(m.PublicInputs[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicInputs == nil {
-				m.PublicInputs = []byte{}
-			}
+			m.ComponentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7069,26 +14199,30 @@ func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7111,7 +14245,7 @@ func (m *GnarkZkSnark) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Will) Unmarshal(dAtA []byte) error {
+func (m *WasmCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7134,15 +14268,15 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Will: wiretype end group for non-group")
+			return fmt.Errorf("proto: WasmCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Will: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WasmCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7170,13 +14304,13 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMsg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7186,27 +14320,29 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.QueryMsg = append(m.QueryMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.QueryMsg == nil {
+				m.QueryMsg = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7234,11 +14370,30 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= ConditionOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7266,13 +14421,13 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.Height = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7282,16 +14437,16 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.Interval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var stringLen uint64
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7301,27 +14456,84 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCheckHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.NextCheckHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCheckHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Wills) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Wills: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Wills: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7348,8 +14560,8 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, &ExecutionComponent{})
-			if err := m.Components[len(m.Components)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Wills = append(m.Wills, &Will{})
+			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7375,7 +14587,7 @@ func (m *Will) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Wills) Unmarshal(dAtA []byte) error {
+func (m *WillEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7398,15 +14610,47 @@ func (m *Wills) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Wills: wiretype end group for non-group")
+			return fmt.Errorf("proto: WillEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Wills: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WillEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wills", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WillId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7433,8 +14677,42 @@ func (m *Wills) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wills = append(m.Wills, &Will{})
-			if err := m.Wills[len(m.Wills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex